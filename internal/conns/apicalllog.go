@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+)
+
+// apiCallLogEntry is a single line in the API call log.
+type apiCallLogEntry struct {
+	Time               time.Time `json:"time"`
+	Service            string    `json:"service"`
+	Operation          string    `json:"operation"`
+	Region             string    `json:"region,omitempty"`
+	ServicePackageName string    `json:"service_package,omitempty"`
+	TypeName           string    `json:"resource,omitempty"`
+	LatencyMS          int64     `json:"latency_ms"`
+	Retries            int       `json:"retries"`
+	HTTPStatus         int       `json:"http_status,omitempty"`
+	ErrorCode          string    `json:"error_code,omitempty"`
+	RequestBody        string    `json:"request_body,omitempty"`
+}
+
+// apiCallLogger writes one JSON line per AWS API operation.
+// It is safe for concurrent use.
+type apiCallLogger struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// newAPICallLogger returns a logger that appends to the specified file, creating it if necessary.
+// The file remains open for the lifetime of the provider process.
+func newAPICallLogger(path string) (*apiCallLogger, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening API call log file (%s): %w", path, err)
+	}
+
+	return &apiCallLogger{w: f}, nil
+}
+
+func (l *apiCallLogger) log(ctx context.Context, entry *apiCallLogEntry) {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		tflog.Warn(ctx, "encoding API call log entry", map[string]any{
+			"error": err.Error(),
+		})
+		return
+	}
+	b = append(b, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err := l.w.Write(b); err != nil {
+		tflog.Warn(ctx, "writing API call log entry", map[string]any{
+			"error": err.Error(),
+		})
+	}
+}
+
+type apiCallLogEntryKey struct{}
+
+const (
+	// maskedValue replaces sensitive values, as tflog does.
+	maskedValue = "***"
+)
+
+// apiCallLogAPIOptions returns API client options that write an API call log entry for each API operation.
+func apiCallLogAPIOptions(logger *apiCallLogger) []func(*middleware.Stack) error {
+	if logger == nil {
+		return nil
+	}
+
+	return []func(*middleware.Stack) error{
+		func(stack *middleware.Stack) error {
+			// Log each operation once, including all retries.
+			return stack.Initialize.Add(apiCallLogMiddleware(logger), middleware.After)
+		},
+		func(stack *middleware.Stack) error {
+			// Record the request as sent on the wire.
+			return stack.Deserialize.Add(apiCallLogRequestMiddleware(), middleware.After)
+		},
+	}
+}
+
+func apiCallLogMiddleware(logger *apiCallLogger) middleware.InitializeMiddleware {
+	return middleware.InitializeMiddlewareFunc("tfAPICallLog", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		entry := &apiCallLogEntry{
+			Time:      time.Now().UTC(),
+			Service:   awsmiddleware.GetServiceID(ctx),
+			Operation: awsmiddleware.GetOperationName(ctx),
+		}
+		if inContext, ok := FromContext(ctx); ok {
+			entry.ServicePackageName = inContext.ServicePackageName()
+			entry.TypeName = inContext.TypeName()
+		}
+
+		ctx = middleware.WithStackValue(ctx, apiCallLogEntryKey{}, entry)
+
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		entry.LatencyMS = time.Since(entry.Time).Milliseconds()
+		if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+			entry.Retries = len(v.Results) - 1
+		}
+		if v, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok && v != nil {
+			entry.HTTPStatus = v.StatusCode
+		}
+		if err != nil {
+			if v, ok := errs.As[*awshttp.ResponseError](err); ok {
+				entry.HTTPStatus = v.HTTPStatusCode()
+			}
+			if v, ok := errs.As[smithy.APIError](err); ok {
+				entry.ErrorCode = v.ErrorCode()
+			}
+		}
+
+		logger.log(ctx, entry)
+
+		return out, metadata, err
+	})
+}
+
+func apiCallLogRequestMiddleware() middleware.DeserializeMiddleware {
+	return middleware.DeserializeMiddlewareFunc("tfAPICallLogRequest", func(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (middleware.DeserializeOutput, middleware.Metadata, error) {
+		entry, ok := middleware.GetStackValue(ctx, apiCallLogEntryKey{}).(*apiCallLogEntry)
+		if !ok {
+			return next.HandleDeserialize(ctx, in)
+		}
+
+		entry.Region = awsmiddleware.GetRegion(ctx)
+
+		// Request bodies are masked exactly as they are for debug logging.
+		if logging.IsSensitiveValueKey(ctx, logging.HTTPKeyRequestBody) {
+			entry.RequestBody = maskedValue
+		} else if request, ok := in.Request.(*smithyhttp.Request); ok {
+			rc := request.Build(ctx)
+
+			if fields, err := baselogging.DecomposeHTTPRequest(ctx, rc); err == nil {
+				if v, ok := fields[logging.HTTPKeyRequestBody].(string); ok {
+					entry.RequestBody = v
+				}
+			}
+
+			// Reading the request body consumed the stream; reset it.
+			request, err := request.SetStream(rc.Body)
+			if err != nil {
+				return middleware.DeserializeOutput{}, middleware.Metadata{}, err
+			}
+			in.Request = request
+		}
+
+		return next.HandleDeserialize(ctx, in)
+	})
+}
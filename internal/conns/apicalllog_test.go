@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+)
+
+func TestAPICallLogMiddleware(t *testing.T) {
+	t.Parallel()
+
+	const (
+		secretKey   = "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY" // nosemgrep:ci.aws-secret-key
+		requestBody = `{"Name":"test","SecretAccessKey":"` + secretKey + `"}`
+	)
+
+	testCases := map[string]struct {
+		ctx             func(context.Context) context.Context
+		err             error
+		wantHTTPStatus  int
+		wantErrorCode   string
+		wantRequestBody func(string) bool
+	}{
+		"success": {
+			wantHTTPStatus: http.StatusOK,
+			wantRequestBody: func(s string) bool {
+				return strings.Contains(s, `"Name":"test"`) && !strings.Contains(s, secretKey)
+			},
+		},
+		"error": {
+			err: &awshttp.ResponseError{
+				ResponseError: &smithyhttp.ResponseError{
+					Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusForbidden}},
+					Err:      &smithy.GenericAPIError{Code: "AccessDeniedException"},
+				},
+			},
+			wantHTTPStatus: http.StatusForbidden,
+			wantErrorCode:  "AccessDeniedException",
+			wantRequestBody: func(s string) bool {
+				return strings.Contains(s, `"Name":"test"`)
+			},
+		},
+		"sensitive": {
+			ctx: func(ctx context.Context) context.Context {
+				return logging.MaskSensitiveValuesByKey(ctx, logging.HTTPKeyRequestBody, logging.HTTPKeyResponseBody)
+			},
+			wantHTTPStatus: http.StatusOK,
+			wantRequestBody: func(s string) bool {
+				return s == maskedValue
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := WithTypeName(NewResourceContext(t.Context(), "test", "Test", ""), "aws_test")
+			if testCase.ctx != nil {
+				ctx = testCase.ctx(ctx)
+			}
+
+			var buf bytes.Buffer
+			logger := &apiCallLogger{w: &buf}
+
+			stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+			for _, f := range apiCallLogAPIOptions(logger) {
+				if err := f(stack); err != nil {
+					t.Fatalf("adding middleware: %s", err)
+				}
+			}
+			if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+				ServiceID:     "Test",
+				Region:        endpoints.UsWest2RegionID,
+				OperationName: "CreateThing",
+			}, middleware.Before); err != nil {
+				t.Fatalf("adding middleware: %s", err)
+			}
+			if err := stack.Serialize.Add(middleware.SerializeMiddlewareFunc("serialize", func(ctx context.Context, in middleware.SerializeInput, next middleware.SerializeHandler) (middleware.SerializeOutput, middleware.Metadata, error) {
+				request := in.Request.(*smithyhttp.Request)
+				request.Method = http.MethodPost
+				request.URL.Scheme, request.URL.Host = "https", "example.com"
+				request, err := request.SetStream(strings.NewReader(requestBody))
+				if err != nil {
+					return middleware.SerializeOutput{}, middleware.Metadata{}, err
+				}
+				in.Request = request
+
+				return next.HandleSerialize(ctx, in)
+			}), middleware.After); err != nil {
+				t.Fatalf("adding middleware: %s", err)
+			}
+			if err := awsmiddleware.AddRawResponseToMetadata(stack); err != nil {
+				t.Fatalf("adding middleware: %s", err)
+			}
+
+			var received []byte
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input any) (any, middleware.Metadata, error) {
+				request := input.(*smithyhttp.Request)
+				received, _ = io.ReadAll(request.GetStream())
+
+				return &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusOK}}, middleware.Metadata{}, testCase.err
+			}), stack)
+
+			if _, _, err := handler.Handle(ctx, struct{}{}); err != testCase.err { //nolint:errorlint // Compare identity
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got, expected := string(received), requestBody; got != expected {
+				t.Errorf("request body not preserved. got %q, expected %q", got, expected)
+			}
+
+			var entry apiCallLogEntry
+			if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+				t.Fatalf("decoding API call log entry %q: %s", buf.String(), err)
+			}
+			if got, expected := strings.Count(buf.String(), "\n"), 1; got != expected {
+				t.Errorf("got %d lines, expected %d", got, expected)
+			}
+
+			if got, expected := entry.Service, "Test"; got != expected {
+				t.Errorf("Service: got %q, expected %q", got, expected)
+			}
+			if got, expected := entry.Operation, "CreateThing"; got != expected {
+				t.Errorf("Operation: got %q, expected %q", got, expected)
+			}
+			if got, expected := entry.Region, endpoints.UsWest2RegionID; got != expected {
+				t.Errorf("Region: got %q, expected %q", got, expected)
+			}
+			if got, expected := entry.ServicePackageName, "test"; got != expected {
+				t.Errorf("ServicePackageName: got %q, expected %q", got, expected)
+			}
+			if got, expected := entry.TypeName, "aws_test"; got != expected {
+				t.Errorf("TypeName: got %q, expected %q", got, expected)
+			}
+			if got, expected := entry.HTTPStatus, testCase.wantHTTPStatus; got != expected {
+				t.Errorf("HTTPStatus: got %d, expected %d", got, expected)
+			}
+			if got, expected := entry.ErrorCode, testCase.wantErrorCode; got != expected {
+				t.Errorf("ErrorCode: got %q, expected %q", got, expected)
+			}
+			if !testCase.wantRequestBody(entry.RequestBody) {
+				t.Errorf("unexpected RequestBody: %q", entry.RequestBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// AssumeRoleOverride is a per-resource IAM role that is assumed using the provider's credentials.
+type AssumeRoleOverride struct {
+	Duration    time.Duration
+	ExternalID  string
+	RoleARN     string
+	SessionName string
+}
+
+// key returns a string that uniquely identifies the override for caching.
+func (o *AssumeRoleOverride) key() string {
+	return fmt.Sprintf("%s|%s|%s|%s", o.RoleARN, o.ExternalID, o.SessionName, o.Duration)
+}
+
+// accountID returns the AWS account ID of the role to be assumed.
+func (o *AssumeRoleOverride) accountID() string {
+	v, err := arn.Parse(o.RoleARN)
+	if err != nil {
+		return ""
+	}
+
+	return v.AccountID
+}
+
+// overrideAssumeRole returns any currently in effect per-resource assume role override.
+func overrideAssumeRole(ctx context.Context) *AssumeRoleOverride {
+	if inContext, ok := FromContext(ctx); ok {
+		return inContext.OverrideAssumeRole()
+	}
+
+	return nil
+}
+
+// clientCacheKey returns the key under which the effective default API clients are cached.
+func (c *AWSClient) clientCacheKey(ctx context.Context) string {
+	key := c.Region(ctx)
+	if v := overrideAssumeRole(ctx); v != nil {
+		key += "@" + v.key()
+	}
+
+	return key
+}
+
+// effectiveAWSConfig returns the AWS SDK for Go v2 configuration for any currently in effect per-resource assume role override,
+// otherwise the provider's configuration is returned.
+// Configurations for assume role overrides are cached, so that assumed role credentials are reused across resources.
+func (c *AWSClient) effectiveAWSConfig(ctx context.Context) *aws.Config {
+	v := overrideAssumeRole(ctx)
+	if v == nil || c.awsConfig == nil {
+		return c.awsConfig
+	}
+
+	c.assumeRoleLock.Lock()
+	defer c.assumeRoleLock.Unlock()
+
+	key := v.key()
+	if awsConfig, ok := c.assumeRoleConfigs[key]; ok {
+		return awsConfig
+	}
+
+	awsConfig := c.awsConfig.Copy()
+	stsClient := sts.NewFromConfig(awsConfig, func(o *sts.Options) {
+		if c.stsRegion != "" {
+			o.Region = c.stsRegion
+		}
+		if endpoint := c.endpoints[names.STS]; endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+	})
+	awsConfig.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsClient, v.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+		if v.Duration > 0 {
+			o.Duration = v.Duration
+		}
+		if v.ExternalID != "" {
+			o.ExternalID = aws.String(v.ExternalID)
+		}
+		if v.SessionName != "" {
+			o.RoleSessionName = v.SessionName
+		}
+	}))
+
+	if c.assumeRoleConfigs == nil {
+		c.assumeRoleConfigs = make(map[string]*aws.Config)
+	}
+	c.assumeRoleConfigs[key] = &awsConfig
+
+	return &awsConfig
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
+
+func TestAWSClientAccountIDAssumeRoleOverride(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := t.Context()
+	c := &AWSClient{
+		accountID: "123456789012",
+	}
+
+	if got, expected := c.AccountID(ctx), "123456789012"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	ctx = NewResourceContext(ctx, "test", "Test", "")
+	ctx = WithOverrideAssumeRole(ctx, &AssumeRoleOverride{
+		RoleARN: "arn:aws:iam::210987654321:role/test", //lintignore:AWSAT005
+	})
+
+	if got, expected := c.AccountID(ctx), "210987654321"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	if inContext, ok := FromContext(ctx); !ok || inContext.ServicePackageName() != "test" {
+		t.Errorf("resource information not preserved in Context")
+	}
+}
+
+func TestAWSClientEffectiveAWSConfigAssumeRoleOverride(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := t.Context()
+	c := &AWSClient{
+		awsConfig: &aws.Config{
+			Region: endpoints.UsWest2RegionID,
+		},
+	}
+
+	if got := c.effectiveAWSConfig(ctx); got != c.awsConfig {
+		t.Errorf("expected provider configuration without override")
+	}
+
+	ctx1 := WithOverrideAssumeRole(ctx, &AssumeRoleOverride{
+		RoleARN: "arn:aws:iam::111111111111:role/test", //lintignore:AWSAT005
+	})
+	ctx2 := WithOverrideAssumeRole(ctx, &AssumeRoleOverride{
+		RoleARN: "arn:aws:iam::222222222222:role/test", //lintignore:AWSAT005
+	})
+
+	config1 := c.effectiveAWSConfig(ctx1)
+	if config1 == c.awsConfig {
+		t.Errorf("expected separate configuration for override")
+	}
+	if got, expected := config1.Region, endpoints.UsWest2RegionID; got != expected {
+		t.Errorf("got Region %s, expected %s", got, expected)
+	}
+	if got := c.effectiveAWSConfig(ctx1); got != config1 {
+		t.Errorf("expected cached configuration for override")
+	}
+	if got := c.effectiveAWSConfig(ctx2); got == config1 {
+		t.Errorf("expected separate configuration for different override")
+	}
+
+	if c.clientCacheKey(ctx) == c.clientCacheKey(ctx1) || c.clientCacheKey(ctx1) == c.clientCacheKey(ctx2) {
+		t.Errorf("expected distinct API client cache keys")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -28,21 +29,30 @@ import (
 
 type AWSClient struct {
 	accountID                 string
+	apiCallLogger             *apiCallLogger         // From provider configuration.
+	assumeRoleConfigs         map[string]*aws.Config // Assume role override -> AWS SDK for Go v2 configuration.
+	assumeRoleLock            sync.Mutex
 	awsConfig                 *aws.Config
-	clients                   map[string]map[string]any // Region -> service package name -> API client.
+	clients                   map[string]map[string]any // Region and any assume role override -> service package name -> API client.
 	defaultTagsConfig         *tftags.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
+	explainDrift              bool              // From provider configuration.
 	httpClient                *http.Client
+	iamPolicyRecorder         *iamPolicyRecorder  // From provider configuration.
+	iamPolicyValidator        *iamPolicyValidator // From provider configuration.
 	ignoreTagsConfig          *tftags.IgnoreConfig
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
+	readOnlyMode              bool // From provider configuration.
 	servicePackages           map[string]ServicePackage
 	s3ExpressClient           *s3.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
-	terraformVersion          string // From provider configuration.
+	serviceRateLimiters       map[string]*serviceRateLimiter // From provider configuration.
+	s3UsePathStyle            bool                           // From provider configuration.
+	s3USEast1RegionalEndpoint string                         // From provider configuration.
+	stsRegion                 string                         // From provider configuration.
+	tagPolicyConfig           *tftags.PolicyConfig           // From provider configuration.
+	terraformVersion          string                         // From provider configuration.
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -66,11 +76,12 @@ func (c *AWSClient) TerraformVersion(_ context.Context) string {
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
-func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider {
-	if c.awsConfig == nil {
+func (c *AWSClient) CredentialsProvider(ctx context.Context) aws.CredentialsProvider {
+	awsConfig := c.effectiveAWSConfig(ctx)
+	if awsConfig == nil {
 		return nil
 	}
-	return c.awsConfig.Credentials
+	return awsConfig.Credentials
 }
 
 func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
@@ -81,12 +92,45 @@ func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
-func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
-	return c.awsConfig.Copy()
+// ExplainDrift returns whether resource Read operations report attributes changed outside of Terraform.
+func (c *AWSClient) ExplainDrift(context.Context) bool {
+	return c.explainDrift
 }
 
-// AccountID returns the configured AWS account ID.
-func (c *AWSClient) AccountID(context.Context) string {
+// IAMPolicyValidation returns how Access Analyzer findings for IAM policy attributes are reported, or "" if they are not validated.
+func (c *AWSClient) IAMPolicyValidation(context.Context) string {
+	if c.iamPolicyValidator == nil {
+		return ""
+	}
+	return c.iamPolicyValidator.mode
+}
+
+// ValidateIAMPolicy returns the Access Analyzer ERROR and SECURITY_WARNING findings for the IAM policy document in the specified attribute.
+func (c *AWSClient) ValidateIAMPolicy(ctx context.Context, attrName, document string) ([]IAMPolicyFinding, error) {
+	if c.iamPolicyValidator == nil {
+		return nil, nil
+	}
+	return c.iamPolicyValidator.validate(ctx, c.AccessAnalyzerClient(ctx), attrName, document)
+}
+
+func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.PolicyConfig {
+	return c.tagPolicyConfig
+}
+
+func (c *AWSClient) AwsConfig(ctx context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
+	return c.effectiveAWSConfig(ctx).Copy()
+}
+
+// AccountID returns the ID of the effective AWS account.
+// If the currently in-process operation has defined a per-resource assume role override,
+// the role's account ID is returned, otherwise the configured account ID is returned.
+func (c *AWSClient) AccountID(ctx context.Context) string {
+	if v := overrideAssumeRole(ctx); v != nil {
+		if accountID := v.accountID(); accountID != "" {
+			return accountID
+		}
+	}
+
 	return c.accountID
 }
 
@@ -332,8 +376,18 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig := c.effectiveAWSConfig(ctx).Copy()
+	// Don't modify the shared API options slice.
+	awsConfig.APIOptions = slices.Concat(
+		awsConfig.APIOptions,
+		serviceRateLimitAPIOptions(servicePackageName, c.serviceRateLimiters[servicePackageName]),
+		apiCallLogAPIOptions(c.apiCallLogger),
+		iamPolicyAPIOptions(servicePackageName, c.iamPolicyRecorder),
+		readOnlyModeAPIOptions(servicePackageName, c.readOnlyMode),
+	)
+
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": &awsConfig,
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 		"region":           c.Region(ctx),
@@ -359,7 +413,7 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
-	region := c.Region(ctx)
+	cacheKey := c.clientCacheKey(ctx)
 
 	isDefault := len(extra) == 0
 	// Default service client is cached.
@@ -367,7 +421,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if v, ok := c.clients[region]; ok {
+		if v, ok := c.clients[cacheKey]; ok {
 			if raw, ok := v[servicePackageName]; ok {
 				if client, ok := raw.(T); ok {
 					return client, nil
@@ -404,10 +458,10 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		if _, ok := c.clients[region]; !ok {
-			c.clients[region] = make(map[string]any, 0)
+		if _, ok := c.clients[cacheKey]; !ok {
+			c.clients[cacheKey] = make(map[string]any, 0)
 		}
-		c.clients[region][servicePackageName] = client
+		c.clients[cacheKey][servicePackageName] = client
 	}
 
 	return client, nil
@@ -28,6 +28,7 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	APICallLogFile                 string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
@@ -35,20 +36,27 @@ type Config struct {
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
+	EndpointProfile                string
 	Endpoints                      map[string]string
+	ExplainDrift                   bool
 	ForbiddenAccountIds            []string
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
+	IAMPolicyFile                  string
+	IAMPolicyValidation            string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	LocalEndpoint                  string
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	ReadOnlyMode                   bool
 	Region                         string
 	RetryMode                      aws.RetryMode
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceRateLimits              map[string]ServiceRateLimit // Service package name -> limits.
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -56,6 +64,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -69,6 +78,11 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	ctx, logger := logging.NewTfLogger(ctx)
 
+	localEndpoint, err := c.applyLocalEndpoint()
+	if err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
 	const (
 		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
 	)
@@ -180,11 +194,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			"See https://registry.terraform.io/providers/hashicorp/aws/latest/docs#skip_requesting_account_id for implications."))
 	}
 
-	err := awsbaseConfig.VerifyAccountIDAllowed(accountID)
-	if err != nil {
+	if err := awsbaseConfig.VerifyAccountIDAllowed(accountID); err != nil {
 		return nil, sdkdiag.AppendErrorf(diags, "%s", err.Error())
 	}
 
+	if accountID == "" && localEndpoint != "" {
+		accountID = localAccountID
+	}
+
 	for _, partition := range endpoints.DefaultPartitions() {
 		if partition.ID() == partitionID {
 			client.partition = partition
@@ -193,7 +210,9 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	client.accountID = accountID
 	client.defaultTagsConfig = c.DefaultTagsConfig
+	client.explainDrift = c.ExplainDrift
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	client.tagPolicyConfig = c.TagPolicyConfig
 	client.terraformVersion = c.TerraformVersion
 
 	// Used for lazy-loading AWS API clients.
@@ -201,10 +220,37 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.clients = make(map[string]map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.readOnlyMode = c.ReadOnlyMode
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.serviceRateLimiters = make(map[string]*serviceRateLimiter, len(c.ServiceRateLimits))
+	for servicePackageName, limit := range c.ServiceRateLimits {
+		client.serviceRateLimiters[servicePackageName] = newServiceRateLimiter(limit)
+	}
 	client.stsRegion = c.STSRegion
 
+	if c.APICallLogFile != "" {
+		logger, err := newAPICallLogger(c.APICallLogFile)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		client.apiCallLogger = logger
+	}
+
+	if c.IAMPolicyFile != "" {
+		recorder, err := newIAMPolicyRecorder(c.IAMPolicyFile)
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		client.iamPolicyRecorder = recorder
+	}
+
+	validator, err := newIAMPolicyValidator(c.IAMPolicyValidation)
+	if err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+	client.iamPolicyValidator = validator
+
 	return client, diags
 }
 
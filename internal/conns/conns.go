@@ -55,10 +55,17 @@ var (
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
-	overrideRegion     string // Any currently in effect per-resource Region override.
-	resourceName       string // Friendly resource name, e.g. "Subnet"
-	servicePackageName string // Canonical name defined as a constant in names package
-	vcrEnabled         bool   // Whether VCR testing is enabled
+	overrideAssumeRole *AssumeRoleOverride // Any currently in effect per-resource assume role override.
+	overrideRegion     string              // Any currently in effect per-resource Region override.
+	resourceName       string              // Friendly resource name, e.g. "Subnet"
+	servicePackageName string              // Canonical name defined as a constant in names package
+	typeName           string              // Terraform type name, e.g. "aws_subnet"
+	vcrEnabled         bool                // Whether VCR testing is enabled
+}
+
+// OverrideAssumeRole returns any currently in effect per-resource assume role override.
+func (c *InContext) OverrideAssumeRole() *AssumeRoleOverride {
+	return c.overrideAssumeRole
 }
 
 // OverrideRegion returns any currently in effect per-resource Region override.
@@ -76,6 +83,11 @@ func (c *InContext) ServicePackageName() string {
 	return c.servicePackageName
 }
 
+// TypeName returns the Terraform type name, e.g. "aws_subnet".
+func (c *InContext) TypeName() string {
+	return c.typeName
+}
+
 // VCREnabled indicates whether VCR testing is enabled.
 func (c *InContext) VCREnabled() bool {
 	return c.vcrEnabled
@@ -92,6 +104,28 @@ func NewResourceContext(ctx context.Context, servicePackageName, resourceName, o
 	return context.WithValue(ctx, contextKey, &v)
 }
 
+// WithOverrideAssumeRole returns a copy of the resource information in Context with the specified per-resource assume role override.
+func WithOverrideAssumeRole(ctx context.Context, overrideAssumeRole *AssumeRoleOverride) context.Context {
+	v := InContext{}
+	if inContext, ok := FromContext(ctx); ok {
+		v = *inContext
+	}
+	v.overrideAssumeRole = overrideAssumeRole
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
+// WithTypeName returns a copy of the resource information in Context with the specified Terraform type name.
+func WithTypeName(ctx context.Context, typeName string) context.Context {
+	v := InContext{}
+	if inContext, ok := FromContext(ctx); ok {
+		v = *inContext
+	}
+	v.typeName = typeName
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
 func FromContext(ctx context.Context) (*InContext, bool) {
 	v, ok := ctx.Value(contextKey).(*InContext)
 	return v, ok
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// EndpointProfileLocalStack points all service clients at a LocalStack instance.
+	EndpointProfileLocalStack = "localstack"
+)
+
+// EndpointProfiles returns the valid endpoint_profile values.
+func EndpointProfiles() []string {
+	return []string{
+		EndpointProfileLocalStack,
+	}
+}
+
+const (
+	// localStackEndpoint is LocalStack's default edge endpoint.
+	localStackEndpoint = "http://localhost:4566"
+	// localAccountID is the account ID reported by local AWS API stand-ins such as LocalStack.
+	localAccountID = "000000000000"
+	// localCredentialsValue is used as access key ID and secret access key when no credentials are configured.
+	localCredentialsValue = "test"
+)
+
+// applyLocalEndpoint configures the provider to use a single local AWS API stand-in, e.g. an emulator, for every service.
+// It returns the local endpoint, or "" if none is configured.
+func (c *Config) applyLocalEndpoint() (string, error) {
+	endpoint := c.LocalEndpoint
+
+	switch c.EndpointProfile {
+	case "":
+	case EndpointProfileLocalStack:
+		if endpoint == "" {
+			endpoint = localStackEndpoint
+		}
+	default:
+		return "", fmt.Errorf("invalid endpoint_profile (%s), expected one of %v", c.EndpointProfile, EndpointProfiles())
+	}
+
+	if endpoint == "" {
+		return "", nil
+	}
+
+	// Endpoints configured per service take precedence.
+	if c.Endpoints == nil {
+		c.Endpoints = make(map[string]string)
+	}
+	for _, servicePackageName := range names.ProviderPackages() {
+		if c.Endpoints[servicePackageName] == "" {
+			c.Endpoints[servicePackageName] = endpoint
+		}
+	}
+
+	c.S3UsePathStyle = true
+	c.SkipCredsValidation = true
+	c.SkipRegionValidation = true
+	c.SkipRequestingAccountId = true
+	if c.EC2MetadataServiceEnableState == imds.ClientDefaultEnableState {
+		c.EC2MetadataServiceEnableState = imds.ClientDisabled
+	}
+
+	if c.AccessKey == "" && c.SecretKey == "" && c.Profile == "" && !slices.ContainsFunc([]string{"AWS_ACCESS_KEY_ID", "AWS_PROFILE"}, hasEnv) {
+		c.AccessKey, c.SecretKey = localCredentialsValue, localCredentialsValue
+	}
+
+	return endpoint, nil
+}
+
+func hasEnv(key string) bool {
+	return os.Getenv(key) != ""
+}
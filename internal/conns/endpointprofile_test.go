@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestConfigApplyLocalEndpoint(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	t.Setenv("AWS_PROFILE", "")
+
+	const (
+		customEndpoint = "http://127.0.0.1:4000"
+		s3Endpoint     = "http://s3.localhost:9000"
+	)
+
+	testCases := map[string]struct {
+		config        Config
+		wantEndpoint  string
+		wantError     bool
+		wantAccessKey string
+	}{
+		"none": {},
+		"invalid profile": {
+			config: Config{
+				EndpointProfile: "moon",
+			},
+			wantError: true,
+		},
+		"localstack": {
+			config: Config{
+				EndpointProfile: EndpointProfileLocalStack,
+			},
+			wantEndpoint:  localStackEndpoint,
+			wantAccessKey: localCredentialsValue,
+		},
+		"localstack with local endpoint": {
+			config: Config{
+				EndpointProfile: EndpointProfileLocalStack,
+				LocalEndpoint:   customEndpoint,
+			},
+			wantEndpoint:  customEndpoint,
+			wantAccessKey: localCredentialsValue,
+		},
+		"local endpoint with credentials": {
+			config: Config{
+				AccessKey:     "AKIAEXAMPLE",
+				LocalEndpoint: customEndpoint,
+				SecretKey:     "secret",
+			},
+			wantEndpoint:  customEndpoint,
+			wantAccessKey: "AKIAEXAMPLE",
+		},
+		"local endpoint with service endpoint": {
+			config: Config{
+				Endpoints: map[string]string{
+					names.S3: s3Endpoint,
+				},
+				LocalEndpoint: customEndpoint,
+			},
+			wantEndpoint:  customEndpoint,
+			wantAccessKey: localCredentialsValue,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := testCase.config
+
+			endpoint, err := config.applyLocalEndpoint()
+
+			if got, expected := err != nil, testCase.wantError; got != expected {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got, expected := endpoint, testCase.wantEndpoint; got != expected {
+				t.Errorf("endpoint: got %q, expected %q", got, expected)
+			}
+			if got, expected := config.AccessKey, testCase.wantAccessKey; got != expected {
+				t.Errorf("AccessKey: got %q, expected %q", got, expected)
+			}
+
+			if endpoint == "" {
+				if len(config.Endpoints) != 0 {
+					t.Errorf("unexpected Endpoints: %v", config.Endpoints)
+				}
+				if config.S3UsePathStyle || config.SkipCredsValidation || config.SkipRequestingAccountId {
+					t.Error("unexpected settings changed")
+				}
+				return
+			}
+
+			if got, expected := config.Endpoints[names.EC2], endpoint; got != expected {
+				t.Errorf("EC2 endpoint: got %q, expected %q", got, expected)
+			}
+			expectedS3 := endpoint
+			if v := testCase.config.Endpoints[names.S3]; v != "" {
+				expectedS3 = v
+			}
+			if got, expected := config.Endpoints[names.S3], expectedS3; got != expected {
+				t.Errorf("S3 endpoint: got %q, expected %q", got, expected)
+			}
+			if !config.S3UsePathStyle {
+				t.Error("S3UsePathStyle not set")
+			}
+			if !config.SkipCredsValidation || !config.SkipRegionValidation || !config.SkipRequestingAccountId {
+				t.Error("validation not skipped")
+			}
+			if got, expected := config.EC2MetadataServiceEnableState, imds.ClientDisabled; got != expected {
+				t.Errorf("EC2MetadataServiceEnableState: got %v, expected %v", got, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// iamPolicyProviderSid is the statement ID for API operations not made on behalf of a resource type,
+	// e.g. those made during provider configuration.
+	iamPolicyProviderSid = "Provider"
+)
+
+// iamActionPrefixes maps service package names to IAM action prefixes where these differ from the service's ARN namespace.
+var iamActionPrefixes = map[string]string{
+	names.ELB:   "elasticloadbalancing",
+	names.ELBV2: "elasticloadbalancing",
+	names.SESV2: "ses",
+}
+
+// iamActionPrefix returns the IAM action prefix, e.g. "ec2", for the specified service package.
+func iamActionPrefix(servicePackageName string) string {
+	if v, ok := iamActionPrefixes[servicePackageName]; ok {
+		return v
+	}
+
+	if v, err := names.ARNNamespace(servicePackageName); err == nil {
+		return v
+	}
+
+	return servicePackageName
+}
+
+// iamActionHTTPMethodServices lists the service packages whose IAM actions are the HTTP methods of API requests,
+// e.g. "apigateway:GET", rather than API operation names.
+var iamActionHTTPMethodServices = []string{
+	names.APIGateway,
+	names.APIGatewayV2,
+}
+
+// iamActions returns the IAM actions, e.g. ["ec2:RunInstances"], authorizing the specified AWS API operation.
+func iamActions(servicePackageName, operation string) []string {
+	if v, ok := names.IAMActions(servicePackageName, operation); ok {
+		return v
+	}
+
+	return []string{iamActionPrefix(servicePackageName) + ":" + operation}
+}
+
+type iamPolicyDocument struct {
+	Version   string                `json:"Version"`
+	Statement []*iamPolicyStatement `json:"Statement"`
+}
+
+type iamPolicyStatement struct {
+	Sid      string   `json:"Sid"`
+	Effect   string   `json:"Effect"`
+	Action   []string `json:"Action"`
+	Resource string   `json:"Resource"`
+}
+
+// iamPolicyRecorder records the IAM actions needed for each AWS API operation invoked, keyed by resource type,
+// and writes a minimal IAM policy document allowing them.
+// It is safe for concurrent use.
+type iamPolicyRecorder struct {
+	mu      sync.Mutex
+	path    string
+	actions map[string]map[string]struct{} // Statement ID -> set of IAM actions.
+}
+
+// newIAMPolicyRecorder returns a recorder that writes to the specified file.
+// Any actions in an existing policy document are retained so that a single file can cover plan, apply and destroy.
+func newIAMPolicyRecorder(path string) (*iamPolicyRecorder, error) {
+	r := &iamPolicyRecorder{
+		path:    path,
+		actions: make(map[string]map[string]struct{}),
+	}
+
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading IAM policy file (%s): %w", path, err)
+	}
+
+	var document iamPolicyDocument
+	if err := json.Unmarshal(b, &document); err != nil {
+		return nil, fmt.Errorf("decoding IAM policy file (%s): %w", path, err)
+	}
+
+	for _, statement := range document.Statement {
+		for _, action := range statement.Action {
+			r.add(statement.Sid, action)
+		}
+	}
+
+	return r, nil
+}
+
+// add adds the specified action, returning whether it was not previously recorded.
+func (r *iamPolicyRecorder) add(sid, action string) bool {
+	actions, ok := r.actions[sid]
+	if !ok {
+		actions = make(map[string]struct{})
+		r.actions[sid] = actions
+	}
+
+	if _, ok := actions[action]; ok {
+		return false
+	}
+	actions[action] = struct{}{}
+
+	return true
+}
+
+// record records the specified actions, rewriting the policy document if any action is new.
+// There is no reliable hook at provider shutdown, so the file is kept current instead.
+func (r *iamPolicyRecorder) record(ctx context.Context, typeName string, actions ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sid := iamPolicySid(typeName)
+	var added bool
+	for _, action := range actions {
+		if r.add(sid, action) {
+			added = true
+		}
+	}
+	if !added {
+		return
+	}
+
+	if err := r.write(); err != nil {
+		tflog.Warn(ctx, "writing IAM policy file", map[string]any{
+			"error": err.Error(),
+		})
+	}
+}
+
+func (r *iamPolicyRecorder) document() *iamPolicyDocument {
+	document := &iamPolicyDocument{
+		Version: "2012-10-17",
+	}
+
+	for _, sid := range slices.Sorted(maps.Keys(r.actions)) {
+		actions := slices.Sorted(maps.Keys(r.actions[sid]))
+
+		document.Statement = append(document.Statement, &iamPolicyStatement{
+			Sid:      sid,
+			Effect:   "Allow",
+			Action:   actions,
+			Resource: "*",
+		})
+	}
+
+	return document
+}
+
+// write atomically replaces the policy document file.
+func (r *iamPolicyRecorder) write() error {
+	b, err := json.MarshalIndent(r.document(), "", "  ")
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	f, err := os.CreateTemp(filepath.Dir(r.path), filepath.Base(r.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), r.path)
+}
+
+// iamPolicySid returns an IAM policy statement ID for the specified resource type name, e.g. "AwsS3Bucket" for "aws_s3_bucket".
+// Statement IDs may only contain alphanumeric characters.
+func iamPolicySid(typeName string) string {
+	if typeName == "" {
+		return iamPolicyProviderSid
+	}
+
+	var sb strings.Builder
+	for part := range strings.SplitSeq(typeName, "_") {
+		if part == "" {
+			continue
+		}
+		sb.WriteString(strings.ToUpper(part[:1]))
+		sb.WriteString(part[1:])
+	}
+
+	return sb.String()
+}
+
+// iamPolicyAPIOptions returns API client options that record the IAM actions for each API operation.
+func iamPolicyAPIOptions(servicePackageName string, recorder *iamPolicyRecorder) []func(*middleware.Stack) error {
+	if recorder == nil {
+		return nil
+	}
+
+	if slices.Contains(iamActionHTTPMethodServices, servicePackageName) {
+		return []func(*middleware.Stack) error{
+			func(stack *middleware.Stack) error {
+				// The HTTP request is serialized before the Build step.
+				return stack.Build.Add(iamPolicyHTTPMethodMiddleware(servicePackageName, recorder), middleware.After)
+			},
+		}
+	}
+
+	return []func(*middleware.Stack) error{
+		func(stack *middleware.Stack) error {
+			// The operation name is registered by the API client's own Initialize middleware.
+			return stack.Initialize.Add(iamPolicyMiddleware(servicePackageName, recorder), middleware.After)
+		},
+	}
+}
+
+func iamPolicyMiddleware(servicePackageName string, recorder *iamPolicyRecorder) middleware.InitializeMiddleware {
+	return middleware.InitializeMiddlewareFunc("tfIAMPolicy", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		recorder.record(ctx, iamPolicyTypeName(ctx), iamActions(servicePackageName, awsmiddleware.GetOperationName(ctx))...)
+
+		return next.HandleInitialize(ctx, in)
+	})
+}
+
+func iamPolicyHTTPMethodMiddleware(servicePackageName string, recorder *iamPolicyRecorder) middleware.BuildMiddleware {
+	return middleware.BuildMiddlewareFunc("tfIAMPolicy", func(ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler) (middleware.BuildOutput, middleware.Metadata, error) {
+		if request, ok := in.Request.(*smithyhttp.Request); ok {
+			recorder.record(ctx, iamPolicyTypeName(ctx), iamActionPrefix(servicePackageName)+":"+request.Method)
+		}
+
+		return next.HandleBuild(ctx, in)
+	})
+}
+
+// iamPolicyTypeName returns the resource type name making the API call, or "" if none.
+func iamPolicyTypeName(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok {
+		return inContext.TypeName()
+	}
+
+	return ""
+}
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestIAMPolicySid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"":                        iamPolicyProviderSid,
+		"aws_instance":            "AwsInstance",
+		"aws_s3_bucket":           "AwsS3Bucket",
+		"aws_lb_target_group":     "AwsLbTargetGroup",
+		"aws_vpc_endpoint__dummy": "AwsVpcEndpointDummy",
+	}
+
+	for typeName, expected := range testCases {
+		t.Run(typeName, func(t *testing.T) {
+			t.Parallel()
+
+			if got := iamPolicySid(typeName); got != expected {
+				t.Errorf("got %q, expected %q", got, expected)
+			}
+		})
+	}
+}
+
+func TestIAMActionPrefix(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		names.AppAutoScaling: "application-autoscaling",
+		names.CognitoIDP:     "cognito-idp",
+		names.EC2:            "ec2",
+		names.ELBV2:          "elasticloadbalancing",
+		names.Logs:           "logs",
+		names.SESV2:          "ses",
+		"doesnotexist":       "doesnotexist",
+	}
+
+	for servicePackageName, expected := range testCases {
+		t.Run(servicePackageName, func(t *testing.T) {
+			t.Parallel()
+
+			if got := iamActionPrefix(servicePackageName); got != expected {
+				t.Errorf("got %q, expected %q", got, expected)
+			}
+		})
+	}
+}
+
+func TestIAMActions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		servicePackageName string
+		operation          string
+		expected           []string
+	}{
+		{names.EC2, "RunInstances", []string{"ec2:RunInstances"}},
+		{names.ELBV2, "CreateLoadBalancer", []string{"elasticloadbalancing:CreateLoadBalancer"}},
+		{names.Lambda, "GetFunction", []string{"lambda:GetFunction"}},
+		{names.Lambda, "Invoke", []string{"lambda:InvokeFunction"}},
+		{names.S3, "CopyObject", []string{"s3:GetObject", "s3:PutObject"}},
+		{names.S3, "DeleteBucketOwnershipControls", []string{"s3:PutBucketOwnershipControls"}},
+		{names.S3, "GetBucketLifecycleConfiguration", []string{"s3:GetLifecycleConfiguration"}},
+		{names.S3, "GetBucketPolicy", []string{"s3:GetBucketPolicy"}},
+		{names.S3, "HeadBucket", []string{"s3:ListBucket"}},
+		{names.S3, "HeadObject", []string{"s3:GetObject"}},
+		{names.S3, "ListBucketMetricsConfigurations", []string{"s3:GetMetricsConfiguration"}},
+		{names.S3, "ListMultipartUploads", []string{"s3:ListBucketMultipartUploads"}},
+		{names.S3, "ListObjectsV2", []string{"s3:ListBucket"}},
+		{names.S3, "ListParts", []string{"s3:ListMultipartUploadParts"}},
+		{names.S3, "PutBucketLifecycleConfiguration", []string{"s3:PutLifecycleConfiguration"}},
+		{names.S3, "SelectObjectContent", []string{"s3:GetObject"}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.servicePackageName+":"+testCase.operation, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(iamActions(testCase.servicePackageName, testCase.operation), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+// invokeIAMPolicyMiddleware invokes a fake API operation that sends an HTTP request with the specified method.
+func invokeIAMPolicyMiddleware(ctx context.Context, t *testing.T, recorder *iamPolicyRecorder, servicePackageName, operation, method string) {
+	t.Helper()
+
+	stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+	if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+		OperationName: operation,
+	}, middleware.Before); err != nil {
+		t.Fatalf("adding middleware: %s", err)
+	}
+	if err := stack.Serialize.Add(middleware.SerializeMiddlewareFunc("testSerialize", func(ctx context.Context, in middleware.SerializeInput, next middleware.SerializeHandler) (middleware.SerializeOutput, middleware.Metadata, error) {
+		in.Request.(*smithyhttp.Request).Method = method
+
+		return next.HandleSerialize(ctx, in)
+	}), middleware.Before); err != nil {
+		t.Fatalf("adding middleware: %s", err)
+	}
+	for _, f := range iamPolicyAPIOptions(servicePackageName, recorder) {
+		if err := f(stack); err != nil {
+			t.Fatalf("adding middleware: %s", err)
+		}
+	}
+
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input any) (any, middleware.Metadata, error) {
+		return &smithyhttp.Response{}, middleware.Metadata{}, nil
+	}), stack)
+
+	if _, _, err := handler.Handle(ctx, struct{}{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestIAMPolicyMiddleware(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "policy.json")
+
+	// Actions recorded by a previous run are retained.
+	if err := os.WriteFile(path, []byte(`{"Version":"2012-10-17","Statement":[{"Sid":"AwsInstance","Effect":"Allow","Action":["ec2:TerminateInstances"],"Resource":"*"}]}`), 0o600); err != nil {
+		t.Fatalf("writing policy file: %s", err)
+	}
+
+	recorder, err := newIAMPolicyRecorder(path)
+	if err != nil {
+		t.Fatalf("creating recorder: %s", err)
+	}
+
+	instanceCtx := WithTypeName(NewResourceContext(t.Context(), names.EC2, "Instance", ""), "aws_instance")
+	invokeIAMPolicyMiddleware(instanceCtx, t, recorder, names.EC2, "RunInstances", http.MethodPost)
+	invokeIAMPolicyMiddleware(instanceCtx, t, recorder, names.EC2, "DescribeInstances", http.MethodPost)
+	invokeIAMPolicyMiddleware(instanceCtx, t, recorder, names.EC2, "RunInstances", http.MethodPost)
+	invokeIAMPolicyMiddleware(WithTypeName(NewResourceContext(t.Context(), names.ELBV2, "Load Balancer", ""), "aws_lb"), t, recorder, names.ELBV2, "CreateLoadBalancer", http.MethodPost)
+	objectCtx := WithTypeName(NewResourceContext(t.Context(), names.S3, "Object Copy", ""), "aws_s3_object_copy")
+	invokeIAMPolicyMiddleware(objectCtx, t, recorder, names.S3, "CopyObject", http.MethodPut)
+	restAPICtx := WithTypeName(NewResourceContext(t.Context(), names.APIGateway, "REST API", ""), "aws_api_gateway_rest_api")
+	invokeIAMPolicyMiddleware(restAPICtx, t, recorder, names.APIGateway, "CreateRestApi", http.MethodPost)
+	invokeIAMPolicyMiddleware(restAPICtx, t, recorder, names.APIGateway, "GetRestApi", http.MethodGet)
+	invokeIAMPolicyMiddleware(t.Context(), t, recorder, names.STS, "GetCallerIdentity", http.MethodPost)
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading policy file: %s", err)
+	}
+
+	var got iamPolicyDocument
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("decoding policy file %q: %s", string(b), err)
+	}
+
+	expected := iamPolicyDocument{
+		Version: "2012-10-17",
+		Statement: []*iamPolicyStatement{
+			{
+				Sid:      "AwsApiGatewayRestApi",
+				Effect:   "Allow",
+				Action:   []string{"apigateway:GET", "apigateway:POST"},
+				Resource: "*",
+			},
+			{
+				Sid:      "AwsInstance",
+				Effect:   "Allow",
+				Action:   []string{"ec2:DescribeInstances", "ec2:RunInstances", "ec2:TerminateInstances"},
+				Resource: "*",
+			},
+			{
+				Sid:      "AwsLb",
+				Effect:   "Allow",
+				Action:   []string{"elasticloadbalancing:CreateLoadBalancer"},
+				Resource: "*",
+			},
+			{
+				Sid:      "AwsS3ObjectCopy",
+				Effect:   "Allow",
+				Action:   []string{"s3:GetObject", "s3:PutObject"},
+				Resource: "*",
+			},
+			{
+				Sid:      iamPolicyProviderSid,
+				Effect:   "Allow",
+				Action:   []string{"sts:GetCallerIdentity"},
+				Resource: "*",
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestNewIAMPolicyRecorderInvalidFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "policy.json")
+	if err := os.WriteFile(path, []byte("not JSON"), 0o600); err != nil {
+		t.Fatalf("writing policy file: %s", err)
+	}
+
+	if _, err := newIAMPolicyRecorder(path); err == nil {
+		t.Fatal("expected error")
+	}
+}
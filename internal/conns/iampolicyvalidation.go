@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+)
+
+const (
+	// IAMPolicyValidationWarning reports Access Analyzer findings for IAM policy attributes as warnings.
+	IAMPolicyValidationWarning = "warning"
+	// IAMPolicyValidationError reports Access Analyzer findings for IAM policy attributes as errors, blocking apply.
+	IAMPolicyValidationError = "error"
+)
+
+// IAMPolicyValidations returns the valid iam_policy_validation values.
+func IAMPolicyValidations() []string {
+	return []string{
+		IAMPolicyValidationWarning,
+		IAMPolicyValidationError,
+	}
+}
+
+// IAMPolicyFinding is an Access Analyzer policy validation finding.
+type IAMPolicyFinding struct {
+	FindingType   awstypes.ValidatePolicyFindingType
+	IssueCode     string
+	Details       string
+	LearnMoreLink string
+}
+
+func (f IAMPolicyFinding) String() string {
+	s := fmt.Sprintf("%s %s: %s", f.FindingType, f.IssueCode, f.Details)
+	if f.LearnMoreLink != "" {
+		s += " (" + f.LearnMoreLink + ")"
+	}
+
+	return s
+}
+
+// iamPolicyValidator validates IAM policy documents with Access Analyzer.
+// Findings are cached by document, as each document is usually validated several times per run (e.g. plan and apply).
+type iamPolicyValidator struct {
+	mode     string
+	findings sync.Map // iamPolicyValidationKey -> []IAMPolicyFinding
+}
+
+type iamPolicyValidationKey struct {
+	policyType   awstypes.PolicyType
+	resourceType awstypes.ValidatePolicyResourceType
+	document     string
+}
+
+// newIAMPolicyValidator returns a validator for the specified iam_policy_validation value, or nil if validation is disabled.
+func newIAMPolicyValidator(mode string) (*iamPolicyValidator, error) {
+	switch mode {
+	case "":
+		return nil, nil
+	case IAMPolicyValidationWarning, IAMPolicyValidationError:
+		return &iamPolicyValidator{
+			mode: mode,
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid iam_policy_validation (%s), expected one of %v", mode, IAMPolicyValidations())
+	}
+}
+
+// validate returns the ERROR and SECURITY_WARNING findings for the specified policy document.
+// attrName is the name of the attribute containing the document and is used to determine the type of policy.
+func (v *iamPolicyValidator) validate(ctx context.Context, conn accessanalyzer.ValidatePolicyAPIClient, attrName, document string) ([]IAMPolicyFinding, error) {
+	policyType, resourceType := iamPolicyType(attrName, document)
+	key := iamPolicyValidationKey{
+		policyType:   policyType,
+		resourceType: resourceType,
+		document:     document,
+	}
+
+	if findings, ok := v.findings.Load(key); ok {
+		return findings.([]IAMPolicyFinding), nil
+	}
+
+	input := accessanalyzer.ValidatePolicyInput{
+		PolicyDocument:             aws.String(document),
+		PolicyType:                 policyType,
+		ValidatePolicyResourceType: resourceType,
+	}
+	findings := []IAMPolicyFinding{}
+
+	pages := accessanalyzer.NewValidatePolicyPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("validating IAM policy: %w", err)
+		}
+
+		for _, finding := range page.Findings {
+			switch finding.FindingType {
+			case awstypes.ValidatePolicyFindingTypeError, awstypes.ValidatePolicyFindingTypeSecurityWarning:
+				findings = append(findings, IAMPolicyFinding{
+					FindingType:   finding.FindingType,
+					IssueCode:     aws.ToString(finding.IssueCode),
+					Details:       aws.ToString(finding.FindingDetails),
+					LearnMoreLink: aws.ToString(finding.LearnMoreLink),
+				})
+			}
+		}
+	}
+
+	v.findings.Store(key, findings)
+
+	return findings, nil
+}
+
+// iamPolicyType returns the Access Analyzer policy type, and resource type if known, of the specified policy document.
+// Role trust policies are identified by attribute name; other policies specifying a principal are resource-based policies.
+func iamPolicyType(attrName, document string) (awstypes.PolicyType, awstypes.ValidatePolicyResourceType) {
+	if attrName == "assume_role_policy" {
+		return awstypes.PolicyTypeResourcePolicy, awstypes.ValidatePolicyResourceTypeRoleTrust
+	}
+
+	var policy struct {
+		Statement json.RawMessage `json:"Statement"`
+	}
+	if err := json.Unmarshal([]byte(document), &policy); err != nil {
+		return awstypes.PolicyTypeIdentityPolicy, ""
+	}
+
+	// Statement is either a single statement or a list of statements.
+	var statements []map[string]json.RawMessage
+	if err := json.Unmarshal(policy.Statement, &statements); err != nil {
+		var statement map[string]json.RawMessage
+		if err := json.Unmarshal(policy.Statement, &statement); err != nil {
+			return awstypes.PolicyTypeIdentityPolicy, ""
+		}
+		statements = append(statements, statement)
+	}
+
+	for _, statement := range statements {
+		if _, ok := statement["Principal"]; ok {
+			return awstypes.PolicyTypeResourcePolicy, ""
+		}
+		if _, ok := statement["NotPrincipal"]; ok {
+			return awstypes.PolicyTypeResourcePolicy, ""
+		}
+	}
+
+	return awstypes.PolicyTypeIdentityPolicy, ""
+}
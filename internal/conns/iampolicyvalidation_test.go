@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+type mockValidatePolicyClient struct {
+	inputs []*accessanalyzer.ValidatePolicyInput
+}
+
+func (m *mockValidatePolicyClient) ValidatePolicy(_ context.Context, input *accessanalyzer.ValidatePolicyInput, _ ...func(*accessanalyzer.Options)) (*accessanalyzer.ValidatePolicyOutput, error) {
+	m.inputs = append(m.inputs, input)
+
+	// Findings of every type are returned over two pages.
+	if input.NextToken == nil {
+		return &accessanalyzer.ValidatePolicyOutput{
+			Findings: []awstypes.ValidatePolicyFinding{
+				{
+					FindingType:    awstypes.ValidatePolicyFindingTypeError,
+					IssueCode:      aws.String("MISSING_VERSION"),
+					FindingDetails: aws.String("We recommend that you specify the Version element."),
+					LearnMoreLink:  aws.String("https://example.com/missing-version"),
+				},
+				{
+					FindingType:    awstypes.ValidatePolicyFindingTypeSuggestion,
+					IssueCode:      aws.String("EMPTY_ARRAY_ACTION"),
+					FindingDetails: aws.String("This statement includes no actions."),
+				},
+			},
+			NextToken: aws.String("next"),
+		}, nil
+	}
+
+	return &accessanalyzer.ValidatePolicyOutput{
+		Findings: []awstypes.ValidatePolicyFinding{
+			{
+				FindingType:    awstypes.ValidatePolicyFindingTypeWarning,
+				IssueCode:      aws.String("REDUNDANT_ACTION"),
+				FindingDetails: aws.String("The action is redundant."),
+			},
+			{
+				FindingType:    awstypes.ValidatePolicyFindingTypeSecurityWarning,
+				IssueCode:      aws.String("PASS_ROLE_WITH_STAR_IN_RESOURCE"),
+				FindingDetails: aws.String("Using the iam:PassRole action with wildcards (*) in the resource can be overly permissive."),
+			},
+		},
+	}, nil
+}
+
+func TestNewIAMPolicyValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		mode        string
+		expectNil   bool
+		expectError bool
+	}{
+		"disabled": {
+			expectNil: true,
+		},
+		IAMPolicyValidationWarning: {
+			mode: IAMPolicyValidationWarning,
+		},
+		IAMPolicyValidationError: {
+			mode: IAMPolicyValidationError,
+		},
+		"invalid": {
+			mode:        "strict",
+			expectNil:   true,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			validator, err := newIAMPolicyValidator(testCase.mode)
+
+			if got, expected := err != nil, testCase.expectError; got != expected {
+				t.Errorf("error: got %v, expected %t", err, expected)
+			}
+
+			if got, expected := validator == nil, testCase.expectNil; got != expected {
+				t.Fatalf("nil validator: got %t, expected %t", got, expected)
+			}
+
+			if validator != nil && validator.mode != testCase.mode {
+				t.Errorf("mode: got %q, expected %q", validator.mode, testCase.mode)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attrName             string
+		document             string
+		expectedPolicyType   awstypes.PolicyType
+		expectedResourceType awstypes.ValidatePolicyResourceType
+	}{
+		"identity": {
+			attrName:           "policy",
+			document:           `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			expectedPolicyType: awstypes.PolicyTypeIdentityPolicy,
+		},
+		"resource": {
+			attrName:           "policy",
+			document:           `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"*"}]}`,
+			expectedPolicyType: awstypes.PolicyTypeResourcePolicy,
+		},
+		"resource single statement": {
+			attrName:           "policy",
+			document:           `{"Version":"2012-10-17","Statement":{"Effect":"Deny","NotPrincipal":{"AWS":"123456789012"},"Action":"s3:*","Resource":"*"}}`,
+			expectedPolicyType: awstypes.PolicyTypeResourcePolicy,
+		},
+		"role trust": {
+			attrName:             "assume_role_policy",
+			document:             `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			expectedPolicyType:   awstypes.PolicyTypeResourcePolicy,
+			expectedResourceType: awstypes.ValidatePolicyResourceTypeRoleTrust,
+		},
+		"invalid JSON": {
+			attrName:           "policy",
+			document:           `{`,
+			expectedPolicyType: awstypes.PolicyTypeIdentityPolicy,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			policyType, resourceType := iamPolicyType(testCase.attrName, testCase.document)
+
+			if got, expected := policyType, testCase.expectedPolicyType; got != expected {
+				t.Errorf("policy type: got %s, expected %s", got, expected)
+			}
+			if got, expected := resourceType, testCase.expectedResourceType; got != expected {
+				t.Errorf("resource type: got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyValidatorValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	const document = `{"Statement":[{"Effect":"Allow","Action":"iam:PassRole","Resource":"*"}]}`
+
+	validator, err := newIAMPolicyValidator(IAMPolicyValidationWarning)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	conn := &mockValidatePolicyClient{}
+
+	findings, err := validator.validate(ctx, conn, "policy", document)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []IAMPolicyFinding{
+		{
+			FindingType:   awstypes.ValidatePolicyFindingTypeError,
+			IssueCode:     "MISSING_VERSION",
+			Details:       "We recommend that you specify the Version element.",
+			LearnMoreLink: "https://example.com/missing-version",
+		},
+		{
+			FindingType: awstypes.ValidatePolicyFindingTypeSecurityWarning,
+			IssueCode:   "PASS_ROLE_WITH_STAR_IN_RESOURCE",
+			Details:     "Using the iam:PassRole action with wildcards (*) in the resource can be overly permissive.",
+		},
+	}
+	if diff := cmp.Diff(findings, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if got, expected := findings[0].String(), "ERROR MISSING_VERSION: We recommend that you specify the Version element. (https://example.com/missing-version)"; got != expected {
+		t.Errorf("String: got %q, expected %q", got, expected)
+	}
+
+	if got, expected := len(conn.inputs), 2; got != expected {
+		t.Fatalf("requests: got %d, expected %d", got, expected)
+	}
+	if got, expected := conn.inputs[0].PolicyType, awstypes.PolicyTypeIdentityPolicy; got != expected {
+		t.Errorf("PolicyType: got %s, expected %s", got, expected)
+	}
+
+	// Findings are cached.
+	if _, err := validator.validate(ctx, conn, "policy", document); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, expected := len(conn.inputs), 2; got != expected {
+		t.Errorf("requests after cached validation: got %d, expected %d", got, expected)
+	}
+
+	// The same document in a different type of attribute is validated again.
+	if _, err := validator.validate(ctx, conn, "assume_role_policy", document); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, expected := len(conn.inputs), 4; got != expected {
+		t.Errorf("requests after role trust validation: got %d, expected %d", got, expected)
+	}
+}
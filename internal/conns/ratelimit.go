@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ServiceRateLimit limits the rate and concurrency of AWS API requests made to a single service.
+type ServiceRateLimit struct {
+	// MaxInFlight is the maximum number of concurrent API operations, including retries. Zero means no limit.
+	MaxInFlight int
+	// RequestsPerSecond is the maximum rate of API requests, including retries. Zero means no limit.
+	RequestsPerSecond float64
+}
+
+// serviceRateLimiter enforces a ServiceRateLimit.
+// A single limiter is shared by all of a service's API clients, in all Regions.
+type serviceRateLimiter struct {
+	inFlight chan struct{} // Semaphore; nil if concurrency is not limited.
+	interval time.Duration // Minimum time between requests; zero if rate is not limited.
+	mu       sync.Mutex
+	next     time.Time // Earliest time at which the next request may be sent.
+}
+
+func newServiceRateLimiter(l ServiceRateLimit) *serviceRateLimiter {
+	limiter := &serviceRateLimiter{}
+
+	if l.MaxInFlight > 0 {
+		limiter.inFlight = make(chan struct{}, l.MaxInFlight)
+	}
+	if l.RequestsPerSecond > 0 {
+		limiter.interval = time.Duration(float64(time.Second) / l.RequestsPerSecond)
+	}
+
+	return limiter
+}
+
+// acquire blocks until an in-flight slot is available or ctx is done.
+func (l *serviceRateLimiter) acquire(ctx context.Context) error {
+	if l.inFlight == nil {
+		return nil
+	}
+
+	select {
+	case l.inFlight <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release frees an in-flight slot obtained by acquire.
+func (l *serviceRateLimiter) release() {
+	if l.inFlight == nil {
+		return
+	}
+
+	<-l.inFlight
+}
+
+// wait blocks until a request may be sent or ctx is done.
+// Returns the time spent waiting.
+func (l *serviceRateLimiter) wait(ctx context.Context) (time.Duration, error) {
+	if l.interval == 0 {
+		return 0, nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return delay, nil
+	case <-ctx.Done():
+		return delay, ctx.Err()
+	}
+}
+
+// serviceRateLimitAPIOptions returns API client options that apply the specified limiter, which may be nil, to all API operations
+// and that log throttled API requests.
+func serviceRateLimitAPIOptions(servicePackageName string, limiter *serviceRateLimiter) []func(*middleware.Stack) error {
+	apiOptions := []func(*middleware.Stack) error{
+		func(stack *middleware.Stack) error {
+			// Log each throttled attempt, so the middleware must run inside the retry loop.
+			return addFinalizeMiddlewareAfterRetry(stack, throttleLoggingMiddleware(servicePackageName))
+		},
+	}
+
+	if limiter == nil {
+		return apiOptions
+	}
+
+	if limiter.inFlight != nil {
+		apiOptions = append(apiOptions, func(stack *middleware.Stack) error {
+			// Limit concurrency per operation, so that retries don't need to reacquire a slot.
+			return stack.Initialize.Add(concurrencyLimitMiddleware(limiter), middleware.Before)
+		})
+	}
+
+	if limiter.interval != 0 {
+		apiOptions = append(apiOptions, func(stack *middleware.Stack) error {
+			// Limit the rate of each attempt.
+			return addFinalizeMiddlewareAfterRetry(stack, rateLimitMiddleware(servicePackageName, limiter))
+		})
+	}
+
+	return apiOptions
+}
+
+// addFinalizeMiddlewareAfterRetry adds the specified middleware to the Finalize step so that it is called for each retry attempt.
+func addFinalizeMiddlewareAfterRetry(stack *middleware.Stack, m middleware.FinalizeMiddleware) error {
+	const (
+		retryMiddlewareID = "Retry"
+	)
+
+	if _, ok := stack.Finalize.Get(retryMiddlewareID); ok {
+		return stack.Finalize.Insert(m, retryMiddlewareID, middleware.After)
+	}
+
+	return stack.Finalize.Add(m, middleware.After)
+}
+
+func concurrencyLimitMiddleware(limiter *serviceRateLimiter) middleware.InitializeMiddleware {
+	return middleware.InitializeMiddlewareFunc("tfServiceConcurrencyLimit", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		if err := limiter.acquire(ctx); err != nil {
+			return middleware.InitializeOutput{}, middleware.Metadata{}, fmt.Errorf("waiting for in-flight API request slot: %w", err)
+		}
+		defer limiter.release()
+
+		return next.HandleInitialize(ctx, in)
+	})
+}
+
+func rateLimitMiddleware(servicePackageName string, limiter *serviceRateLimiter) middleware.FinalizeMiddleware {
+	return middleware.FinalizeMiddlewareFunc("tfServiceRateLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		delay, err := limiter.wait(ctx)
+		if err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, fmt.Errorf("waiting for API request rate limit: %w", err)
+		}
+
+		if delay > 0 {
+			tflog.Debug(ctx, "AWS API request delayed by service rate limit", map[string]any{
+				"tf_aws.service_package": servicePackageName,
+				"aws.operation":          awsmiddleware.GetOperationName(ctx),
+				"delay":                  delay.String(),
+			})
+		}
+
+		return next.HandleFinalize(ctx, in)
+	})
+}
+
+func throttleLoggingMiddleware(servicePackageName string) middleware.FinalizeMiddleware {
+	isErrorThrottle := retry.IsErrorThrottles(retry.DefaultThrottles)
+
+	return middleware.FinalizeMiddlewareFunc("tfThrottleLogging", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		out, metadata, err := next.HandleFinalize(ctx, in)
+
+		if err != nil && isErrorThrottle.IsErrorThrottle(err).Bool() {
+			tflog.Debug(ctx, "AWS API request throttled", map[string]any{
+				"tf_aws.service_package": servicePackageName,
+				"aws.operation":          awsmiddleware.GetOperationName(ctx),
+				"error":                  err.Error(),
+			})
+		}
+
+		return out, metadata, err
+	})
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestServiceRateLimiterWait(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	limiter := newServiceRateLimiter(ServiceRateLimit{RequestsPerSecond: 20})
+
+	const n = 5
+	start := time.Now()
+	for range n {
+		if _, err := limiter.wait(ctx); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	// The first request isn't delayed.
+	if got, expected := time.Since(start), (n-1)*limiter.interval; got < expected {
+		t.Errorf("requests not rate limited. Expected at least %s, got %s", expected, got)
+	}
+}
+
+func TestServiceRateLimiterWaitCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	limiter := newServiceRateLimiter(ServiceRateLimit{RequestsPerSecond: 0.1})
+
+	if _, err := limiter.wait(ctx); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cancel()
+
+	if _, err := limiter.wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestServiceRateLimiterAcquire(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	limiter := newServiceRateLimiter(ServiceRateLimit{MaxInFlight: 2})
+
+	var wg sync.WaitGroup
+	var current, maxSeen atomic.Int32
+
+	for range 6 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := limiter.acquire(ctx); err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			defer limiter.release()
+
+			n := current.Add(1)
+			defer current.Add(-1)
+			for {
+				m := maxSeen.Load()
+				if n <= m || maxSeen.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+		}()
+	}
+
+	wg.Wait()
+
+	if got, expected := maxSeen.Load(), int32(2); got != expected {
+		t.Errorf("incorrect maximum concurrency. Expected: %d, got: %d", expected, got)
+	}
+}
+
+func TestServiceRateLimiterUnlimited(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	limiter := newServiceRateLimiter(ServiceRateLimit{})
+
+	for range 100 {
+		if delay, err := limiter.wait(ctx); err != nil || delay != 0 {
+			t.Fatalf("unexpected result: %s, %v", delay, err)
+		}
+		if err := limiter.acquire(ctx); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// ReadOnlyModeError is returned when an AWS API operation that isn't known to be read-only is called in read-only mode.
+type ReadOnlyModeError struct {
+	Operation          string
+	ServiceID          string
+	ServicePackageName string
+	TypeName           string
+}
+
+func (e *ReadOnlyModeError) Error() string {
+	msg := fmt.Sprintf("read-only mode: AWS API operation %s:%s is not on the read-only allowlist", e.ServiceID, e.Operation)
+	if e.TypeName != "" {
+		msg += fmt.Sprintf(" (called by %s)", e.TypeName)
+	}
+
+	return msg
+}
+
+// isReadOnlyOperation returns whether the specified AWS API operation is on the read-only allowlist.
+func isReadOnlyOperation(servicePackageName, operation string) bool {
+	_, ok := readOnlyOperations[servicePackageName][operation]
+	return ok
+}
+
+// readOnlyModeAPIOptions returns API client options that, if enabled, block any API operation not on the read-only allowlist.
+func readOnlyModeAPIOptions(servicePackageName string, enabled bool) []func(*middleware.Stack) error {
+	if !enabled {
+		return nil
+	}
+
+	return []func(*middleware.Stack) error{
+		func(stack *middleware.Stack) error {
+			// The operation name is registered by the API client's own Initialize middleware.
+			return stack.Initialize.Add(readOnlyModeMiddleware(servicePackageName), middleware.After)
+		},
+	}
+}
+
+func readOnlyModeMiddleware(servicePackageName string) middleware.InitializeMiddleware {
+	return middleware.InitializeMiddlewareFunc("tfReadOnlyMode", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		if operation := awsmiddleware.GetOperationName(ctx); !isReadOnlyOperation(servicePackageName, operation) {
+			err := &ReadOnlyModeError{
+				Operation:          operation,
+				ServiceID:          awsmiddleware.GetServiceID(ctx),
+				ServicePackageName: servicePackageName,
+			}
+			if inContext, ok := FromContext(ctx); ok {
+				err.TypeName = inContext.TypeName()
+			}
+
+			return middleware.InitializeOutput{}, middleware.Metadata{}, err
+		}
+
+		return next.HandleInitialize(ctx, in)
+	})
+}
@@ -0,0 +1,6235 @@
+// Code generated by internal/generate/readonlyoperations/main.go; DO NOT EDIT.
+package conns
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// readOnlyOperations is the allowlist of AWS API operations that don't modify resources, keyed by service package name.
+var readOnlyOperations = map[string]map[string]struct{}{
+	names.ACM: {
+		"DescribeCertificate":     {},
+		"GetAccountConfiguration": {},
+		"GetCertificate":          {},
+		"ListCertificates":        {},
+		"ListTagsForCertificate":  {},
+	},
+	names.ACMPCA: {
+		"DescribeCertificateAuthority":            {},
+		"DescribeCertificateAuthorityAuditReport": {},
+		"GetCertificate":                          {},
+		"GetCertificateAuthorityCertificate":      {},
+		"GetCertificateAuthorityCsr":              {},
+		"GetPolicy":                               {},
+		"ListCertificateAuthorities":              {},
+		"ListPermissions":                         {},
+		"ListTags":                                {},
+	},
+	names.AMP: {
+		"DescribeAlertManagerDefinition":      {},
+		"DescribeLoggingConfiguration":        {},
+		"DescribeQueryLoggingConfiguration":   {},
+		"DescribeResourcePolicy":              {},
+		"DescribeRuleGroupsNamespace":         {},
+		"DescribeScraper":                     {},
+		"DescribeScraperLoggingConfiguration": {},
+		"DescribeWorkspace":                   {},
+		"DescribeWorkspaceConfiguration":      {},
+		"GetDefaultScraperConfiguration":      {},
+		"ListRuleGroupsNamespaces":            {},
+		"ListScrapers":                        {},
+		"ListTagsForResource":                 {},
+		"ListWorkspaces":                      {},
+	},
+	names.APIGateway: {
+		"GetAccount":                      {},
+		"GetApiKey":                       {},
+		"GetApiKeys":                      {},
+		"GetAuthorizer":                   {},
+		"GetAuthorizers":                  {},
+		"GetBasePathMapping":              {},
+		"GetBasePathMappings":             {},
+		"GetClientCertificate":            {},
+		"GetClientCertificates":           {},
+		"GetDeployment":                   {},
+		"GetDeployments":                  {},
+		"GetDocumentationPart":            {},
+		"GetDocumentationParts":           {},
+		"GetDocumentationVersion":         {},
+		"GetDocumentationVersions":        {},
+		"GetDomainName":                   {},
+		"GetDomainNameAccessAssociations": {},
+		"GetDomainNames":                  {},
+		"GetExport":                       {},
+		"GetGatewayResponse":              {},
+		"GetGatewayResponses":             {},
+		"GetIntegration":                  {},
+		"GetIntegrationResponse":          {},
+		"GetMethod":                       {},
+		"GetMethodResponse":               {},
+		"GetModel":                        {},
+		"GetModelTemplate":                {},
+		"GetModels":                       {},
+		"GetRequestValidator":             {},
+		"GetRequestValidators":            {},
+		"GetResource":                     {},
+		"GetResources":                    {},
+		"GetRestApi":                      {},
+		"GetRestApis":                     {},
+		"GetSdk":                          {},
+		"GetSdkType":                      {},
+		"GetSdkTypes":                     {},
+		"GetStage":                        {},
+		"GetStages":                       {},
+		"GetTags":                         {},
+		"GetUsage":                        {},
+		"GetUsagePlan":                    {},
+		"GetUsagePlanKey":                 {},
+		"GetUsagePlanKeys":                {},
+		"GetUsagePlans":                   {},
+		"GetVpcLink":                      {},
+		"GetVpcLinks":                     {},
+	},
+	names.APIGatewayV2: {
+		"GetApi":                  {},
+		"GetApiMapping":           {},
+		"GetApiMappings":          {},
+		"GetApis":                 {},
+		"GetAuthorizer":           {},
+		"GetAuthorizers":          {},
+		"GetDeployment":           {},
+		"GetDeployments":          {},
+		"GetDomainName":           {},
+		"GetDomainNames":          {},
+		"GetIntegration":          {},
+		"GetIntegrationResponse":  {},
+		"GetIntegrationResponses": {},
+		"GetIntegrations":         {},
+		"GetModel":                {},
+		"GetModelTemplate":        {},
+		"GetModels":               {},
+		"GetRoute":                {},
+		"GetRouteResponse":        {},
+		"GetRouteResponses":       {},
+		"GetRoutes":               {},
+		"GetRoutingRule":          {},
+		"GetStage":                {},
+		"GetStages":               {},
+		"GetTags":                 {},
+		"GetVpcLink":              {},
+		"GetVpcLinks":             {},
+		"ListRoutingRules":        {},
+	},
+	names.ARCRegionSwitch: {
+		"GetPlan":                 {},
+		"GetPlanEvaluationStatus": {},
+		"GetPlanExecution":        {},
+		"GetPlanInRegion":         {},
+		"ListPlanExecutionEvents": {},
+		"ListPlanExecutions":      {},
+		"ListPlans":               {},
+		"ListPlansInRegion":       {},
+		"ListRoute53HealthChecks": {},
+		"ListTagsForResource":     {},
+	},
+	names.AccessAnalyzer: {
+		"GetAccessPreview":          {},
+		"GetAnalyzedResource":       {},
+		"GetAnalyzer":               {},
+		"GetArchiveRule":            {},
+		"GetFinding":                {},
+		"GetFindingRecommendation":  {},
+		"GetFindingV2":              {},
+		"GetFindingsStatistics":     {},
+		"GetGeneratedPolicy":        {},
+		"ListAccessPreviewFindings": {},
+		"ListAccessPreviews":        {},
+		"ListAnalyzedResources":     {},
+		"ListAnalyzers":             {},
+		"ListArchiveRules":          {},
+		"ListFindings":              {},
+		"ListFindingsV2":            {},
+		"ListPolicyGenerations":     {},
+		"ListTagsForResource":       {},
+		"ValidatePolicy":            {},
+	},
+	names.Account: {
+		"GetAccountInformation": {},
+		"GetAlternateContact":   {},
+		"GetContactInformation": {},
+		"GetPrimaryEmail":       {},
+		"GetRegionOptStatus":    {},
+		"ListRegions":           {},
+	},
+	names.Amplify: {
+		"GetApp":                  {},
+		"GetArtifactUrl":          {},
+		"GetBackendEnvironment":   {},
+		"GetBranch":               {},
+		"GetDomainAssociation":    {},
+		"GetJob":                  {},
+		"GetWebhook":              {},
+		"ListApps":                {},
+		"ListArtifacts":           {},
+		"ListBackendEnvironments": {},
+		"ListBranches":            {},
+		"ListDomainAssociations":  {},
+		"ListJobs":                {},
+		"ListTagsForResource":     {},
+		"ListWebhooks":            {},
+	},
+	names.AppAutoScaling: {
+		"DescribeScalableTargets":      {},
+		"DescribeScalingActivities":    {},
+		"DescribeScalingPolicies":      {},
+		"DescribeScheduledActions":     {},
+		"GetPredictiveScalingForecast": {},
+		"ListTagsForResource":          {},
+	},
+	names.AppConfig: {
+		"GetAccountSettings":              {},
+		"GetApplication":                  {},
+		"GetConfiguration":                {},
+		"GetConfigurationProfile":         {},
+		"GetDeployment":                   {},
+		"GetDeploymentStrategy":           {},
+		"GetEnvironment":                  {},
+		"GetExtension":                    {},
+		"GetExtensionAssociation":         {},
+		"GetHostedConfigurationVersion":   {},
+		"ListApplications":                {},
+		"ListConfigurationProfiles":       {},
+		"ListDeploymentStrategies":        {},
+		"ListDeployments":                 {},
+		"ListEnvironments":                {},
+		"ListExtensionAssociations":       {},
+		"ListExtensions":                  {},
+		"ListHostedConfigurationVersions": {},
+		"ListTagsForResource":             {},
+		"ValidateConfiguration":           {},
+	},
+	names.AppFabric: {
+		"BatchGetUserAccessTasks":   {},
+		"GetAppAuthorization":       {},
+		"GetAppBundle":              {},
+		"GetIngestion":              {},
+		"GetIngestionDestination":   {},
+		"ListAppAuthorizations":     {},
+		"ListAppBundles":            {},
+		"ListIngestionDestinations": {},
+		"ListIngestions":            {},
+		"ListTagsForResource":       {},
+	},
+	names.AppFlow: {
+		"DescribeConnector":            {},
+		"DescribeConnectorEntity":      {},
+		"DescribeConnectorProfiles":    {},
+		"DescribeConnectors":           {},
+		"DescribeFlow":                 {},
+		"DescribeFlowExecutionRecords": {},
+		"ListConnectorEntities":        {},
+		"ListConnectors":               {},
+		"ListFlows":                    {},
+		"ListTagsForResource":          {},
+	},
+	names.AppIntegrations: {
+		"GetApplication":                   {},
+		"GetDataIntegration":               {},
+		"GetEventIntegration":              {},
+		"ListApplicationAssociations":      {},
+		"ListApplications":                 {},
+		"ListDataIntegrationAssociations":  {},
+		"ListDataIntegrations":             {},
+		"ListEventIntegrationAssociations": {},
+		"ListEventIntegrations":            {},
+		"ListTagsForResource":              {},
+	},
+	names.AppMesh: {
+		"DescribeGatewayRoute":   {},
+		"DescribeMesh":           {},
+		"DescribeRoute":          {},
+		"DescribeVirtualGateway": {},
+		"DescribeVirtualNode":    {},
+		"DescribeVirtualRouter":  {},
+		"DescribeVirtualService": {},
+		"ListGatewayRoutes":      {},
+		"ListMeshes":             {},
+		"ListRoutes":             {},
+		"ListTagsForResource":    {},
+		"ListVirtualGateways":    {},
+		"ListVirtualNodes":       {},
+		"ListVirtualRouters":     {},
+		"ListVirtualServices":    {},
+	},
+	names.AppRunner: {
+		"DescribeAutoScalingConfiguration":        {},
+		"DescribeCustomDomains":                   {},
+		"DescribeObservabilityConfiguration":      {},
+		"DescribeService":                         {},
+		"DescribeVpcConnector":                    {},
+		"DescribeVpcIngressConnection":            {},
+		"ListAutoScalingConfigurations":           {},
+		"ListConnections":                         {},
+		"ListObservabilityConfigurations":         {},
+		"ListOperations":                          {},
+		"ListServices":                            {},
+		"ListServicesForAutoScalingConfiguration": {},
+		"ListTagsForResource":                     {},
+		"ListVpcConnectors":                       {},
+		"ListVpcIngressConnections":               {},
+	},
+	names.AppStream: {
+		"DescribeAppBlockBuilderAppBlockAssociations": {},
+		"DescribeAppBlockBuilders":                    {},
+		"DescribeAppBlocks":                           {},
+		"DescribeAppLicenseUsage":                     {},
+		"DescribeApplicationFleetAssociations":        {},
+		"DescribeApplications":                        {},
+		"DescribeDirectoryConfigs":                    {},
+		"DescribeEntitlements":                        {},
+		"DescribeFleets":                              {},
+		"DescribeImageBuilders":                       {},
+		"DescribeImagePermissions":                    {},
+		"DescribeImages":                              {},
+		"DescribeSessions":                            {},
+		"DescribeSoftwareAssociations":                {},
+		"DescribeStacks":                              {},
+		"DescribeThemeForStack":                       {},
+		"DescribeUsageReportSubscriptions":            {},
+		"DescribeUserStackAssociations":               {},
+		"DescribeUsers":                               {},
+		"ListAssociatedFleets":                        {},
+		"ListAssociatedStacks":                        {},
+		"ListEntitledApplications":                    {},
+		"ListTagsForResource":                         {},
+	},
+	names.AppSync: {
+		"GetApi":                            {},
+		"GetApiAssociation":                 {},
+		"GetApiCache":                       {},
+		"GetChannelNamespace":               {},
+		"GetDataSource":                     {},
+		"GetDataSourceIntrospection":        {},
+		"GetDomainName":                     {},
+		"GetFunction":                       {},
+		"GetGraphqlApi":                     {},
+		"GetGraphqlApiEnvironmentVariables": {},
+		"GetIntrospectionSchema":            {},
+		"GetResolver":                       {},
+		"GetSchemaCreationStatus":           {},
+		"GetSourceApiAssociation":           {},
+		"GetType":                           {},
+		"ListApiKeys":                       {},
+		"ListApis":                          {},
+		"ListChannelNamespaces":             {},
+		"ListDataSources":                   {},
+		"ListDomainNames":                   {},
+		"ListFunctions":                     {},
+		"ListGraphqlApis":                   {},
+		"ListResolvers":                     {},
+		"ListResolversByFunction":           {},
+		"ListSourceApiAssociations":         {},
+		"ListTagsForResource":               {},
+		"ListTypes":                         {},
+		"ListTypesByAssociation":            {},
+	},
+	names.ApplicationInsights: {
+		"DescribeApplication":                          {},
+		"DescribeComponent":                            {},
+		"DescribeComponentConfiguration":               {},
+		"DescribeComponentConfigurationRecommendation": {},
+		"DescribeLogPattern":                           {},
+		"DescribeObservation":                          {},
+		"DescribeProblem":                              {},
+		"DescribeProblemObservations":                  {},
+		"DescribeWorkload":                             {},
+		"ListApplications":                             {},
+		"ListComponents":                               {},
+		"ListConfigurationHistory":                     {},
+		"ListLogPatternSets":                           {},
+		"ListLogPatterns":                              {},
+		"ListProblems":                                 {},
+		"ListTagsForResource":                          {},
+		"ListWorkloads":                                {},
+	},
+	names.ApplicationSignals: {
+		"BatchGetServiceLevelObjectiveBudgetReport": {},
+		"GetService":                                {},
+		"GetServiceLevelObjective":                  {},
+		"ListAuditFindings":                         {},
+		"ListGroupingAttributeDefinitions":          {},
+		"ListServiceDependencies":                   {},
+		"ListServiceDependents":                     {},
+		"ListServiceLevelObjectiveExclusionWindows": {},
+		"ListServiceLevelObjectives":                {},
+		"ListServiceOperations":                     {},
+		"ListServiceStates":                         {},
+		"ListServices":                              {},
+		"ListTagsForResource":                       {},
+	},
+	names.Athena: {
+		"BatchGetNamedQuery":                 {},
+		"BatchGetPreparedStatement":          {},
+		"BatchGetQueryExecution":             {},
+		"GetCalculationExecution":            {},
+		"GetCalculationExecutionCode":        {},
+		"GetCalculationExecutionStatus":      {},
+		"GetCapacityAssignmentConfiguration": {},
+		"GetCapacityReservation":             {},
+		"GetDataCatalog":                     {},
+		"GetDatabase":                        {},
+		"GetNamedQuery":                      {},
+		"GetNotebookMetadata":                {},
+		"GetPreparedStatement":               {},
+		"GetQueryExecution":                  {},
+		"GetQueryResults":                    {},
+		"GetQueryRuntimeStatistics":          {},
+		"GetSession":                         {},
+		"GetSessionStatus":                   {},
+		"GetTableMetadata":                   {},
+		"GetWorkGroup":                       {},
+		"ListApplicationDPUSizes":            {},
+		"ListCalculationExecutions":          {},
+		"ListCapacityReservations":           {},
+		"ListDataCatalogs":                   {},
+		"ListDatabases":                      {},
+		"ListEngineVersions":                 {},
+		"ListExecutors":                      {},
+		"ListNamedQueries":                   {},
+		"ListNotebookMetadata":               {},
+		"ListNotebookSessions":               {},
+		"ListPreparedStatements":             {},
+		"ListQueryExecutions":                {},
+		"ListSessions":                       {},
+		"ListTableMetadata":                  {},
+		"ListTagsForResource":                {},
+		"ListWorkGroups":                     {},
+	},
+	names.AuditManager: {
+		"GetAccountStatus":                             {},
+		"GetAssessment":                                {},
+		"GetAssessmentFramework":                       {},
+		"GetAssessmentReportUrl":                       {},
+		"GetChangeLogs":                                {},
+		"GetControl":                                   {},
+		"GetDelegations":                               {},
+		"GetEvidence":                                  {},
+		"GetEvidenceByEvidenceFolder":                  {},
+		"GetEvidenceFileUploadUrl":                     {},
+		"GetEvidenceFolder":                            {},
+		"GetEvidenceFoldersByAssessment":               {},
+		"GetEvidenceFoldersByAssessmentControl":        {},
+		"GetInsights":                                  {},
+		"GetInsightsByAssessment":                      {},
+		"GetOrganizationAdminAccount":                  {},
+		"GetServicesInScope":                           {},
+		"GetSettings":                                  {},
+		"ListAssessmentControlInsightsByControlDomain": {},
+		"ListAssessmentFrameworkShareRequests":         {},
+		"ListAssessmentFrameworks":                     {},
+		"ListAssessmentReports":                        {},
+		"ListAssessments":                              {},
+		"ListControlDomainInsights":                    {},
+		"ListControlDomainInsightsByAssessment":        {},
+		"ListControlInsightsByControlDomain":           {},
+		"ListControls":                                 {},
+		"ListKeywordsForDataSource":                    {},
+		"ListNotifications":                            {},
+		"ListTagsForResource":                          {},
+		"ValidateAssessmentReportIntegrity":            {},
+	},
+	names.AutoScaling: {
+		"DescribeAccountLimits":                {},
+		"DescribeAdjustmentTypes":              {},
+		"DescribeAutoScalingGroups":            {},
+		"DescribeAutoScalingGroups_test":       {},
+		"DescribeAutoScalingInstances":         {},
+		"DescribeAutoScalingNotificationTypes": {},
+		"DescribeInstanceRefreshes":            {},
+		"DescribeLaunchConfigurations":         {},
+		"DescribeLifecycleHookTypes":           {},
+		"DescribeLifecycleHooks":               {},
+		"DescribeLoadBalancerTargetGroups":     {},
+		"DescribeLoadBalancers":                {},
+		"DescribeMetricCollectionTypes":        {},
+		"DescribeNotificationConfigurations":   {},
+		"DescribePolicies":                     {},
+		"DescribeScalingActivities":            {},
+		"DescribeScalingProcessTypes":          {},
+		"DescribeScheduledActions":             {},
+		"DescribeTags":                         {},
+		"DescribeTerminationPolicyTypes":       {},
+		"DescribeTrafficSources":               {},
+		"DescribeWarmPool":                     {},
+		"GetPredictiveScalingForecast":         {},
+	},
+	names.AutoScalingPlans: {
+		"DescribeScalingPlanResources":       {},
+		"DescribeScalingPlans":               {},
+		"GetScalingPlanResourceForecastData": {},
+	},
+	names.BCMDataExports: {
+		"GetExecution":        {},
+		"GetExport":           {},
+		"GetTable":            {},
+		"ListExecutions":      {},
+		"ListExports":         {},
+		"ListTables":          {},
+		"ListTagsForResource": {},
+	},
+	names.Backup: {
+		"DescribeBackupJob":                   {},
+		"DescribeBackupVault":                 {},
+		"DescribeCopyJob":                     {},
+		"DescribeFramework":                   {},
+		"DescribeGlobalSettings":              {},
+		"DescribeProtectedResource":           {},
+		"DescribeRecoveryPoint":               {},
+		"DescribeRegionSettings":              {},
+		"DescribeReportJob":                   {},
+		"DescribeReportPlan":                  {},
+		"DescribeRestoreJob":                  {},
+		"GetBackupPlan":                       {},
+		"GetBackupPlanFromJSON":               {},
+		"GetBackupPlanFromTemplate":           {},
+		"GetBackupSelection":                  {},
+		"GetBackupVaultAccessPolicy":          {},
+		"GetBackupVaultNotifications":         {},
+		"GetLegalHold":                        {},
+		"GetRecoveryPointIndexDetails":        {},
+		"GetRecoveryPointRestoreMetadata":     {},
+		"GetRestoreJobMetadata":               {},
+		"GetRestoreTestingInferredMetadata":   {},
+		"GetRestoreTestingPlan":               {},
+		"GetRestoreTestingSelection":          {},
+		"GetSupportedResourceTypes":           {},
+		"ListBackupJobSummaries":              {},
+		"ListBackupJobs":                      {},
+		"ListBackupPlanTemplates":             {},
+		"ListBackupPlanVersions":              {},
+		"ListBackupPlans":                     {},
+		"ListBackupSelections":                {},
+		"ListBackupVaults":                    {},
+		"ListCopyJobSummaries":                {},
+		"ListCopyJobs":                        {},
+		"ListFrameworks":                      {},
+		"ListIndexedRecoveryPoints":           {},
+		"ListLegalHolds":                      {},
+		"ListProtectedResources":              {},
+		"ListProtectedResourcesByBackupVault": {},
+		"ListRecoveryPointsByBackupVault":     {},
+		"ListRecoveryPointsByLegalHold":       {},
+		"ListRecoveryPointsByResource":        {},
+		"ListReportJobs":                      {},
+		"ListReportPlans":                     {},
+		"ListRestoreAccessBackupVaults":       {},
+		"ListRestoreJobSummaries":             {},
+		"ListRestoreJobs":                     {},
+		"ListRestoreJobsByProtectedResource":  {},
+		"ListRestoreTestingPlans":             {},
+		"ListRestoreTestingSelections":        {},
+		"ListTags":                            {},
+	},
+	names.Batch: {
+		"DescribeComputeEnvironments":  {},
+		"DescribeConsumableResource":   {},
+		"DescribeJobDefinitions":       {},
+		"DescribeJobQueues":            {},
+		"DescribeJobs":                 {},
+		"DescribeSchedulingPolicies":   {},
+		"DescribeServiceEnvironments":  {},
+		"DescribeServiceJob":           {},
+		"GetJobQueueSnapshot":          {},
+		"ListConsumableResources":      {},
+		"ListJobs":                     {},
+		"ListJobsByConsumableResource": {},
+		"ListSchedulingPolicies":       {},
+		"ListServiceJobs":              {},
+		"ListTagsForResource":          {},
+	},
+	names.Bedrock: {
+		"GetAutomatedReasoningPolicy":                          {},
+		"GetAutomatedReasoningPolicyAnnotations":               {},
+		"GetAutomatedReasoningPolicyBuildWorkflow":             {},
+		"GetAutomatedReasoningPolicyBuildWorkflowResultAssets": {},
+		"GetAutomatedReasoningPolicyNextScenario":              {},
+		"GetAutomatedReasoningPolicyTestCase":                  {},
+		"GetAutomatedReasoningPolicyTestResult":                {},
+		"GetCustomModel":                                       {},
+		"GetCustomModelDeployment":                             {},
+		"GetEvaluationJob":                                     {},
+		"GetFoundationModel":                                   {},
+		"GetFoundationModelAvailability":                       {},
+		"GetGuardrail":                                         {},
+		"GetImportedModel":                                     {},
+		"GetInferenceProfile":                                  {},
+		"GetMarketplaceModelEndpoint":                          {},
+		"GetModelCopyJob":                                      {},
+		"GetModelCustomizationJob":                             {},
+		"GetModelImportJob":                                    {},
+		"GetModelInvocationJob":                                {},
+		"GetModelInvocationLoggingConfiguration":               {},
+		"GetPromptRouter":                                      {},
+		"GetProvisionedModelThroughput":                        {},
+		"GetUseCaseForModelAccess":                             {},
+		"ListAutomatedReasoningPolicies":                       {},
+		"ListAutomatedReasoningPolicyBuildWorkflows":           {},
+		"ListAutomatedReasoningPolicyTestCases":                {},
+		"ListAutomatedReasoningPolicyTestResults":              {},
+		"ListCustomModelDeployments":                           {},
+		"ListCustomModels":                                     {},
+		"ListEvaluationJobs":                                   {},
+		"ListFoundationModelAgreementOffers":                   {},
+		"ListFoundationModels":                                 {},
+		"ListGuardrails":                                       {},
+		"ListImportedModels":                                   {},
+		"ListInferenceProfiles":                                {},
+		"ListMarketplaceModelEndpoints":                        {},
+		"ListModelCopyJobs":                                    {},
+		"ListModelCustomizationJobs":                           {},
+		"ListModelImportJobs":                                  {},
+		"ListModelInvocationJobs":                              {},
+		"ListPromptRouters":                                    {},
+		"ListProvisionedModelThroughputs":                      {},
+		"ListTagsForResource":                                  {},
+	},
+	names.BedrockAgent: {
+		"GetAgent":                   {},
+		"GetAgentActionGroup":        {},
+		"GetAgentAlias":              {},
+		"GetAgentCollaborator":       {},
+		"GetAgentKnowledgeBase":      {},
+		"GetAgentVersion":            {},
+		"GetDataSource":              {},
+		"GetFlow":                    {},
+		"GetFlowAlias":               {},
+		"GetFlowVersion":             {},
+		"GetIngestionJob":            {},
+		"GetKnowledgeBase":           {},
+		"GetKnowledgeBaseDocuments":  {},
+		"GetPrompt":                  {},
+		"ListAgentActionGroups":      {},
+		"ListAgentAliases":           {},
+		"ListAgentCollaborators":     {},
+		"ListAgentKnowledgeBases":    {},
+		"ListAgentVersions":          {},
+		"ListAgents":                 {},
+		"ListDataSources":            {},
+		"ListFlowAliases":            {},
+		"ListFlowVersions":           {},
+		"ListFlows":                  {},
+		"ListIngestionJobs":          {},
+		"ListKnowledgeBaseDocuments": {},
+		"ListKnowledgeBases":         {},
+		"ListPrompts":                {},
+		"ListTagsForResource":        {},
+		"ValidateFlowDefinition":     {},
+	},
+	names.BedrockAgentCore: {
+		"GetAgentRuntime":               {},
+		"GetAgentRuntimeEndpoint":       {},
+		"GetApiKeyCredentialProvider":   {},
+		"GetBrowser":                    {},
+		"GetCodeInterpreter":            {},
+		"GetGateway":                    {},
+		"GetGatewayTarget":              {},
+		"GetMemory":                     {},
+		"GetOauth2CredentialProvider":   {},
+		"GetTokenVault":                 {},
+		"GetWorkloadIdentity":           {},
+		"ListAgentRuntimeEndpoints":     {},
+		"ListAgentRuntimeVersions":      {},
+		"ListAgentRuntimes":             {},
+		"ListApiKeyCredentialProviders": {},
+		"ListBrowsers":                  {},
+		"ListCodeInterpreters":          {},
+		"ListGatewayTargets":            {},
+		"ListGateways":                  {},
+		"ListMemories":                  {},
+		"ListOauth2CredentialProviders": {},
+		"ListTagsForResource":           {},
+		"ListWorkloadIdentities":        {},
+	},
+	names.Billing: {
+		"GetBillingView":                {},
+		"GetResourcePolicy":             {},
+		"ListBillingViews":              {},
+		"ListSourceViewsForBillingView": {},
+		"ListTagsForResource":           {},
+	},
+	names.Budgets: {
+		"DescribeBudget":                        {},
+		"DescribeBudgetAction":                  {},
+		"DescribeBudgetActionHistories":         {},
+		"DescribeBudgetActionsForAccount":       {},
+		"DescribeBudgetActionsForBudget":        {},
+		"DescribeBudgetNotificationsForAccount": {},
+		"DescribeBudgetPerformanceHistory":      {},
+		"DescribeBudgets":                       {},
+		"DescribeNotificationsForBudget":        {},
+		"DescribeSubscribersForNotification":    {},
+		"ListTagsForResource":                   {},
+	},
+	names.CE: {
+		"DescribeCostCategoryDefinition":                   {},
+		"GetAnomalies":                                     {},
+		"GetAnomalyMonitors":                               {},
+		"GetAnomalySubscriptions":                          {},
+		"GetApproximateUsageRecords":                       {},
+		"GetCommitmentPurchaseAnalysis":                    {},
+		"GetCostAndUsage":                                  {},
+		"GetCostAndUsageComparisons":                       {},
+		"GetCostAndUsageWithResources":                     {},
+		"GetCostCategories":                                {},
+		"GetCostComparisonDrivers":                         {},
+		"GetCostForecast":                                  {},
+		"GetDimensionValues":                               {},
+		"GetReservationCoverage":                           {},
+		"GetReservationPurchaseRecommendation":             {},
+		"GetReservationUtilization":                        {},
+		"GetRightsizingRecommendation":                     {},
+		"GetSavingsPlanPurchaseRecommendationDetails":      {},
+		"GetSavingsPlansCoverage":                          {},
+		"GetSavingsPlansPurchaseRecommendation":            {},
+		"GetSavingsPlansUtilization":                       {},
+		"GetSavingsPlansUtilizationDetails":                {},
+		"GetTags":                                          {},
+		"GetUsageForecast":                                 {},
+		"ListCommitmentPurchaseAnalyses":                   {},
+		"ListCostAllocationTagBackfillHistory":             {},
+		"ListCostAllocationTags":                           {},
+		"ListCostCategoryDefinitions":                      {},
+		"ListSavingsPlansPurchaseRecommendationGeneration": {},
+		"ListTagsForResource":                              {},
+	},
+	names.CUR: {
+		"DescribeReportDefinitions": {},
+		"ListTagsForResource":       {},
+	},
+	names.Chatbot: {
+		"DescribeChimeWebhookConfigurations":      {},
+		"DescribeSlackChannelConfigurations":      {},
+		"DescribeSlackUserIdentities":             {},
+		"DescribeSlackWorkspaces":                 {},
+		"GetAccountPreferences":                   {},
+		"GetCustomAction":                         {},
+		"GetMicrosoftTeamsChannelConfiguration":   {},
+		"ListAssociations":                        {},
+		"ListCustomActions":                       {},
+		"ListMicrosoftTeamsChannelConfigurations": {},
+		"ListMicrosoftTeamsConfiguredTeams":       {},
+		"ListMicrosoftTeamsUserIdentities":        {},
+		"ListTagsForResource":                     {},
+	},
+	names.Chime: {
+		"GetAccount":                        {},
+		"GetAccountSettings":                {},
+		"GetBot":                            {},
+		"GetEventsConfiguration":            {},
+		"GetGlobalSettings":                 {},
+		"GetPhoneNumber":                    {},
+		"GetPhoneNumberOrder":               {},
+		"GetPhoneNumberSettings":            {},
+		"GetRetentionSettings":              {},
+		"GetRoom":                           {},
+		"GetUser":                           {},
+		"GetUserSettings":                   {},
+		"ListAccounts":                      {},
+		"ListBots":                          {},
+		"ListPhoneNumberOrders":             {},
+		"ListPhoneNumbers":                  {},
+		"ListRoomMemberships":               {},
+		"ListRooms":                         {},
+		"ListSupportedPhoneNumberCountries": {},
+		"ListUsers":                         {},
+		"SearchAvailablePhoneNumbers":       {},
+	},
+	names.ChimeSDKMediaPipelines: {
+		"GetMediaCapturePipeline":                  {},
+		"GetMediaInsightsPipelineConfiguration":    {},
+		"GetMediaPipeline":                         {},
+		"GetMediaPipelineKinesisVideoStreamPool":   {},
+		"GetSpeakerSearchTask":                     {},
+		"GetVoiceToneAnalysisTask":                 {},
+		"ListMediaCapturePipelines":                {},
+		"ListMediaInsightsPipelineConfigurations":  {},
+		"ListMediaPipelineKinesisVideoStreamPools": {},
+		"ListMediaPipelines":                       {},
+		"ListTagsForResource":                      {},
+	},
+	names.ChimeSDKVoice: {
+		"GetGlobalSettings":                              {},
+		"GetPhoneNumber":                                 {},
+		"GetPhoneNumberOrder":                            {},
+		"GetPhoneNumberSettings":                         {},
+		"GetProxySession":                                {},
+		"GetSipMediaApplication":                         {},
+		"GetSipMediaApplicationAlexaSkillConfiguration":  {},
+		"GetSipMediaApplicationLoggingConfiguration":     {},
+		"GetSipRule":                                     {},
+		"GetSpeakerSearchTask":                           {},
+		"GetVoiceConnector":                              {},
+		"GetVoiceConnectorEmergencyCallingConfiguration": {},
+		"GetVoiceConnectorExternalSystemsConfiguration":  {},
+		"GetVoiceConnectorGroup":                         {},
+		"GetVoiceConnectorLoggingConfiguration":          {},
+		"GetVoiceConnectorOrigination":                   {},
+		"GetVoiceConnectorProxy":                         {},
+		"GetVoiceConnectorStreamingConfiguration":        {},
+		"GetVoiceConnectorTermination":                   {},
+		"GetVoiceConnectorTerminationHealth":             {},
+		"GetVoiceProfile":                                {},
+		"GetVoiceProfileDomain":                          {},
+		"GetVoiceToneAnalysisTask":                       {},
+		"ListAvailableVoiceConnectorRegions":             {},
+		"ListPhoneNumberOrders":                          {},
+		"ListPhoneNumbers":                               {},
+		"ListProxySessions":                              {},
+		"ListSipMediaApplications":                       {},
+		"ListSipRules":                                   {},
+		"ListSupportedPhoneNumberCountries":              {},
+		"ListTagsForResource":                            {},
+		"ListVoiceConnectorGroups":                       {},
+		"ListVoiceConnectorTerminationCredentials":       {},
+		"ListVoiceConnectors":                            {},
+		"ListVoiceProfileDomains":                        {},
+		"ListVoiceProfiles":                              {},
+		"SearchAvailablePhoneNumbers":                    {},
+		"ValidateE911Address":                            {},
+	},
+	names.CleanRooms: {
+		"BatchGetCollaborationAnalysisTemplate":                {},
+		"BatchGetSchema":                                       {},
+		"BatchGetSchemaAnalysisRule":                           {},
+		"GetAnalysisTemplate":                                  {},
+		"GetCollaboration":                                     {},
+		"GetCollaborationAnalysisTemplate":                     {},
+		"GetCollaborationChangeRequest":                        {},
+		"GetCollaborationConfiguredAudienceModelAssociation":   {},
+		"GetCollaborationIdNamespaceAssociation":               {},
+		"GetCollaborationPrivacyBudgetTemplate":                {},
+		"GetConfiguredAudienceModelAssociation":                {},
+		"GetConfiguredTable":                                   {},
+		"GetConfiguredTableAnalysisRule":                       {},
+		"GetConfiguredTableAssociation":                        {},
+		"GetConfiguredTableAssociationAnalysisRule":            {},
+		"GetIdMappingTable":                                    {},
+		"GetIdNamespaceAssociation":                            {},
+		"GetMembership":                                        {},
+		"GetPrivacyBudgetTemplate":                             {},
+		"GetProtectedJob":                                      {},
+		"GetProtectedQuery":                                    {},
+		"GetSchema":                                            {},
+		"GetSchemaAnalysisRule":                                {},
+		"ListAnalysisTemplates":                                {},
+		"ListCollaborationAnalysisTemplates":                   {},
+		"ListCollaborationChangeRequests":                      {},
+		"ListCollaborationConfiguredAudienceModelAssociations": {},
+		"ListCollaborationIdNamespaceAssociations":             {},
+		"ListCollaborationPrivacyBudgetTemplates":              {},
+		"ListCollaborationPrivacyBudgets":                      {},
+		"ListCollaborations":                                   {},
+		"ListConfiguredAudienceModelAssociations":              {},
+		"ListConfiguredTableAssociations":                      {},
+		"ListConfiguredTables":                                 {},
+		"ListIdMappingTables":                                  {},
+		"ListIdNamespaceAssociations":                          {},
+		"ListMembers":                                          {},
+		"ListMemberships":                                      {},
+		"ListPrivacyBudgetTemplates":                           {},
+		"ListPrivacyBudgets":                                   {},
+		"ListProtectedJobs":                                    {},
+		"ListProtectedQueries":                                 {},
+		"ListSchemas":                                          {},
+		"ListTagsForResource":                                  {},
+	},
+	names.Cloud9: {
+		"DescribeEnvironmentMemberships": {},
+		"DescribeEnvironmentStatus":      {},
+		"DescribeEnvironments":           {},
+		"ListEnvironments":               {},
+		"ListTagsForResource":            {},
+	},
+	names.CloudControl: {
+		"GetResource":              {},
+		"GetResourceRequestStatus": {},
+		"ListResourceRequests":     {},
+		"ListResources":            {},
+	},
+	names.CloudFormation: {
+		"BatchDescribeTypeConfigurations":   {},
+		"DescribeAccountLimits":             {},
+		"DescribeChangeSet":                 {},
+		"DescribeChangeSetHooks":            {},
+		"DescribeGeneratedTemplate":         {},
+		"DescribeOrganizationsAccess":       {},
+		"DescribePublisher":                 {},
+		"DescribeResourceScan":              {},
+		"DescribeStackDriftDetectionStatus": {},
+		"DescribeStackEvents":               {},
+		"DescribeStackInstance":             {},
+		"DescribeStackRefactor":             {},
+		"DescribeStackResource":             {},
+		"DescribeStackResourceDrifts":       {},
+		"DescribeStackResources":            {},
+		"DescribeStackSet":                  {},
+		"DescribeStackSetOperation":         {},
+		"DescribeStacks":                    {},
+		"DescribeType":                      {},
+		"DescribeTypeRegistration":          {},
+		"GetGeneratedTemplate":              {},
+		"GetStackPolicy":                    {},
+		"GetTemplate":                       {},
+		"GetTemplateSummary":                {},
+		"ListChangeSets":                    {},
+		"ListExports":                       {},
+		"ListGeneratedTemplates":            {},
+		"ListHookResults":                   {},
+		"ListImports":                       {},
+		"ListResourceScanRelatedResources":  {},
+		"ListResourceScanResources":         {},
+		"ListResourceScans":                 {},
+		"ListStackInstanceResourceDrifts":   {},
+		"ListStackInstances":                {},
+		"ListStackRefactorActions":          {},
+		"ListStackRefactors":                {},
+		"ListStackResources":                {},
+		"ListStackSetAutoDeploymentTargets": {},
+		"ListStackSetOperationResults":      {},
+		"ListStackSetOperations":            {},
+		"ListStackSets":                     {},
+		"ListStacks":                        {},
+		"ListTypeRegistrations":             {},
+		"ListTypeVersions":                  {},
+		"ListTypes":                         {},
+		"ValidateTemplate":                  {},
+	},
+	names.CloudFront: {
+		"DescribeFunction":                           {},
+		"DescribeKeyValueStore":                      {},
+		"GetAnycastIpList":                           {},
+		"GetCachePolicy":                             {},
+		"GetCachePolicyConfig":                       {},
+		"GetCloudFrontOriginAccessIdentity":          {},
+		"GetCloudFrontOriginAccessIdentityConfig":    {},
+		"GetConnectionGroup":                         {},
+		"GetConnectionGroupByRoutingEndpoint":        {},
+		"GetContinuousDeploymentPolicy":              {},
+		"GetContinuousDeploymentPolicyConfig":        {},
+		"GetDistribution":                            {},
+		"GetDistributionConfig":                      {},
+		"GetDistributionTenant":                      {},
+		"GetDistributionTenantByDomain":              {},
+		"GetFieldLevelEncryption":                    {},
+		"GetFieldLevelEncryptionConfig":              {},
+		"GetFieldLevelEncryptionProfile":             {},
+		"GetFieldLevelEncryptionProfileConfig":       {},
+		"GetFunction":                                {},
+		"GetInvalidation":                            {},
+		"GetInvalidationForDistributionTenant":       {},
+		"GetKeyGroup":                                {},
+		"GetKeyGroupConfig":                          {},
+		"GetManagedCertificateDetails":               {},
+		"GetMonitoringSubscription":                  {},
+		"GetOriginAccessControl":                     {},
+		"GetOriginAccessControlConfig":               {},
+		"GetOriginRequestPolicy":                     {},
+		"GetOriginRequestPolicyConfig":               {},
+		"GetPublicKey":                               {},
+		"GetPublicKeyConfig":                         {},
+		"GetRealtimeLogConfig":                       {},
+		"GetResponseHeadersPolicy":                   {},
+		"GetResponseHeadersPolicyConfig":             {},
+		"GetStreamingDistribution":                   {},
+		"GetStreamingDistributionConfig":             {},
+		"GetVpcOrigin":                               {},
+		"ListAnycastIpLists":                         {},
+		"ListCachePolicies":                          {},
+		"ListCloudFrontOriginAccessIdentities":       {},
+		"ListConflictingAliases":                     {},
+		"ListConnectionGroups":                       {},
+		"ListContinuousDeploymentPolicies":           {},
+		"ListDistributionTenants":                    {},
+		"ListDistributionTenantsByCustomization":     {},
+		"ListDistributions":                          {},
+		"ListDistributionsByAnycastIpListId":         {},
+		"ListDistributionsByCachePolicyId":           {},
+		"ListDistributionsByConnectionMode":          {},
+		"ListDistributionsByKeyGroup":                {},
+		"ListDistributionsByOriginRequestPolicyId":   {},
+		"ListDistributionsByRealtimeLogConfig":       {},
+		"ListDistributionsByResponseHeadersPolicyId": {},
+		"ListDistributionsByVpcOriginId":             {},
+		"ListDistributionsByWebACLId":                {},
+		"ListDomainConflicts":                        {},
+		"ListFieldLevelEncryptionConfigs":            {},
+		"ListFieldLevelEncryptionProfiles":           {},
+		"ListFunctions":                              {},
+		"ListInvalidations":                          {},
+		"ListInvalidationsForDistributionTenant":     {},
+		"ListKeyGroups":                              {},
+		"ListKeyValueStores":                         {},
+		"ListOriginAccessControls":                   {},
+		"ListOriginRequestPolicies":                  {},
+		"ListPublicKeys":                             {},
+		"ListRealtimeLogConfigs":                     {},
+		"ListResponseHeadersPolicies":                {},
+		"ListStreamingDistributions":                 {},
+		"ListTagsForResource":                        {},
+		"ListVpcOrigins":                             {},
+		"VerifyDnsConfiguration":                     {},
+	},
+	names.CloudFrontKeyValueStore: {
+		"DescribeKeyValueStore": {},
+		"GetKey":                {},
+		"ListKeys":              {},
+	},
+	names.CloudHSMV2: {
+		"DescribeBackups":   {},
+		"DescribeClusters":  {},
+		"GetResourcePolicy": {},
+		"ListTags":          {},
+	},
+	names.CloudSearch: {
+		"DescribeAnalysisSchemes":       {},
+		"DescribeAvailabilityOptions":   {},
+		"DescribeDomainEndpointOptions": {},
+		"DescribeDomains":               {},
+		"DescribeExpressions":           {},
+		"DescribeIndexFields":           {},
+		"DescribeScalingParameters":     {},
+		"DescribeServiceAccessPolicies": {},
+		"DescribeSuggesters":            {},
+		"ListDomainNames":               {},
+	},
+	names.CloudTrail: {
+		"DescribeQuery":          {},
+		"DescribeTrails":         {},
+		"GetChannel":             {},
+		"GetDashboard":           {},
+		"GetEventConfiguration":  {},
+		"GetEventDataStore":      {},
+		"GetEventSelectors":      {},
+		"GetImport":              {},
+		"GetInsightSelectors":    {},
+		"GetQueryResults":        {},
+		"GetResourcePolicy":      {},
+		"GetTrail":               {},
+		"GetTrailStatus":         {},
+		"ListChannels":           {},
+		"ListDashboards":         {},
+		"ListEventDataStores":    {},
+		"ListImportFailures":     {},
+		"ListImports":            {},
+		"ListInsightsMetricData": {},
+		"ListPublicKeys":         {},
+		"ListQueries":            {},
+		"ListTags":               {},
+		"ListTrails":             {},
+		"LookupEvents":           {},
+		"SearchSampleQueries":    {},
+	},
+	names.CloudWatch: {
+		"DescribeAlarmContributors": {},
+		"DescribeAlarmHistory":      {},
+		"DescribeAlarms":            {},
+		"DescribeAlarmsForMetric":   {},
+		"DescribeAnomalyDetectors":  {},
+		"DescribeInsightRules":      {},
+		"GetDashboard":              {},
+		"GetInsightRuleReport":      {},
+		"GetMetricData":             {},
+		"GetMetricStatistics":       {},
+		"GetMetricStream":           {},
+		"GetMetricWidgetImage":      {},
+		"ListDashboards":            {},
+		"ListManagedInsightRules":   {},
+		"ListMetricStreams":         {},
+		"ListMetrics":               {},
+		"ListTagsForResource":       {},
+	},
+	names.CodeArtifact: {
+		"DescribeDomain":                  {},
+		"DescribePackage":                 {},
+		"DescribePackageGroup":            {},
+		"DescribePackageVersion":          {},
+		"DescribeRepository":              {},
+		"GetAssociatedPackageGroup":       {},
+		"GetDomainPermissionsPolicy":      {},
+		"GetPackageVersionAsset":          {},
+		"GetPackageVersionReadme":         {},
+		"GetRepositoryEndpoint":           {},
+		"GetRepositoryPermissionsPolicy":  {},
+		"ListAllowedRepositoriesForGroup": {},
+		"ListAssociatedPackages":          {},
+		"ListDomains":                     {},
+		"ListPackageGroups":               {},
+		"ListPackageVersionAssets":        {},
+		"ListPackageVersionDependencies":  {},
+		"ListPackageVersions":             {},
+		"ListPackages":                    {},
+		"ListRepositories":                {},
+		"ListRepositoriesInDomain":        {},
+		"ListSubPackageGroups":            {},
+		"ListTagsForResource":             {},
+	},
+	names.CodeBuild: {
+		"BatchGetBuildBatches":            {},
+		"BatchGetBuilds":                  {},
+		"BatchGetCommandExecutions":       {},
+		"BatchGetFleets":                  {},
+		"BatchGetProjects":                {},
+		"BatchGetReportGroups":            {},
+		"BatchGetReports":                 {},
+		"BatchGetSandboxes":               {},
+		"DescribeCodeCoverages":           {},
+		"DescribeTestCases":               {},
+		"GetReportGroupTrend":             {},
+		"GetResourcePolicy":               {},
+		"ListBuildBatches":                {},
+		"ListBuildBatchesForProject":      {},
+		"ListBuilds":                      {},
+		"ListBuildsForProject":            {},
+		"ListCommandExecutionsForSandbox": {},
+		"ListCuratedEnvironmentImages":    {},
+		"ListFleets":                      {},
+		"ListProjects":                    {},
+		"ListReportGroups":                {},
+		"ListReports":                     {},
+		"ListReportsForReportGroup":       {},
+		"ListSandboxes":                   {},
+		"ListSandboxesForProject":         {},
+		"ListSharedProjects":              {},
+		"ListSharedReportGroups":          {},
+		"ListSourceCredentials":           {},
+	},
+	names.CodeCatalyst: {
+		"GetDevEnvironment":            {},
+		"GetProject":                   {},
+		"GetSourceRepository":          {},
+		"GetSourceRepositoryCloneUrls": {},
+		"GetSpace":                     {},
+		"GetSubscription":              {},
+		"GetUserDetails":               {},
+		"GetWorkflow":                  {},
+		"GetWorkflowRun":               {},
+		"ListAccessTokens":             {},
+		"ListDevEnvironmentSessions":   {},
+		"ListDevEnvironments":          {},
+		"ListEventLogs":                {},
+		"ListProjects":                 {},
+		"ListSourceRepositories":       {},
+		"ListSourceRepositoryBranches": {},
+		"ListSpaces":                   {},
+		"ListWorkflowRuns":             {},
+		"ListWorkflows":                {},
+		"VerifySession":                {},
+	},
+	names.CodeCommit: {
+		"BatchDescribeMergeConflicts":  {},
+		"BatchGetCommits":              {},
+		"BatchGetRepositories":         {},
+		"DescribeMergeConflicts":       {},
+		"DescribePullRequestEvents":    {},
+		"GetApprovalRuleTemplate":      {},
+		"GetBlob":                      {},
+		"GetBranch":                    {},
+		"GetComment":                   {},
+		"GetCommentReactions":          {},
+		"GetCommentsForComparedCommit": {},
+		"GetCommentsForPullRequest":    {},
+		"GetCommit":                    {},
+		"GetDifferences":               {},
+		"GetFile":                      {},
+		"GetFolder":                    {},
+		"GetMergeCommit":               {},
+		"GetMergeConflicts":            {},
+		"GetMergeOptions":              {},
+		"GetPullRequest":               {},
+		"GetPullRequestApprovalStates": {},
+		"GetPullRequestOverrideState":  {},
+		"GetRepository":                {},
+		"GetRepositoryTriggers":        {},
+		"ListApprovalRuleTemplates":    {},
+		"ListAssociatedApprovalRuleTemplatesForRepository": {},
+		"ListBranches":                            {},
+		"ListFileCommitHistory":                   {},
+		"ListPullRequests":                        {},
+		"ListRepositories":                        {},
+		"ListRepositoriesForApprovalRuleTemplate": {},
+		"ListTagsForResource":                     {},
+	},
+	names.CodeConnections: {
+		"GetConnection":                 {},
+		"GetHost":                       {},
+		"GetRepositoryLink":             {},
+		"GetRepositorySyncStatus":       {},
+		"GetResourceSyncStatus":         {},
+		"GetSyncBlockerSummary":         {},
+		"GetSyncConfiguration":          {},
+		"ListConnections":               {},
+		"ListHosts":                     {},
+		"ListRepositoryLinks":           {},
+		"ListRepositorySyncDefinitions": {},
+		"ListSyncConfigurations":        {},
+		"ListTagsForResource":           {},
+	},
+	names.CodeGuruProfiler: {
+		"BatchGetFrameMetricData":         {},
+		"DescribeProfilingGroup":          {},
+		"GetFindingsReportAccountSummary": {},
+		"GetNotificationConfiguration":    {},
+		"GetPolicy":                       {},
+		"GetProfile":                      {},
+		"GetRecommendations":              {},
+		"ListFindingsReports":             {},
+		"ListProfileTimes":                {},
+		"ListProfilingGroups":             {},
+		"ListTagsForResource":             {},
+	},
+	names.CodeGuruReviewer: {
+		"DescribeCodeReview":             {},
+		"DescribeRecommendationFeedback": {},
+		"DescribeRepositoryAssociation":  {},
+		"ListCodeReviews":                {},
+		"ListRecommendationFeedback":     {},
+		"ListRecommendations":            {},
+		"ListRepositoryAssociations":     {},
+		"ListTagsForResource":            {},
+	},
+	names.CodePipeline: {
+		"GetActionType":                    {},
+		"GetJobDetails":                    {},
+		"GetPipeline":                      {},
+		"GetPipelineExecution":             {},
+		"GetPipelineState":                 {},
+		"GetThirdPartyJobDetails":          {},
+		"ListActionExecutions":             {},
+		"ListActionTypes":                  {},
+		"ListDeployActionExecutionTargets": {},
+		"ListPipelineExecutions":           {},
+		"ListPipelines":                    {},
+		"ListRuleExecutions":               {},
+		"ListRuleTypes":                    {},
+		"ListTagsForResource":              {},
+		"ListWebhooks":                     {},
+	},
+	names.CodeStarConnections: {
+		"GetConnection":                 {},
+		"GetHost":                       {},
+		"GetRepositoryLink":             {},
+		"GetRepositorySyncStatus":       {},
+		"GetResourceSyncStatus":         {},
+		"GetSyncBlockerSummary":         {},
+		"GetSyncConfiguration":          {},
+		"ListConnections":               {},
+		"ListHosts":                     {},
+		"ListRepositoryLinks":           {},
+		"ListRepositorySyncDefinitions": {},
+		"ListSyncConfigurations":        {},
+		"ListTagsForResource":           {},
+	},
+	names.CodeStarNotifications: {
+		"DescribeNotificationRule": {},
+		"ListEventTypes":           {},
+		"ListNotificationRules":    {},
+		"ListTagsForResource":      {},
+		"ListTargets":              {},
+	},
+	names.CognitoIDP: {
+		"DescribeIdentityProvider":             {},
+		"DescribeManagedLoginBranding":         {},
+		"DescribeManagedLoginBrandingByClient": {},
+		"DescribeResourceServer":               {},
+		"DescribeRiskConfiguration":            {},
+		"DescribeTerms":                        {},
+		"DescribeUserImportJob":                {},
+		"DescribeUserPool":                     {},
+		"DescribeUserPoolClient":               {},
+		"DescribeUserPoolDomain":               {},
+		"GetCSVHeader":                         {},
+		"GetDevice":                            {},
+		"GetGroup":                             {},
+		"GetIdentityProviderByIdentifier":      {},
+		"GetLogDeliveryConfiguration":          {},
+		"GetSigningCertificate":                {},
+		"GetTokensFromRefreshToken":            {},
+		"GetUICustomization":                   {},
+		"GetUser":                              {},
+		"GetUserAttributeVerificationCode":     {},
+		"GetUserAuthFactors":                   {},
+		"GetUserPoolMfaConfig":                 {},
+		"ListDevices":                          {},
+		"ListGroups":                           {},
+		"ListIdentityProviders":                {},
+		"ListResourceServers":                  {},
+		"ListTagsForResource":                  {},
+		"ListTerms":                            {},
+		"ListUserImportJobs":                   {},
+		"ListUserPoolClients":                  {},
+		"ListUserPools":                        {},
+		"ListUsers":                            {},
+		"ListUsersInGroup":                     {},
+		"ListWebAuthnCredentials":              {},
+	},
+	names.CognitoIdentity: {
+		"DescribeIdentity":            {},
+		"DescribeIdentityPool":        {},
+		"GetIdentityPoolRoles":        {},
+		"GetPrincipalTagAttributeMap": {},
+		"ListIdentities":              {},
+		"ListIdentityPools":           {},
+		"ListTagsForResource":         {},
+		"LookupDeveloperIdentity":     {},
+	},
+	names.Comprehend: {
+		"DescribeDataset":                       {},
+		"DescribeDocumentClassificationJob":     {},
+		"DescribeDocumentClassifier":            {},
+		"DescribeDominantLanguageDetectionJob":  {},
+		"DescribeEndpoint":                      {},
+		"DescribeEntitiesDetectionJob":          {},
+		"DescribeEntityRecognizer":              {},
+		"DescribeEventsDetectionJob":            {},
+		"DescribeFlywheel":                      {},
+		"DescribeFlywheelIteration":             {},
+		"DescribeKeyPhrasesDetectionJob":        {},
+		"DescribePiiEntitiesDetectionJob":       {},
+		"DescribeResourcePolicy":                {},
+		"DescribeSentimentDetectionJob":         {},
+		"DescribeTargetedSentimentDetectionJob": {},
+		"DescribeTopicsDetectionJob":            {},
+		"ListDatasets":                          {},
+		"ListDocumentClassificationJobs":        {},
+		"ListDocumentClassifierSummaries":       {},
+		"ListDocumentClassifiers":               {},
+		"ListDominantLanguageDetectionJobs":     {},
+		"ListEndpoints":                         {},
+		"ListEntitiesDetectionJobs":             {},
+		"ListEntityRecognizerSummaries":         {},
+		"ListEntityRecognizers":                 {},
+		"ListEventsDetectionJobs":               {},
+		"ListFlywheelIterationHistory":          {},
+		"ListFlywheels":                         {},
+		"ListKeyPhrasesDetectionJobs":           {},
+		"ListPiiEntitiesDetectionJobs":          {},
+		"ListSentimentDetectionJobs":            {},
+		"ListTagsForResource":                   {},
+		"ListTargetedSentimentDetectionJobs":    {},
+		"ListTopicsDetectionJobs":               {},
+	},
+	names.ComputeOptimizer: {
+		"DescribeRecommendationExportJobs":             {},
+		"GetAutoScalingGroupRecommendations":           {},
+		"GetEBSVolumeRecommendations":                  {},
+		"GetEC2InstanceRecommendations":                {},
+		"GetEC2RecommendationProjectedMetrics":         {},
+		"GetECSServiceRecommendationProjectedMetrics":  {},
+		"GetECSServiceRecommendations":                 {},
+		"GetEffectiveRecommendationPreferences":        {},
+		"GetEnrollmentStatus":                          {},
+		"GetEnrollmentStatusesForOrganization":         {},
+		"GetIdleRecommendations":                       {},
+		"GetLambdaFunctionRecommendations":             {},
+		"GetLicenseRecommendations":                    {},
+		"GetRDSDatabaseRecommendationProjectedMetrics": {},
+		"GetRDSDatabaseRecommendations":                {},
+		"GetRecommendationPreferences":                 {},
+		"GetRecommendationSummaries":                   {},
+	},
+	names.ConfigService: {
+		"BatchGetAggregateResourceConfig":               {},
+		"BatchGetResourceConfig":                        {},
+		"DescribeAggregateComplianceByConfigRules":      {},
+		"DescribeAggregateComplianceByConformancePacks": {},
+		"DescribeAggregationAuthorizations":             {},
+		"DescribeComplianceByConfigRule":                {},
+		"DescribeComplianceByResource":                  {},
+		"DescribeConfigRuleEvaluationStatus":            {},
+		"DescribeConfigRules":                           {},
+		"DescribeConfigurationAggregatorSourcesStatus":  {},
+		"DescribeConfigurationAggregators":              {},
+		"DescribeConfigurationRecorderStatus":           {},
+		"DescribeConfigurationRecorders":                {},
+		"DescribeConformancePackCompliance":             {},
+		"DescribeConformancePackStatus":                 {},
+		"DescribeConformancePacks":                      {},
+		"DescribeDeliveryChannelStatus":                 {},
+		"DescribeDeliveryChannels":                      {},
+		"DescribeOrganizationConfigRuleStatuses":        {},
+		"DescribeOrganizationConfigRules":               {},
+		"DescribeOrganizationConformancePackStatuses":   {},
+		"DescribeOrganizationConformancePacks":          {},
+		"DescribePendingAggregationRequests":            {},
+		"DescribeRemediationConfigurations":             {},
+		"DescribeRemediationExceptions":                 {},
+		"DescribeRemediationExecutionStatus":            {},
+		"DescribeRetentionConfigurations":               {},
+		"GetAggregateComplianceDetailsByConfigRule":     {},
+		"GetAggregateConfigRuleComplianceSummary":       {},
+		"GetAggregateConformancePackComplianceSummary":  {},
+		"GetAggregateDiscoveredResourceCounts":          {},
+		"GetAggregateResourceConfig":                    {},
+		"GetComplianceDetailsByConfigRule":              {},
+		"GetComplianceDetailsByResource":                {},
+		"GetComplianceSummaryByConfigRule":              {},
+		"GetComplianceSummaryByResourceType":            {},
+		"GetConformancePackComplianceDetails":           {},
+		"GetConformancePackComplianceSummary":           {},
+		"GetCustomRulePolicy":                           {},
+		"GetDiscoveredResourceCounts":                   {},
+		"GetOrganizationConfigRuleDetailedStatus":       {},
+		"GetOrganizationConformancePackDetailedStatus":  {},
+		"GetOrganizationCustomRulePolicy":               {},
+		"GetResourceConfigHistory":                      {},
+		"GetResourceEvaluationSummary":                  {},
+		"GetStoredQuery":                                {},
+		"ListAggregateDiscoveredResources":              {},
+		"ListConfigurationRecorders":                    {},
+		"ListConformancePackComplianceScores":           {},
+		"ListDiscoveredResources":                       {},
+		"ListResourceEvaluations":                       {},
+		"ListStoredQueries":                             {},
+		"ListTagsForResource":                           {},
+		"SelectAggregateResourceConfig":                 {},
+		"SelectResourceConfig":                          {},
+	},
+	names.Connect: {
+		"BatchGetAttachedFileMetadata":             {},
+		"BatchGetFlowAssociation":                  {},
+		"DescribeAgentStatus":                      {},
+		"DescribeAuthenticationProfile":            {},
+		"DescribeContact":                          {},
+		"DescribeContactEvaluation":                {},
+		"DescribeContactFlow":                      {},
+		"DescribeContactFlowModule":                {},
+		"DescribeEmailAddress":                     {},
+		"DescribeEvaluationForm":                   {},
+		"DescribeHoursOfOperation":                 {},
+		"DescribeHoursOfOperationOverride":         {},
+		"DescribeInstance":                         {},
+		"DescribeInstanceAttribute":                {},
+		"DescribeInstanceStorageConfig":            {},
+		"DescribePhoneNumber":                      {},
+		"DescribePredefinedAttribute":              {},
+		"DescribePrompt":                           {},
+		"DescribeQueue":                            {},
+		"DescribeQuickConnect":                     {},
+		"DescribeRoutingProfile":                   {},
+		"DescribeRule":                             {},
+		"DescribeSecurityProfile":                  {},
+		"DescribeTrafficDistributionGroup":         {},
+		"DescribeUser":                             {},
+		"DescribeUserHierarchyGroup":               {},
+		"DescribeUserHierarchyStructure":           {},
+		"DescribeView":                             {},
+		"DescribeVocabulary":                       {},
+		"GetAttachedFile":                          {},
+		"GetContactAttributes":                     {},
+		"GetContactMetrics":                        {},
+		"GetCurrentMetricData":                     {},
+		"GetCurrentUserData":                       {},
+		"GetEffectiveHoursOfOperations":            {},
+		"GetFlowAssociation":                       {},
+		"GetMetricData":                            {},
+		"GetMetricDataV2":                          {},
+		"GetPromptFile":                            {},
+		"GetTaskTemplate":                          {},
+		"GetTrafficDistribution":                   {},
+		"ListAgentStatuses":                        {},
+		"ListAnalyticsDataAssociations":            {},
+		"ListAnalyticsDataLakeDataSets":            {},
+		"ListApprovedOrigins":                      {},
+		"ListAssociatedContacts":                   {},
+		"ListAuthenticationProfiles":               {},
+		"ListBots":                                 {},
+		"ListContactEvaluations":                   {},
+		"ListContactFlowModules":                   {},
+		"ListContactFlowVersions":                  {},
+		"ListContactFlows":                         {},
+		"ListContactReferences":                    {},
+		"ListDefaultVocabularies":                  {},
+		"ListEvaluationFormVersions":               {},
+		"ListEvaluationForms":                      {},
+		"ListFlowAssociations":                     {},
+		"ListHoursOfOperationOverrides":            {},
+		"ListHoursOfOperations":                    {},
+		"ListInstanceAttributes":                   {},
+		"ListInstanceStorageConfigs":               {},
+		"ListInstances":                            {},
+		"ListIntegrationAssociations":              {},
+		"ListLambdaFunctions":                      {},
+		"ListLexBots":                              {},
+		"ListPhoneNumbers":                         {},
+		"ListPhoneNumbersV2":                       {},
+		"ListPredefinedAttributes":                 {},
+		"ListPrompts":                              {},
+		"ListQueueQuickConnects":                   {},
+		"ListQueues":                               {},
+		"ListQuickConnects":                        {},
+		"ListRealtimeContactAnalysisSegmentsV2":    {},
+		"ListRoutingProfileManualAssignmentQueues": {},
+		"ListRoutingProfileQueues":                 {},
+		"ListRoutingProfiles":                      {},
+		"ListRules":                                {},
+		"ListSecurityKeys":                         {},
+		"ListSecurityProfileApplications":          {},
+		"ListSecurityProfilePermissions":           {},
+		"ListSecurityProfiles":                     {},
+		"ListTagsForResource":                      {},
+		"ListTaskTemplates":                        {},
+		"ListTrafficDistributionGroupUsers":        {},
+		"ListTrafficDistributionGroups":            {},
+		"ListUseCases":                             {},
+		"ListUserHierarchyGroups":                  {},
+		"ListUserProficiencies":                    {},
+		"ListUsers":                                {},
+		"ListViewVersions":                         {},
+		"ListViews":                                {},
+		"SearchAgentStatuses":                      {},
+		"SearchAvailablePhoneNumbers":              {},
+		"SearchContactFlowModules":                 {},
+		"SearchContactFlows":                       {},
+		"SearchContacts":                           {},
+		"SearchEmailAddresses":                     {},
+		"SearchHoursOfOperationOverrides":          {},
+		"SearchHoursOfOperations":                  {},
+		"SearchPredefinedAttributes":               {},
+		"SearchPrompts":                            {},
+		"SearchQueues":                             {},
+		"SearchQuickConnects":                      {},
+		"SearchResourceTags":                       {},
+		"SearchRoutingProfiles":                    {},
+		"SearchSecurityProfiles":                   {},
+		"SearchUserHierarchyGroups":                {},
+		"SearchUsers":                              {},
+		"SearchVocabularies":                       {},
+	},
+	names.ConnectCases: {
+		"BatchGetCaseRule":          {},
+		"BatchGetField":             {},
+		"GetCase":                   {},
+		"GetCaseAuditEvents":        {},
+		"GetCaseEventConfiguration": {},
+		"GetDomain":                 {},
+		"GetLayout":                 {},
+		"GetTemplate":               {},
+		"ListCaseRules":             {},
+		"ListCasesForContact":       {},
+		"ListDomains":               {},
+		"ListFieldOptions":          {},
+		"ListFields":                {},
+		"ListLayouts":               {},
+		"ListTagsForResource":       {},
+		"ListTemplates":             {},
+		"SearchAllRelatedItems":     {},
+		"SearchCases":               {},
+		"SearchRelatedItems":        {},
+	},
+	names.ControlTower: {
+		"GetBaseline":               {},
+		"GetBaselineOperation":      {},
+		"GetControlOperation":       {},
+		"GetEnabledBaseline":        {},
+		"GetEnabledControl":         {},
+		"GetLandingZone":            {},
+		"GetLandingZoneOperation":   {},
+		"ListBaselines":             {},
+		"ListControlOperations":     {},
+		"ListEnabledBaselines":      {},
+		"ListEnabledControls":       {},
+		"ListLandingZoneOperations": {},
+		"ListLandingZones":          {},
+		"ListTagsForResource":       {},
+	},
+	names.CostOptimizationHub: {
+		"GetPreferences":              {},
+		"GetRecommendation":           {},
+		"ListEnrollmentStatuses":      {},
+		"ListRecommendationSummaries": {},
+		"ListRecommendations":         {},
+	},
+	names.CustomerProfiles: {
+		"BatchGetCalculatedAttributeForProfile": {},
+		"BatchGetProfile":                       {},
+		"GetAutoMergingPreview":                 {},
+		"GetCalculatedAttributeDefinition":      {},
+		"GetCalculatedAttributeForProfile":      {},
+		"GetDomain":                             {},
+		"GetDomainLayout":                       {},
+		"GetEventStream":                        {},
+		"GetEventTrigger":                       {},
+		"GetIdentityResolutionJob":              {},
+		"GetIntegration":                        {},
+		"GetMatches":                            {},
+		"GetProfileHistoryRecord":               {},
+		"GetProfileObjectType":                  {},
+		"GetProfileObjectTypeTemplate":          {},
+		"GetSegmentDefinition":                  {},
+		"GetSegmentEstimate":                    {},
+		"GetSegmentMembership":                  {},
+		"GetSegmentSnapshot":                    {},
+		"GetSimilarProfiles":                    {},
+		"GetUploadJob":                          {},
+		"GetUploadJobPath":                      {},
+		"GetWorkflow":                           {},
+		"GetWorkflowSteps":                      {},
+		"ListAccountIntegrations":               {},
+		"ListCalculatedAttributeDefinitions":    {},
+		"ListCalculatedAttributesForProfile":    {},
+		"ListDomainLayouts":                     {},
+		"ListDomains":                           {},
+		"ListEventStreams":                      {},
+		"ListEventTriggers":                     {},
+		"ListIdentityResolutionJobs":            {},
+		"ListIntegrations":                      {},
+		"ListObjectTypeAttributes":              {},
+		"ListProfileAttributeValues":            {},
+		"ListProfileHistoryRecords":             {},
+		"ListProfileObjectTypeTemplates":        {},
+		"ListProfileObjectTypes":                {},
+		"ListProfileObjects":                    {},
+		"ListRuleBasedMatches":                  {},
+		"ListSegmentDefinitions":                {},
+		"ListTagsForResource":                   {},
+		"ListUploadJobs":                        {},
+		"ListWorkflows":                         {},
+		"SearchProfiles":                        {},
+	},
+	names.DAX: {
+		"DescribeClusters":          {},
+		"DescribeDefaultParameters": {},
+		"DescribeEvents":            {},
+		"DescribeParameterGroups":   {},
+		"DescribeParameters":        {},
+		"DescribeSubnetGroups":      {},
+		"ListTags":                  {},
+	},
+	names.DLM: {
+		"GetLifecyclePolicies": {},
+		"GetLifecyclePolicy":   {},
+		"ListTagsForResource":  {},
+	},
+	names.DMS: {
+		"DescribeAccountAttributes":                    {},
+		"DescribeApplicableIndividualAssessments":      {},
+		"DescribeCertificates":                         {},
+		"DescribeConnections":                          {},
+		"DescribeConversionConfiguration":              {},
+		"DescribeDataMigrations":                       {},
+		"DescribeDataProviders":                        {},
+		"DescribeEndpointSettings":                     {},
+		"DescribeEndpointTypes":                        {},
+		"DescribeEndpoints":                            {},
+		"DescribeEngineVersions":                       {},
+		"DescribeEventCategories":                      {},
+		"DescribeEventSubscriptions":                   {},
+		"DescribeEvents":                               {},
+		"DescribeExtensionPackAssociations":            {},
+		"DescribeFleetAdvisorCollectors":               {},
+		"DescribeFleetAdvisorDatabases":                {},
+		"DescribeFleetAdvisorLsaAnalysis":              {},
+		"DescribeFleetAdvisorSchemaObjectSummary":      {},
+		"DescribeFleetAdvisorSchemas":                  {},
+		"DescribeInstanceProfiles":                     {},
+		"DescribeMetadataModelAssessments":             {},
+		"DescribeMetadataModelConversions":             {},
+		"DescribeMetadataModelExportsAsScript":         {},
+		"DescribeMetadataModelExportsToTarget":         {},
+		"DescribeMetadataModelImports":                 {},
+		"DescribeMigrationProjects":                    {},
+		"DescribeOrderableReplicationInstances":        {},
+		"DescribePendingMaintenanceActions":            {},
+		"DescribeRecommendationLimitations":            {},
+		"DescribeRecommendations":                      {},
+		"DescribeRefreshSchemasStatus":                 {},
+		"DescribeReplicationConfigs":                   {},
+		"DescribeReplicationInstanceTaskLogs":          {},
+		"DescribeReplicationInstances":                 {},
+		"DescribeReplicationSubnetGroups":              {},
+		"DescribeReplicationTableStatistics":           {},
+		"DescribeReplicationTaskAssessmentResults":     {},
+		"DescribeReplicationTaskAssessmentRuns":        {},
+		"DescribeReplicationTaskIndividualAssessments": {},
+		"DescribeReplicationTasks":                     {},
+		"DescribeReplications":                         {},
+		"DescribeSchemas":                              {},
+		"DescribeTableStatistics":                      {},
+		"ListTagsForResource":                          {},
+	},
+	names.DRS: {
+		"DescribeJobLogItems":                       {},
+		"DescribeJobs":                              {},
+		"DescribeLaunchConfigurationTemplates":      {},
+		"DescribeRecoveryInstances":                 {},
+		"DescribeRecoverySnapshots":                 {},
+		"DescribeReplicationConfigurationTemplates": {},
+		"DescribeSourceNetworks":                    {},
+		"DescribeSourceServers":                     {},
+		"GetFailbackReplicationConfiguration":       {},
+		"GetLaunchConfiguration":                    {},
+		"GetReplicationConfiguration":               {},
+		"ListExtensibleSourceServers":               {},
+		"ListLaunchActions":                         {},
+		"ListStagingAccounts":                       {},
+		"ListTagsForResource":                       {},
+	},
+	names.DS: {
+		"DescribeADAssessment":                 {},
+		"DescribeCAEnrollmentPolicy":           {},
+		"DescribeCertificate":                  {},
+		"DescribeClientAuthenticationSettings": {},
+		"DescribeConditionalForwarders":        {},
+		"DescribeDirectories":                  {},
+		"DescribeDirectoryDataAccess":          {},
+		"DescribeDomainControllers":            {},
+		"DescribeEventTopics":                  {},
+		"DescribeHybridADUpdate":               {},
+		"DescribeLDAPSSettings":                {},
+		"DescribeRegions":                      {},
+		"DescribeSettings":                     {},
+		"DescribeSharedDirectories":            {},
+		"DescribeSnapshots":                    {},
+		"DescribeTrusts":                       {},
+		"DescribeUpdateDirectory":              {},
+		"GetDirectoryLimits":                   {},
+		"GetSnapshotLimits":                    {},
+		"ListADAssessments":                    {},
+		"ListCertificates":                     {},
+		"ListIpRoutes":                         {},
+		"ListLogSubscriptions":                 {},
+		"ListSchemaExtensions":                 {},
+		"ListTagsForResource":                  {},
+	},
+	names.DSQL: {
+		"GetCluster":                {},
+		"GetVpcEndpointServiceName": {},
+		"ListClusters":              {},
+		"ListTagsForResource":       {},
+	},
+	names.DataBrew: {
+		"DescribeDataset":     {},
+		"DescribeJob":         {},
+		"DescribeJobRun":      {},
+		"DescribeProject":     {},
+		"DescribeRecipe":      {},
+		"DescribeRuleset":     {},
+		"DescribeSchedule":    {},
+		"ListDatasets":        {},
+		"ListJobRuns":         {},
+		"ListJobs":            {},
+		"ListProjects":        {},
+		"ListRecipeVersions":  {},
+		"ListRecipes":         {},
+		"ListRulesets":        {},
+		"ListSchedules":       {},
+		"ListTagsForResource": {},
+	},
+	names.DataExchange: {
+		"GetAsset":               {},
+		"GetDataGrant":           {},
+		"GetDataSet":             {},
+		"GetEventAction":         {},
+		"GetJob":                 {},
+		"GetReceivedDataGrant":   {},
+		"GetRevision":            {},
+		"ListDataGrants":         {},
+		"ListDataSetRevisions":   {},
+		"ListDataSets":           {},
+		"ListEventActions":       {},
+		"ListJobs":               {},
+		"ListReceivedDataGrants": {},
+		"ListRevisionAssets":     {},
+		"ListTagsForResource":    {},
+	},
+	names.DataPipeline: {
+		"DescribeObjects":            {},
+		"DescribePipelines":          {},
+		"GetPipelineDefinition":      {},
+		"ListPipelines":              {},
+		"QueryObjects":               {},
+		"ValidatePipelineDefinition": {},
+	},
+	names.DataSync: {
+		"DescribeAgent":                 {},
+		"DescribeLocationAzureBlob":     {},
+		"DescribeLocationEfs":           {},
+		"DescribeLocationFsxLustre":     {},
+		"DescribeLocationFsxOntap":      {},
+		"DescribeLocationFsxOpenZfs":    {},
+		"DescribeLocationFsxWindows":    {},
+		"DescribeLocationHdfs":          {},
+		"DescribeLocationNfs":           {},
+		"DescribeLocationObjectStorage": {},
+		"DescribeLocationS3":            {},
+		"DescribeLocationSmb":           {},
+		"DescribeTask":                  {},
+		"DescribeTaskExecution":         {},
+		"ListAgents":                    {},
+		"ListLocations":                 {},
+		"ListTagsForResource":           {},
+		"ListTaskExecutions":            {},
+		"ListTasks":                     {},
+	},
+	names.DataZone: {
+		"GetAccountPool":                         {},
+		"GetAsset":                               {},
+		"GetAssetFilter":                         {},
+		"GetAssetType":                           {},
+		"GetConnection":                          {},
+		"GetDataProduct":                         {},
+		"GetDataSource":                          {},
+		"GetDataSourceRun":                       {},
+		"GetDomain":                              {},
+		"GetDomainUnit":                          {},
+		"GetEnvironment":                         {},
+		"GetEnvironmentAction":                   {},
+		"GetEnvironmentBlueprint":                {},
+		"GetEnvironmentBlueprintConfiguration":   {},
+		"GetEnvironmentProfile":                  {},
+		"GetFormType":                            {},
+		"GetGlossary":                            {},
+		"GetGlossaryTerm":                        {},
+		"GetGroupProfile":                        {},
+		"GetJobRun":                              {},
+		"GetLineageEvent":                        {},
+		"GetLineageNode":                         {},
+		"GetListing":                             {},
+		"GetMetadataGenerationRun":               {},
+		"GetProject":                             {},
+		"GetProjectProfile":                      {},
+		"GetRule":                                {},
+		"GetSubscription":                        {},
+		"GetSubscriptionGrant":                   {},
+		"GetSubscriptionRequestDetails":          {},
+		"GetSubscriptionTarget":                  {},
+		"GetTimeSeriesDataPoint":                 {},
+		"GetUserProfile":                         {},
+		"ListAccountPools":                       {},
+		"ListAccountsInAccountPool":              {},
+		"ListAssetFilters":                       {},
+		"ListAssetRevisions":                     {},
+		"ListConnections":                        {},
+		"ListDataProductRevisions":               {},
+		"ListDataSourceRunActivities":            {},
+		"ListDataSourceRuns":                     {},
+		"ListDataSources":                        {},
+		"ListDomainUnitsForParent":               {},
+		"ListDomains":                            {},
+		"ListEntityOwners":                       {},
+		"ListEnvironmentActions":                 {},
+		"ListEnvironmentBlueprintConfigurations": {},
+		"ListEnvironmentBlueprints":              {},
+		"ListEnvironmentProfiles":                {},
+		"ListEnvironments":                       {},
+		"ListJobRuns":                            {},
+		"ListLineageEvents":                      {},
+		"ListLineageNodeHistory":                 {},
+		"ListMetadataGenerationRuns":             {},
+		"ListNotifications":                      {},
+		"ListPolicyGrants":                       {},
+		"ListProjectMemberships":                 {},
+		"ListProjectProfiles":                    {},
+		"ListProjects":                           {},
+		"ListRules":                              {},
+		"ListSubscriptionGrants":                 {},
+		"ListSubscriptionRequests":               {},
+		"ListSubscriptionTargets":                {},
+		"ListSubscriptions":                      {},
+		"ListTagsForResource":                    {},
+		"ListTimeSeriesDataPoints":               {},
+		"Search":                                 {},
+		"SearchGroupProfiles":                    {},
+		"SearchListings":                         {},
+		"SearchTypes":                            {},
+		"SearchUserProfiles":                     {},
+	},
+	names.Deploy: {
+		"BatchGetApplicationRevisions": {},
+		"BatchGetApplications":         {},
+		"BatchGetDeploymentGroups":     {},
+		"BatchGetDeploymentInstances":  {},
+		"BatchGetDeploymentTargets":    {},
+		"BatchGetDeployments":          {},
+		"BatchGetOnPremisesInstances":  {},
+		"GetApplication":               {},
+		"GetApplicationRevision":       {},
+		"GetDeployment":                {},
+		"GetDeploymentConfig":          {},
+		"GetDeploymentGroup":           {},
+		"GetDeploymentInstance":        {},
+		"GetDeploymentTarget":          {},
+		"GetOnPremisesInstance":        {},
+		"ListApplicationRevisions":     {},
+		"ListApplications":             {},
+		"ListDeploymentConfigs":        {},
+		"ListDeploymentGroups":         {},
+		"ListDeploymentInstances":      {},
+		"ListDeploymentTargets":        {},
+		"ListDeployments":              {},
+		"ListGitHubAccountTokenNames":  {},
+		"ListOnPremisesInstances":      {},
+		"ListTagsForResource":          {},
+	},
+	names.Detective: {
+		"BatchGetGraphMemberDatasources":    {},
+		"BatchGetMembershipDatasources":     {},
+		"DescribeOrganizationConfiguration": {},
+		"GetInvestigation":                  {},
+		"GetMembers":                        {},
+		"ListDatasourcePackages":            {},
+		"ListGraphs":                        {},
+		"ListIndicators":                    {},
+		"ListInvestigations":                {},
+		"ListInvitations":                   {},
+		"ListMembers":                       {},
+		"ListOrganizationAdminAccounts":     {},
+		"ListTagsForResource":               {},
+	},
+	names.DevOpsGuru: {
+		"DescribeAccountHealth":                        {},
+		"DescribeAccountOverview":                      {},
+		"DescribeAnomaly":                              {},
+		"DescribeEventSourcesConfig":                   {},
+		"DescribeFeedback":                             {},
+		"DescribeInsight":                              {},
+		"DescribeOrganizationHealth":                   {},
+		"DescribeOrganizationOverview":                 {},
+		"DescribeOrganizationResourceCollectionHealth": {},
+		"DescribeResourceCollectionHealth":             {},
+		"DescribeServiceIntegration":                   {},
+		"GetCostEstimation":                            {},
+		"GetResourceCollection":                        {},
+		"ListAnomaliesForInsight":                      {},
+		"ListAnomalousLogGroups":                       {},
+		"ListEvents":                                   {},
+		"ListInsights":                                 {},
+		"ListMonitoredResources":                       {},
+		"ListNotificationChannels":                     {},
+		"ListOrganizationInsights":                     {},
+		"ListRecommendations":                          {},
+		"SearchInsights":                               {},
+		"SearchOrganizationInsights":                   {},
+	},
+	names.DeviceFarm: {
+		"GetAccountSettings":           {},
+		"GetDevice":                    {},
+		"GetDeviceInstance":            {},
+		"GetDevicePool":                {},
+		"GetDevicePoolCompatibility":   {},
+		"GetInstanceProfile":           {},
+		"GetJob":                       {},
+		"GetNetworkProfile":            {},
+		"GetOfferingStatus":            {},
+		"GetProject":                   {},
+		"GetRemoteAccessSession":       {},
+		"GetRun":                       {},
+		"GetSuite":                     {},
+		"GetTest":                      {},
+		"GetTestGridProject":           {},
+		"GetTestGridSession":           {},
+		"GetUpload":                    {},
+		"GetVPCEConfiguration":         {},
+		"ListArtifacts":                {},
+		"ListDeviceInstances":          {},
+		"ListDevicePools":              {},
+		"ListDevices":                  {},
+		"ListInstanceProfiles":         {},
+		"ListJobs":                     {},
+		"ListNetworkProfiles":          {},
+		"ListOfferingPromotions":       {},
+		"ListOfferingTransactions":     {},
+		"ListOfferings":                {},
+		"ListProjects":                 {},
+		"ListRemoteAccessSessions":     {},
+		"ListRuns":                     {},
+		"ListSamples":                  {},
+		"ListSuites":                   {},
+		"ListTagsForResource":          {},
+		"ListTestGridProjects":         {},
+		"ListTestGridSessionActions":   {},
+		"ListTestGridSessionArtifacts": {},
+		"ListTestGridSessions":         {},
+		"ListTests":                    {},
+		"ListUniqueProblems":           {},
+		"ListUploads":                  {},
+		"ListVPCEConfigurations":       {},
+	},
+	names.DirectConnect: {
+		"DescribeConnectionLoa":                            {},
+		"DescribeConnections":                              {},
+		"DescribeConnectionsOnInterconnect":                {},
+		"DescribeCustomerMetadata":                         {},
+		"DescribeDirectConnectGatewayAssociationProposals": {},
+		"DescribeDirectConnectGatewayAssociations":         {},
+		"DescribeDirectConnectGatewayAttachments":          {},
+		"DescribeDirectConnectGateways":                    {},
+		"DescribeHostedConnections":                        {},
+		"DescribeInterconnectLoa":                          {},
+		"DescribeInterconnects":                            {},
+		"DescribeLags":                                     {},
+		"DescribeLoa":                                      {},
+		"DescribeLocations":                                {},
+		"DescribeRouterConfiguration":                      {},
+		"DescribeTags":                                     {},
+		"DescribeVirtualGateways":                          {},
+		"DescribeVirtualInterfaces":                        {},
+		"ListVirtualInterfaceTestHistory":                  {},
+	},
+	names.DocDB: {
+		"DescribeCertificates":                   {},
+		"DescribeDBClusterParameterGroups":       {},
+		"DescribeDBClusterParameters":            {},
+		"DescribeDBClusterSnapshotAttributes":    {},
+		"DescribeDBClusterSnapshots":             {},
+		"DescribeDBClusters":                     {},
+		"DescribeDBEngineVersions":               {},
+		"DescribeDBInstances":                    {},
+		"DescribeDBSubnetGroups":                 {},
+		"DescribeEngineDefaultClusterParameters": {},
+		"DescribeEventCategories":                {},
+		"DescribeEventSubscriptions":             {},
+		"DescribeEvents":                         {},
+		"DescribeGlobalClusters":                 {},
+		"DescribeOrderableDBInstanceOptions":     {},
+		"DescribePendingMaintenanceActions":      {},
+		"ListTagsForResource":                    {},
+	},
+	names.DocDBElastic: {
+		"GetCluster":                    {},
+		"GetClusterSnapshot":            {},
+		"GetPendingMaintenanceAction":   {},
+		"ListClusterSnapshots":          {},
+		"ListClusters":                  {},
+		"ListPendingMaintenanceActions": {},
+		"ListTagsForResource":           {},
+	},
+	names.DynamoDB: {
+		"BatchGetItem":                        {},
+		"DescribeBackup":                      {},
+		"DescribeContinuousBackups":           {},
+		"DescribeContributorInsights":         {},
+		"DescribeEndpoints":                   {},
+		"DescribeExport":                      {},
+		"DescribeGlobalTable":                 {},
+		"DescribeGlobalTableSettings":         {},
+		"DescribeImport":                      {},
+		"DescribeKinesisStreamingDestination": {},
+		"DescribeLimits":                      {},
+		"DescribeTable":                       {},
+		"DescribeTableReplicaAutoScaling":     {},
+		"DescribeTimeToLive":                  {},
+		"GetItem":                             {},
+		"GetResourcePolicy":                   {},
+		"ListBackups":                         {},
+		"ListContributorInsights":             {},
+		"ListExports":                         {},
+		"ListGlobalTables":                    {},
+		"ListImports":                         {},
+		"ListTables":                          {},
+		"ListTagsOfResource":                  {},
+		"Query":                               {},
+		"Scan":                                {},
+	},
+	names.EC2: {
+		"DescribeAccountAttributes":                                       {},
+		"DescribeAddressTransfers":                                        {},
+		"DescribeAddresses":                                               {},
+		"DescribeAddressesAttribute":                                      {},
+		"DescribeAggregateIdFormat":                                       {},
+		"DescribeAvailabilityZones":                                       {},
+		"DescribeAwsNetworkPerformanceMetricSubscriptions":                {},
+		"DescribeBundleTasks":                                             {},
+		"DescribeByoipCidrs":                                              {},
+		"DescribeCapacityBlockExtensionHistory":                           {},
+		"DescribeCapacityBlockExtensionOfferings":                         {},
+		"DescribeCapacityBlockOfferings":                                  {},
+		"DescribeCapacityBlockStatus":                                     {},
+		"DescribeCapacityBlocks":                                          {},
+		"DescribeCapacityManagerDataExports":                              {},
+		"DescribeCapacityReservationBillingRequests":                      {},
+		"DescribeCapacityReservationFleets":                               {},
+		"DescribeCapacityReservations":                                    {},
+		"DescribeCarrierGateways":                                         {},
+		"DescribeClassicLinkInstances":                                    {},
+		"DescribeClientVpnAuthorizationRules":                             {},
+		"DescribeClientVpnConnections":                                    {},
+		"DescribeClientVpnEndpoints":                                      {},
+		"DescribeClientVpnRoutes":                                         {},
+		"DescribeClientVpnTargetNetworks":                                 {},
+		"DescribeCoipPools":                                               {},
+		"DescribeConversionTasks":                                         {},
+		"DescribeCustomerGateways":                                        {},
+		"DescribeDeclarativePoliciesReports":                              {},
+		"DescribeDhcpOptions":                                             {},
+		"DescribeEgressOnlyInternetGateways":                              {},
+		"DescribeElasticGpus":                                             {},
+		"DescribeExportImageTasks":                                        {},
+		"DescribeExportTasks":                                             {},
+		"DescribeFastLaunchImages":                                        {},
+		"DescribeFastSnapshotRestores":                                    {},
+		"DescribeFleetHistory":                                            {},
+		"DescribeFleetInstances":                                          {},
+		"DescribeFleets":                                                  {},
+		"DescribeFlowLogs":                                                {},
+		"DescribeFpgaImageAttribute":                                      {},
+		"DescribeFpgaImages":                                              {},
+		"DescribeHostReservationOfferings":                                {},
+		"DescribeHostReservations":                                        {},
+		"DescribeHosts":                                                   {},
+		"DescribeIamInstanceProfileAssociations":                          {},
+		"DescribeIdFormat":                                                {},
+		"DescribeIdentityIdFormat":                                        {},
+		"DescribeImageAttribute":                                          {},
+		"DescribeImageReferences":                                         {},
+		"DescribeImageUsageReportEntries":                                 {},
+		"DescribeImageUsageReports":                                       {},
+		"DescribeImages":                                                  {},
+		"DescribeImportImageTasks":                                        {},
+		"DescribeImportSnapshotTasks":                                     {},
+		"DescribeInstanceAttribute":                                       {},
+		"DescribeInstanceConnectEndpoints":                                {},
+		"DescribeInstanceCreditSpecifications":                            {},
+		"DescribeInstanceEventNotificationAttributes":                     {},
+		"DescribeInstanceEventWindows":                                    {},
+		"DescribeInstanceImageMetadata":                                   {},
+		"DescribeInstanceStatus":                                          {},
+		"DescribeInstanceTopology":                                        {},
+		"DescribeInstanceTypeOfferings":                                   {},
+		"DescribeInstanceTypes":                                           {},
+		"DescribeInstances":                                               {},
+		"DescribeInternetGateways":                                        {},
+		"DescribeIpamByoasn":                                              {},
+		"DescribeIpamExternalResourceVerificationTokens":                  {},
+		"DescribeIpamPools":                                               {},
+		"DescribeIpamResourceDiscoveries":                                 {},
+		"DescribeIpamResourceDiscoveryAssociations":                       {},
+		"DescribeIpamScopes":                                              {},
+		"DescribeIpams":                                                   {},
+		"DescribeIpv6Pools":                                               {},
+		"DescribeKeyPairs":                                                {},
+		"DescribeLaunchTemplateVersions":                                  {},
+		"DescribeLaunchTemplates":                                         {},
+		"DescribeLocalGatewayRouteTableVirtualInterfaceGroupAssociations": {},
+		"DescribeLocalGatewayRouteTableVpcAssociations":                   {},
+		"DescribeLocalGatewayRouteTables":                                 {},
+		"DescribeLocalGatewayVirtualInterfaceGroups":                      {},
+		"DescribeLocalGatewayVirtualInterfaces":                           {},
+		"DescribeLocalGateways":                                           {},
+		"DescribeLockedSnapshots":                                         {},
+		"DescribeMacHosts":                                                {},
+		"DescribeMacModificationTasks":                                    {},
+		"DescribeManagedPrefixLists":                                      {},
+		"DescribeMovingAddresses":                                         {},
+		"DescribeNatGateways":                                             {},
+		"DescribeNetworkAcls":                                             {},
+		"DescribeNetworkInsightsAccessScopeAnalyses":                      {},
+		"DescribeNetworkInsightsAccessScopes":                             {},
+		"DescribeNetworkInsightsAnalyses":                                 {},
+		"DescribeNetworkInsightsPaths":                                    {},
+		"DescribeNetworkInterfaceAttribute":                               {},
+		"DescribeNetworkInterfacePermissions":                             {},
+		"DescribeNetworkInterfaces":                                       {},
+		"DescribeOutpostLags":                                             {},
+		"DescribePlacementGroups":                                         {},
+		"DescribePrefixLists":                                             {},
+		"DescribePrincipalIdFormat":                                       {},
+		"DescribePublicIpv4Pools":                                         {},
+		"DescribeRegions":                                                 {},
+		"DescribeReplaceRootVolumeTasks":                                  {},
+		"DescribeReservedInstances":                                       {},
+		"DescribeReservedInstancesListings":                               {},
+		"DescribeReservedInstancesModifications":                          {},
+		"DescribeReservedInstancesOfferings":                              {},
+		"DescribeRouteServerEndpoints":                                    {},
+		"DescribeRouteServerPeers":                                        {},
+		"DescribeRouteServers":                                            {},
+		"DescribeRouteTables":                                             {},
+		"DescribeScheduledInstanceAvailability":                           {},
+		"DescribeScheduledInstances":                                      {},
+		"DescribeSecurityGroupReferences":                                 {},
+		"DescribeSecurityGroupRules":                                      {},
+		"DescribeSecurityGroupVpcAssociations":                            {},
+		"DescribeSecurityGroups":                                          {},
+		"DescribeServiceLinkVirtualInterfaces":                            {},
+		"DescribeSnapshotAttribute":                                       {},
+		"DescribeSnapshotTierStatus":                                      {},
+		"DescribeSnapshots":                                               {},
+		"DescribeSpotDatafeedSubscription":                                {},
+		"DescribeSpotFleetInstances":                                      {},
+		"DescribeSpotFleetRequestHistory":                                 {},
+		"DescribeSpotFleetRequests":                                       {},
+		"DescribeSpotInstanceRequests":                                    {},
+		"DescribeSpotPriceHistory":                                        {},
+		"DescribeStaleSecurityGroups":                                     {},
+		"DescribeStoreImageTasks":                                         {},
+		"DescribeSubnets":                                                 {},
+		"DescribeTags":                                                    {},
+		"DescribeTrafficMirrorFilterRules":                                {},
+		"DescribeTrafficMirrorFilters":                                    {},
+		"DescribeTrafficMirrorSessions":                                   {},
+		"DescribeTrafficMirrorTargets":                                    {},
+		"DescribeTransitGatewayAttachments":                               {},
+		"DescribeTransitGatewayConnectPeers":                              {},
+		"DescribeTransitGatewayConnects":                                  {},
+		"DescribeTransitGatewayMulticastDomains":                          {},
+		"DescribeTransitGatewayPeeringAttachments":                        {},
+		"DescribeTransitGatewayPolicyTables":                              {},
+		"DescribeTransitGatewayRouteTableAnnouncements":                   {},
+		"DescribeTransitGatewayRouteTables":                               {},
+		"DescribeTransitGatewayVpcAttachments":                            {},
+		"DescribeTransitGateways":                                         {},
+		"DescribeTrunkInterfaceAssociations":                              {},
+		"DescribeVerifiedAccessEndpoints":                                 {},
+		"DescribeVerifiedAccessGroups":                                    {},
+		"DescribeVerifiedAccessInstanceLoggingConfigurations":             {},
+		"DescribeVerifiedAccessInstances":                                 {},
+		"DescribeVerifiedAccessTrustProviders":                            {},
+		"DescribeVolumeAttribute":                                         {},
+		"DescribeVolumeStatus":                                            {},
+		"DescribeVolumes":                                                 {},
+		"DescribeVolumesModifications":                                    {},
+		"DescribeVpcAttribute":                                            {},
+		"DescribeVpcBlockPublicAccessExclusions":                          {},
+		"DescribeVpcBlockPublicAccessOptions":                             {},
+		"DescribeVpcClassicLink":                                          {},
+		"DescribeVpcClassicLinkDnsSupport":                                {},
+		"DescribeVpcEndpointAssociations":                                 {},
+		"DescribeVpcEndpointConnectionNotifications":                      {},
+		"DescribeVpcEndpointConnections":                                  {},
+		"DescribeVpcEndpointServiceConfigurations":                        {},
+		"DescribeVpcEndpointServicePermissions":                           {},
+		"DescribeVpcEndpointServices":                                     {},
+		"DescribeVpcEndpoints":                                            {},
+		"DescribeVpcPeeringConnections":                                   {},
+		"DescribeVpcs":                                                    {},
+		"DescribeVpnConnections":                                          {},
+		"DescribeVpnGateways":                                             {},
+		"GetActiveVpnTunnelStatus":                                        {},
+		"GetAllowedImagesSettings":                                        {},
+		"GetAssociatedEnclaveCertificateIamRoles":                         {},
+		"GetAssociatedIpv6PoolCidrs":                                      {},
+		"GetAwsNetworkPerformanceData":                                    {},
+		"GetCapacityManagerAttributes":                                    {},
+		"GetCapacityManagerMetricData":                                    {},
+		"GetCapacityManagerMetricDimensions":                              {},
+		"GetCapacityReservationUsage":                                     {},
+		"GetCoipPoolUsage":                                                {},
+		"GetConsoleOutput":                                                {},
+		"GetConsoleScreenshot":                                            {},
+		"GetDeclarativePoliciesReportSummary":                             {},
+		"GetDefaultCreditSpecification":                                   {},
+		"GetEbsDefaultKmsKeyId":                                           {},
+		"GetEbsEncryptionByDefault":                                       {},
+		"GetFlowLogsIntegrationTemplate":                                  {},
+		"GetGroupsForCapacityReservation":                                 {},
+		"GetHostReservationPurchasePreview":                               {},
+		"GetImageBlockPublicAccessState":                                  {},
+		"GetInstanceMetadataDefaults":                                     {},
+		"GetInstanceTpmEkPub":                                             {},
+		"GetInstanceTypesFromInstanceRequirements":                        {},
+		"GetInstanceUefiData":                                             {},
+		"GetIpamAddressHistory":                                           {},
+		"GetIpamDiscoveredAccounts":                                       {},
+		"GetIpamDiscoveredPublicAddresses":                                {},
+		"GetIpamDiscoveredResourceCidrs":                                  {},
+		"GetIpamPoolAllocations":                                          {},
+		"GetIpamPoolCidrs":                                                {},
+		"GetIpamResourceCidrs":                                            {},
+		"GetLaunchTemplateData":                                           {},
+		"GetManagedPrefixListAssociations":                                {},
+		"GetManagedPrefixListEntries":                                     {},
+		"GetNetworkInsightsAccessScopeAnalysisFindings":                   {},
+		"GetNetworkInsightsAccessScopeContent":                            {},
+		"GetPasswordData":                                                 {},
+		"GetReservedInstancesExchangeQuote":                               {},
+		"GetRouteServerAssociations":                                      {},
+		"GetRouteServerPropagations":                                      {},
+		"GetRouteServerRoutingDatabase":                                   {},
+		"GetSecurityGroupsForVpc":                                         {},
+		"GetSerialConsoleAccessStatus":                                    {},
+		"GetSnapshotBlockPublicAccessState":                               {},
+		"GetSpotPlacementScores":                                          {},
+		"GetSubnetCidrReservations":                                       {},
+		"GetTransitGatewayAttachmentPropagations":                         {},
+		"GetTransitGatewayMulticastDomainAssociations":                    {},
+		"GetTransitGatewayPolicyTableAssociations":                        {},
+		"GetTransitGatewayPolicyTableEntries":                             {},
+		"GetTransitGatewayPrefixListReferences":                           {},
+		"GetTransitGatewayRouteTableAssociations":                         {},
+		"GetTransitGatewayRouteTablePropagations":                         {},
+		"GetVerifiedAccessEndpointPolicy":                                 {},
+		"GetVerifiedAccessEndpointTargets":                                {},
+		"GetVerifiedAccessGroupPolicy":                                    {},
+		"GetVpnConnectionDeviceSampleConfiguration":                       {},
+		"GetVpnConnectionDeviceTypes":                                     {},
+		"GetVpnTunnelReplacementStatus":                                   {},
+		"ListImagesInRecycleBin":                                          {},
+		"ListSnapshotsInRecycleBin":                                       {},
+		"SearchLocalGatewayRoutes":                                        {},
+		"SearchTransitGatewayMulticastGroups":                             {},
+		"SearchTransitGatewayRoutes":                                      {},
+	},
+	names.ECR: {
+		"BatchGetImage": {},
+		"BatchGetRepositoryScanningConfiguration": {},
+		"DescribeImageReplicationStatus":          {},
+		"DescribeImageScanFindings":               {},
+		"DescribeImages":                          {},
+		"DescribePullThroughCacheRules":           {},
+		"DescribeRegistry":                        {},
+		"DescribeRepositories":                    {},
+		"DescribeRepositoryCreationTemplates":     {},
+		"GetAccountSetting":                       {},
+		"GetDownloadUrlForLayer":                  {},
+		"GetLifecyclePolicy":                      {},
+		"GetLifecyclePolicyPreview":               {},
+		"GetRegistryPolicy":                       {},
+		"GetRegistryScanningConfiguration":        {},
+		"GetRepositoryPolicy":                     {},
+		"ListImages":                              {},
+		"ListTagsForResource":                     {},
+		"ValidatePullThroughCacheRule":            {},
+	},
+	names.ECRPublic: {
+		"DescribeImageTags":        {},
+		"DescribeImages":           {},
+		"DescribeRegistries":       {},
+		"DescribeRepositories":     {},
+		"GetRegistryCatalogData":   {},
+		"GetRepositoryCatalogData": {},
+		"GetRepositoryPolicy":      {},
+		"ListTagsForResource":      {},
+	},
+	names.ECS: {
+		"DescribeCapacityProviders":  {},
+		"DescribeClusters":           {},
+		"DescribeContainerInstances": {},
+		"DescribeServiceDeployments": {},
+		"DescribeServiceRevisions":   {},
+		"DescribeServices":           {},
+		"DescribeTaskDefinition":     {},
+		"DescribeTaskSets":           {},
+		"DescribeTasks":              {},
+		"GetTaskProtection":          {},
+		"ListAccountSettings":        {},
+		"ListAttributes":             {},
+		"ListClusters":               {},
+		"ListContainerInstances":     {},
+		"ListServiceDeployments":     {},
+		"ListServices":               {},
+		"ListServicesByNamespace":    {},
+		"ListTagsForResource":        {},
+		"ListTaskDefinitionFamilies": {},
+		"ListTaskDefinitions":        {},
+		"ListTasks":                  {},
+	},
+	names.EFS: {
+		"DescribeAccessPoints":              {},
+		"DescribeAccountPreferences":        {},
+		"DescribeBackupPolicy":              {},
+		"DescribeFileSystemPolicy":          {},
+		"DescribeFileSystems":               {},
+		"DescribeLifecycleConfiguration":    {},
+		"DescribeMountTargetSecurityGroups": {},
+		"DescribeMountTargets":              {},
+		"DescribeReplicationConfigurations": {},
+		"DescribeTags":                      {},
+		"ListTagsForResource":               {},
+	},
+	names.EKS: {
+		"DescribeAccessEntry":             {},
+		"DescribeAddon":                   {},
+		"DescribeAddonConfiguration":      {},
+		"DescribeAddonVersions":           {},
+		"DescribeCluster":                 {},
+		"DescribeClusterVersions":         {},
+		"DescribeEksAnywhereSubscription": {},
+		"DescribeFargateProfile":          {},
+		"DescribeIdentityProviderConfig":  {},
+		"DescribeInsight":                 {},
+		"DescribeInsightsRefresh":         {},
+		"DescribeNodegroup":               {},
+		"DescribePodIdentityAssociation":  {},
+		"DescribeUpdate":                  {},
+		"ListAccessEntries":               {},
+		"ListAccessPolicies":              {},
+		"ListAddons":                      {},
+		"ListAssociatedAccessPolicies":    {},
+		"ListClusters":                    {},
+		"ListEksAnywhereSubscriptions":    {},
+		"ListFargateProfiles":             {},
+		"ListIdentityProviderConfigs":     {},
+		"ListInsights":                    {},
+		"ListNodegroups":                  {},
+		"ListPodIdentityAssociations":     {},
+		"ListTagsForResource":             {},
+		"ListUpdates":                     {},
+	},
+	names.ELB: {
+		"DescribeAccountLimits":           {},
+		"DescribeInstanceHealth":          {},
+		"DescribeLoadBalancerAttributes":  {},
+		"DescribeLoadBalancerPolicies":    {},
+		"DescribeLoadBalancerPolicyTypes": {},
+		"DescribeLoadBalancers":           {},
+		"DescribeTags":                    {},
+	},
+	names.ELBV2: {
+		"DescribeAccountLimits":             {},
+		"DescribeCapacityReservation":       {},
+		"DescribeListenerAttributes":        {},
+		"DescribeListenerCertificates":      {},
+		"DescribeListeners":                 {},
+		"DescribeLoadBalancerAttributes":    {},
+		"DescribeLoadBalancers":             {},
+		"DescribeRules":                     {},
+		"DescribeSSLPolicies":               {},
+		"DescribeTags":                      {},
+		"DescribeTargetGroupAttributes":     {},
+		"DescribeTargetGroups":              {},
+		"DescribeTargetHealth":              {},
+		"DescribeTrustStoreAssociations":    {},
+		"DescribeTrustStoreRevocations":     {},
+		"DescribeTrustStores":               {},
+		"GetResourcePolicy":                 {},
+		"GetTrustStoreCaCertificatesBundle": {},
+		"GetTrustStoreRevocationContent":    {},
+	},
+	names.EMRContainers: {
+		"DescribeJobRun":                {},
+		"DescribeJobTemplate":           {},
+		"DescribeManagedEndpoint":       {},
+		"DescribeSecurityConfiguration": {},
+		"DescribeVirtualCluster":        {},
+		"ListJobRuns":                   {},
+		"ListJobTemplates":              {},
+		"ListManagedEndpoints":          {},
+		"ListSecurityConfigurations":    {},
+		"ListTagsForResource":           {},
+		"ListVirtualClusters":           {},
+	},
+	names.EMRServerless: {
+		"GetApplication":        {},
+		"GetDashboardForJobRun": {},
+		"GetJobRun":             {},
+		"ListApplications":      {},
+		"ListJobRunAttempts":    {},
+		"ListJobRuns":           {},
+		"ListTagsForResource":   {},
+	},
+	names.EVS: {
+		"GetEnvironment":       {},
+		"ListEnvironmentHosts": {},
+		"ListEnvironmentVlans": {},
+		"ListEnvironments":     {},
+		"ListTagsForResource":  {},
+	},
+	names.ElastiCache: {
+		"DescribeCacheClusters":               {},
+		"DescribeCacheEngineVersions":         {},
+		"DescribeCacheParameterGroups":        {},
+		"DescribeCacheParameters":             {},
+		"DescribeCacheSecurityGroups":         {},
+		"DescribeCacheSubnetGroups":           {},
+		"DescribeEngineDefaultParameters":     {},
+		"DescribeEvents":                      {},
+		"DescribeGlobalReplicationGroups":     {},
+		"DescribeReplicationGroups":           {},
+		"DescribeReservedCacheNodes":          {},
+		"DescribeReservedCacheNodesOfferings": {},
+		"DescribeServerlessCacheSnapshots":    {},
+		"DescribeServerlessCaches":            {},
+		"DescribeServiceUpdates":              {},
+		"DescribeSnapshots":                   {},
+		"DescribeUpdateActions":               {},
+		"DescribeUserGroups":                  {},
+		"DescribeUsers":                       {},
+		"ListAllowedNodeTypeModifications":    {},
+		"ListTagsForResource":                 {},
+	},
+	names.ElasticBeanstalk: {
+		"DescribeAccountAttributes":               {},
+		"DescribeApplicationVersions":             {},
+		"DescribeApplications":                    {},
+		"DescribeConfigurationOptions":            {},
+		"DescribeConfigurationSettings":           {},
+		"DescribeEnvironmentHealth":               {},
+		"DescribeEnvironmentManagedActionHistory": {},
+		"DescribeEnvironmentManagedActions":       {},
+		"DescribeEnvironmentResources":            {},
+		"DescribeEnvironments":                    {},
+		"DescribeEvents":                          {},
+		"DescribeInstancesHealth":                 {},
+		"DescribePlatformVersion":                 {},
+		"ListAvailableSolutionStacks":             {},
+		"ListPlatformBranches":                    {},
+		"ListPlatformVersions":                    {},
+		"ListTagsForResource":                     {},
+		"ValidateConfigurationSettings":           {},
+	},
+	names.ElasticTranscoder: {
+		"ListJobsByPipeline": {},
+		"ListJobsByStatus":   {},
+		"ListPipelines":      {},
+		"ListPresets":        {},
+	},
+	names.Elasticsearch: {
+		"DescribeDomainAutoTunes":                        {},
+		"DescribeDomainChangeProgress":                   {},
+		"DescribeElasticsearchDomain":                    {},
+		"DescribeElasticsearchDomainConfig":              {},
+		"DescribeElasticsearchDomains":                   {},
+		"DescribeElasticsearchInstanceTypeLimits":        {},
+		"DescribeInboundCrossClusterSearchConnections":   {},
+		"DescribeOutboundCrossClusterSearchConnections":  {},
+		"DescribePackages":                               {},
+		"DescribeReservedElasticsearchInstanceOfferings": {},
+		"DescribeReservedElasticsearchInstances":         {},
+		"DescribeVpcEndpoints":                           {},
+		"GetCompatibleElasticsearchVersions":             {},
+		"GetPackageVersionHistory":                       {},
+		"GetUpgradeHistory":                              {},
+		"GetUpgradeStatus":                               {},
+		"ListDomainNames":                                {},
+		"ListDomainsForPackage":                          {},
+		"ListElasticsearchInstanceTypes":                 {},
+		"ListElasticsearchVersions":                      {},
+		"ListPackagesForDomain":                          {},
+		"ListTags":                                       {},
+		"ListVpcEndpointAccess":                          {},
+		"ListVpcEndpoints":                               {},
+		"ListVpcEndpointsForDomain":                      {},
+	},
+	names.Events: {
+		"DescribeApiDestination":         {},
+		"DescribeArchive":                {},
+		"DescribeConnection":             {},
+		"DescribeEndpoint":               {},
+		"DescribeEventBus":               {},
+		"DescribeEventSource":            {},
+		"DescribePartnerEventSource":     {},
+		"DescribeReplay":                 {},
+		"DescribeRule":                   {},
+		"ListApiDestinations":            {},
+		"ListArchives":                   {},
+		"ListConnections":                {},
+		"ListEndpoints":                  {},
+		"ListEventBuses":                 {},
+		"ListEventSources":               {},
+		"ListPartnerEventSourceAccounts": {},
+		"ListPartnerEventSources":        {},
+		"ListReplays":                    {},
+		"ListRuleNamesByTarget":          {},
+		"ListRules":                      {},
+		"ListTagsForResource":            {},
+		"ListTargetsByRule":              {},
+	},
+	names.Evidently: {
+		"GetExperiment":         {},
+		"GetExperimentResults":  {},
+		"GetFeature":            {},
+		"GetLaunch":             {},
+		"GetProject":            {},
+		"GetSegment":            {},
+		"ListExperiments":       {},
+		"ListFeatures":          {},
+		"ListLaunches":          {},
+		"ListProjects":          {},
+		"ListSegmentReferences": {},
+		"ListSegments":          {},
+		"ListTagsForResource":   {},
+	},
+	names.FIS: {
+		"GetAction":     {},
+		"GetExperiment": {},
+		"GetExperimentTargetAccountConfiguration":   {},
+		"GetExperimentTemplate":                     {},
+		"GetSafetyLever":                            {},
+		"GetTargetAccountConfiguration":             {},
+		"GetTargetResourceType":                     {},
+		"ListActions":                               {},
+		"ListExperimentResolvedTargets":             {},
+		"ListExperimentTargetAccountConfigurations": {},
+		"ListExperimentTemplates":                   {},
+		"ListExperiments":                           {},
+		"ListTagsForResource":                       {},
+		"ListTargetAccountConfigurations":           {},
+		"ListTargetResourceTypes":                   {},
+	},
+	names.FMS: {
+		"GetAdminAccount":                        {},
+		"GetAdminScope":                          {},
+		"GetAppsList":                            {},
+		"GetComplianceDetail":                    {},
+		"GetNotificationChannel":                 {},
+		"GetPolicy":                              {},
+		"GetProtectionStatus":                    {},
+		"GetProtocolsList":                       {},
+		"GetResourceSet":                         {},
+		"GetThirdPartyFirewallAssociationStatus": {},
+		"GetViolationDetails":                    {},
+		"ListAdminAccountsForOrganization":       {},
+		"ListAdminsManagingAccount":              {},
+		"ListAppsLists":                          {},
+		"ListComplianceStatus":                   {},
+		"ListDiscoveredResources":                {},
+		"ListMemberAccounts":                     {},
+		"ListPolicies":                           {},
+		"ListProtocolsLists":                     {},
+		"ListResourceSetResources":               {},
+		"ListResourceSets":                       {},
+		"ListTagsForResource":                    {},
+		"ListThirdPartyFirewallFirewallPolicies": {},
+	},
+	names.FSx: {
+		"DescribeBackups":                    {},
+		"DescribeDataRepositoryAssociations": {},
+		"DescribeDataRepositoryTasks":        {},
+		"DescribeFileCaches":                 {},
+		"DescribeFileSystemAliases":          {},
+		"DescribeFileSystems":                {},
+		"DescribeS3AccessPointAttachments":   {},
+		"DescribeSharedVpcConfiguration":     {},
+		"DescribeSnapshots":                  {},
+		"DescribeStorageVirtualMachines":     {},
+		"DescribeVolumes":                    {},
+		"ListTagsForResource":                {},
+	},
+	names.FinSpace: {
+		"GetEnvironment":        {},
+		"GetKxChangeset":        {},
+		"GetKxCluster":          {},
+		"GetKxConnectionString": {},
+		"GetKxDatabase":         {},
+		"GetKxDataview":         {},
+		"GetKxEnvironment":      {},
+		"GetKxScalingGroup":     {},
+		"GetKxUser":             {},
+		"GetKxVolume":           {},
+		"ListEnvironments":      {},
+		"ListKxChangesets":      {},
+		"ListKxClusterNodes":    {},
+		"ListKxClusters":        {},
+		"ListKxDatabases":       {},
+		"ListKxDataviews":       {},
+		"ListKxEnvironments":    {},
+		"ListKxScalingGroups":   {},
+		"ListKxUsers":           {},
+		"ListKxVolumes":         {},
+		"ListTagsForResource":   {},
+	},
+	names.Firehose: {
+		"DescribeDeliveryStream":    {},
+		"ListDeliveryStreams":       {},
+		"ListTagsForDeliveryStream": {},
+	},
+	names.GameLift: {
+		"DescribeAlias":                        {},
+		"DescribeBuild":                        {},
+		"DescribeCompute":                      {},
+		"DescribeContainerFleet":               {},
+		"DescribeContainerGroupDefinition":     {},
+		"DescribeEC2InstanceLimits":            {},
+		"DescribeFleetAttributes":              {},
+		"DescribeFleetCapacity":                {},
+		"DescribeFleetDeployment":              {},
+		"DescribeFleetEvents":                  {},
+		"DescribeFleetLocationAttributes":      {},
+		"DescribeFleetLocationCapacity":        {},
+		"DescribeFleetLocationUtilization":     {},
+		"DescribeFleetPortSettings":            {},
+		"DescribeFleetUtilization":             {},
+		"DescribeGameServer":                   {},
+		"DescribeGameServerGroup":              {},
+		"DescribeGameServerInstances":          {},
+		"DescribeGameSessionDetails":           {},
+		"DescribeGameSessionPlacement":         {},
+		"DescribeGameSessionQueues":            {},
+		"DescribeGameSessions":                 {},
+		"DescribeInstances":                    {},
+		"DescribeMatchmaking":                  {},
+		"DescribeMatchmakingConfigurations":    {},
+		"DescribeMatchmakingRuleSets":          {},
+		"DescribePlayerSessions":               {},
+		"DescribeRuntimeConfiguration":         {},
+		"DescribeScalingPolicies":              {},
+		"DescribeScript":                       {},
+		"DescribeVpcPeeringAuthorizations":     {},
+		"DescribeVpcPeeringConnections":        {},
+		"GetComputeAccess":                     {},
+		"GetGameSessionLogUrl":                 {},
+		"GetInstanceAccess":                    {},
+		"ListAliases":                          {},
+		"ListBuilds":                           {},
+		"ListCompute":                          {},
+		"ListContainerFleets":                  {},
+		"ListContainerGroupDefinitionVersions": {},
+		"ListContainerGroupDefinitions":        {},
+		"ListFleetDeployments":                 {},
+		"ListFleets":                           {},
+		"ListGameServerGroups":                 {},
+		"ListGameServers":                      {},
+		"ListLocations":                        {},
+		"ListScripts":                          {},
+		"ListTagsForResource":                  {},
+		"SearchGameSessions":                   {},
+		"ValidateMatchmakingRuleSet":           {},
+	},
+	names.Glacier: {
+		"DescribeJob":             {},
+		"DescribeVault":           {},
+		"GetDataRetrievalPolicy":  {},
+		"GetJobOutput":            {},
+		"GetVaultAccessPolicy":    {},
+		"GetVaultLock":            {},
+		"GetVaultNotifications":   {},
+		"ListJobs":                {},
+		"ListMultipartUploads":    {},
+		"ListParts":               {},
+		"ListProvisionedCapacity": {},
+		"ListTagsForVault":        {},
+		"ListVaults":              {},
+	},
+	names.GlobalAccelerator: {
+		"DescribeAccelerator":                        {},
+		"DescribeAcceleratorAttributes":              {},
+		"DescribeCrossAccountAttachment":             {},
+		"DescribeCustomRoutingAccelerator":           {},
+		"DescribeCustomRoutingAcceleratorAttributes": {},
+		"DescribeCustomRoutingEndpointGroup":         {},
+		"DescribeCustomRoutingListener":              {},
+		"DescribeEndpointGroup":                      {},
+		"DescribeListener":                           {},
+		"ListAccelerators":                           {},
+		"ListByoipCidrs":                             {},
+		"ListCrossAccountAttachments":                {},
+		"ListCrossAccountResourceAccounts":           {},
+		"ListCrossAccountResources":                  {},
+		"ListCustomRoutingAccelerators":              {},
+		"ListCustomRoutingEndpointGroups":            {},
+		"ListCustomRoutingListeners":                 {},
+		"ListCustomRoutingPortMappings":              {},
+		"ListCustomRoutingPortMappingsByDestination": {},
+		"ListEndpointGroups":                         {},
+		"ListListeners":                              {},
+		"ListTagsForResource":                        {},
+	},
+	names.Glue: {
+		"BatchGetBlueprints":                    {},
+		"BatchGetCrawlers":                      {},
+		"BatchGetCustomEntityTypes":             {},
+		"BatchGetDataQualityResult":             {},
+		"BatchGetDevEndpoints":                  {},
+		"BatchGetJobs":                          {},
+		"BatchGetPartition":                     {},
+		"BatchGetTableOptimizer":                {},
+		"BatchGetTriggers":                      {},
+		"BatchGetWorkflows":                     {},
+		"DescribeConnectionType":                {},
+		"DescribeEntity":                        {},
+		"DescribeInboundIntegrations":           {},
+		"DescribeIntegrations":                  {},
+		"GetBlueprint":                          {},
+		"GetBlueprintRun":                       {},
+		"GetBlueprintRuns":                      {},
+		"GetCatalog":                            {},
+		"GetCatalogImportStatus":                {},
+		"GetCatalogs":                           {},
+		"GetClassifier":                         {},
+		"GetClassifiers":                        {},
+		"GetColumnStatisticsForPartition":       {},
+		"GetColumnStatisticsForTable":           {},
+		"GetColumnStatisticsTaskRun":            {},
+		"GetColumnStatisticsTaskRuns":           {},
+		"GetColumnStatisticsTaskSettings":       {},
+		"GetConnection":                         {},
+		"GetConnections":                        {},
+		"GetCrawler":                            {},
+		"GetCrawlerMetrics":                     {},
+		"GetCrawlers":                           {},
+		"GetCustomEntityType":                   {},
+		"GetDataCatalogEncryptionSettings":      {},
+		"GetDataQualityModel":                   {},
+		"GetDataQualityModelResult":             {},
+		"GetDataQualityResult":                  {},
+		"GetDataQualityRuleRecommendationRun":   {},
+		"GetDataQualityRuleset":                 {},
+		"GetDataQualityRulesetEvaluationRun":    {},
+		"GetDatabase":                           {},
+		"GetDatabases":                          {},
+		"GetDataflowGraph":                      {},
+		"GetDevEndpoint":                        {},
+		"GetDevEndpoints":                       {},
+		"GetEntityRecords":                      {},
+		"GetGlueIdentityCenterConfiguration":    {},
+		"GetIntegrationResourceProperty":        {},
+		"GetIntegrationTableProperties":         {},
+		"GetJob":                                {},
+		"GetJobBookmark":                        {},
+		"GetJobRun":                             {},
+		"GetJobRuns":                            {},
+		"GetJobs":                               {},
+		"GetMLTaskRun":                          {},
+		"GetMLTaskRuns":                         {},
+		"GetMLTransform":                        {},
+		"GetMLTransforms":                       {},
+		"GetMapping":                            {},
+		"GetPartition":                          {},
+		"GetPartitionIndexes":                   {},
+		"GetPartitions":                         {},
+		"GetPlan":                               {},
+		"GetRegistry":                           {},
+		"GetResourcePolicies":                   {},
+		"GetResourcePolicy":                     {},
+		"GetSchema":                             {},
+		"GetSchemaByDefinition":                 {},
+		"GetSchemaVersion":                      {},
+		"GetSchemaVersionsDiff":                 {},
+		"GetSecurityConfiguration":              {},
+		"GetSecurityConfigurations":             {},
+		"GetSession":                            {},
+		"GetStatement":                          {},
+		"GetTable":                              {},
+		"GetTableOptimizer":                     {},
+		"GetTableVersion":                       {},
+		"GetTableVersions":                      {},
+		"GetTables":                             {},
+		"GetTags":                               {},
+		"GetTrigger":                            {},
+		"GetTriggers":                           {},
+		"GetUnfilteredPartitionMetadata":        {},
+		"GetUnfilteredPartitionsMetadata":       {},
+		"GetUnfilteredTableMetadata":            {},
+		"GetUsageProfile":                       {},
+		"GetUserDefinedFunction":                {},
+		"GetUserDefinedFunctions":               {},
+		"GetWorkflow":                           {},
+		"GetWorkflowRun":                        {},
+		"GetWorkflowRunProperties":              {},
+		"GetWorkflowRuns":                       {},
+		"ListBlueprints":                        {},
+		"ListColumnStatisticsTaskRuns":          {},
+		"ListConnectionTypes":                   {},
+		"ListCrawlers":                          {},
+		"ListCrawls":                            {},
+		"ListCustomEntityTypes":                 {},
+		"ListDataQualityResults":                {},
+		"ListDataQualityRuleRecommendationRuns": {},
+		"ListDataQualityRulesetEvaluationRuns":  {},
+		"ListDataQualityRulesets":               {},
+		"ListDataQualityStatisticAnnotations":   {},
+		"ListDataQualityStatistics":             {},
+		"ListDevEndpoints":                      {},
+		"ListEntities":                          {},
+		"ListJobs":                              {},
+		"ListMLTransforms":                      {},
+		"ListRegistries":                        {},
+		"ListSchemaVersions":                    {},
+		"ListSchemas":                           {},
+		"ListSessions":                          {},
+		"ListStatements":                        {},
+		"ListTableOptimizerRuns":                {},
+		"ListTriggers":                          {},
+		"ListUsageProfiles":                     {},
+		"ListWorkflows":                         {},
+		"QuerySchemaVersionMetadata":            {},
+		"SearchTables":                          {},
+	},
+	names.Grafana: {
+		"DescribeWorkspace":                 {},
+		"DescribeWorkspaceAuthentication":   {},
+		"DescribeWorkspaceConfiguration":    {},
+		"ListPermissions":                   {},
+		"ListTagsForResource":               {},
+		"ListVersions":                      {},
+		"ListWorkspaceServiceAccountTokens": {},
+		"ListWorkspaceServiceAccounts":      {},
+		"ListWorkspaces":                    {},
+	},
+	names.Greengrass: {
+		"GetAssociatedRole":                  {},
+		"GetBulkDeploymentStatus":            {},
+		"GetConnectivityInfo":                {},
+		"GetConnectorDefinition":             {},
+		"GetConnectorDefinitionVersion":      {},
+		"GetCoreDefinition":                  {},
+		"GetCoreDefinitionVersion":           {},
+		"GetDeploymentStatus":                {},
+		"GetDeviceDefinition":                {},
+		"GetDeviceDefinitionVersion":         {},
+		"GetFunctionDefinition":              {},
+		"GetFunctionDefinitionVersion":       {},
+		"GetGroup":                           {},
+		"GetGroupCertificateAuthority":       {},
+		"GetGroupCertificateConfiguration":   {},
+		"GetGroupVersion":                    {},
+		"GetLoggerDefinition":                {},
+		"GetLoggerDefinitionVersion":         {},
+		"GetResourceDefinition":              {},
+		"GetResourceDefinitionVersion":       {},
+		"GetServiceRoleForAccount":           {},
+		"GetSubscriptionDefinition":          {},
+		"GetSubscriptionDefinitionVersion":   {},
+		"GetThingRuntimeConfiguration":       {},
+		"ListBulkDeploymentDetailedReports":  {},
+		"ListBulkDeployments":                {},
+		"ListConnectorDefinitionVersions":    {},
+		"ListConnectorDefinitions":           {},
+		"ListCoreDefinitionVersions":         {},
+		"ListCoreDefinitions":                {},
+		"ListDeployments":                    {},
+		"ListDeviceDefinitionVersions":       {},
+		"ListDeviceDefinitions":              {},
+		"ListFunctionDefinitionVersions":     {},
+		"ListFunctionDefinitions":            {},
+		"ListGroupCertificateAuthorities":    {},
+		"ListGroupVersions":                  {},
+		"ListGroups":                         {},
+		"ListLoggerDefinitionVersions":       {},
+		"ListLoggerDefinitions":              {},
+		"ListResourceDefinitionVersions":     {},
+		"ListResourceDefinitions":            {},
+		"ListSubscriptionDefinitionVersions": {},
+		"ListSubscriptionDefinitions":        {},
+		"ListTagsForResource":                {},
+	},
+	names.GroundStation: {
+		"DescribeContact":            {},
+		"DescribeEphemeris":          {},
+		"GetAgentConfiguration":      {},
+		"GetConfig":                  {},
+		"GetDataflowEndpointGroup":   {},
+		"GetMinuteUsage":             {},
+		"GetMissionProfile":          {},
+		"GetSatellite":               {},
+		"ListConfigs":                {},
+		"ListContacts":               {},
+		"ListDataflowEndpointGroups": {},
+		"ListEphemerides":            {},
+		"ListGroundStations":         {},
+		"ListMissionProfiles":        {},
+		"ListSatellites":             {},
+		"ListTagsForResource":        {},
+	},
+	names.GuardDuty: {
+		"DescribeMalwareScans":              {},
+		"DescribeOrganizationConfiguration": {},
+		"DescribePublishingDestination":     {},
+		"GetAdministratorAccount":           {},
+		"GetCoverageStatistics":             {},
+		"GetDetector":                       {},
+		"GetFilter":                         {},
+		"GetFindings":                       {},
+		"GetFindingsStatistics":             {},
+		"GetIPSet":                          {},
+		"GetInvitationsCount":               {},
+		"GetMalwareProtectionPlan":          {},
+		"GetMalwareScanSettings":            {},
+		"GetMasterAccount":                  {},
+		"GetMemberDetectors":                {},
+		"GetMembers":                        {},
+		"GetOrganizationStatistics":         {},
+		"GetRemainingFreeTrialDays":         {},
+		"GetThreatEntitySet":                {},
+		"GetThreatIntelSet":                 {},
+		"GetTrustedEntitySet":               {},
+		"GetUsageStatistics":                {},
+		"ListCoverage":                      {},
+		"ListDetectors":                     {},
+		"ListFilters":                       {},
+		"ListFindings":                      {},
+		"ListIPSets":                        {},
+		"ListInvitations":                   {},
+		"ListMalwareProtectionPlans":        {},
+		"ListMembers":                       {},
+		"ListOrganizationAdminAccounts":     {},
+		"ListPublishingDestinations":        {},
+		"ListTagsForResource":               {},
+		"ListThreatEntitySets":              {},
+		"ListThreatIntelSets":               {},
+		"ListTrustedEntitySets":             {},
+	},
+	names.HealthLake: {
+		"DescribeFHIRDatastore": {},
+		"DescribeFHIRExportJob": {},
+		"DescribeFHIRImportJob": {},
+		"ListFHIRDatastores":    {},
+		"ListFHIRExportJobs":    {},
+		"ListFHIRImportJobs":    {},
+		"ListTagsForResource":   {},
+	},
+	names.IAM: {
+		"GetAccessKeyLastUsed":                      {},
+		"GetAccountAuthorizationDetails":            {},
+		"GetAccountPasswordPolicy":                  {},
+		"GetAccountSummary":                         {},
+		"GetContextKeysForCustomPolicy":             {},
+		"GetContextKeysForPrincipalPolicy":          {},
+		"GetCredentialReport":                       {},
+		"GetGroup":                                  {},
+		"GetGroupPolicy":                            {},
+		"GetInstanceProfile":                        {},
+		"GetLoginProfile":                           {},
+		"GetMFADevice":                              {},
+		"GetOpenIDConnectProvider":                  {},
+		"GetOrganizationsAccessReport":              {},
+		"GetPolicy":                                 {},
+		"GetPolicyVersion":                          {},
+		"GetRole":                                   {},
+		"GetRolePolicy":                             {},
+		"GetSAMLProvider":                           {},
+		"GetSSHPublicKey":                           {},
+		"GetServerCertificate":                      {},
+		"GetServiceLastAccessedDetails":             {},
+		"GetServiceLastAccessedDetailsWithEntities": {},
+		"GetServiceLinkedRoleDeletionStatus":        {},
+		"GetUser":                                   {},
+		"GetUserPolicy":                             {},
+		"ListAccessKeys":                            {},
+		"ListAccountAliases":                        {},
+		"ListAttachedGroupPolicies":                 {},
+		"ListAttachedRolePolicies":                  {},
+		"ListAttachedUserPolicies":                  {},
+		"ListEntitiesForPolicy":                     {},
+		"ListGroupPolicies":                         {},
+		"ListGroups":                                {},
+		"ListGroupsForUser":                         {},
+		"ListInstanceProfileTags":                   {},
+		"ListInstanceProfiles":                      {},
+		"ListInstanceProfilesForRole":               {},
+		"ListMFADeviceTags":                         {},
+		"ListMFADevices":                            {},
+		"ListOpenIDConnectProviderTags":             {},
+		"ListOpenIDConnectProviders":                {},
+		"ListOrganizationsFeatures":                 {},
+		"ListPolicies":                              {},
+		"ListPoliciesGrantingServiceAccess":         {},
+		"ListPolicyTags":                            {},
+		"ListPolicyVersions":                        {},
+		"ListRolePolicies":                          {},
+		"ListRoleTags":                              {},
+		"ListRoles":                                 {},
+		"ListSAMLProviderTags":                      {},
+		"ListSAMLProviders":                         {},
+		"ListSSHPublicKeys":                         {},
+		"ListServerCertificateTags":                 {},
+		"ListServerCertificates":                    {},
+		"ListServiceSpecificCredentials":            {},
+		"ListSigningCertificates":                   {},
+		"ListUserPolicies":                          {},
+		"ListUserTags":                              {},
+		"ListUsers":                                 {},
+		"ListVirtualMFADevices":                     {},
+		"SimulateCustomPolicy":                      {},
+		"SimulatePrincipalPolicy":                   {},
+	},
+	names.IVS: {
+		"BatchGetChannel":                 {},
+		"BatchGetStreamKey":               {},
+		"GetChannel":                      {},
+		"GetPlaybackKeyPair":              {},
+		"GetPlaybackRestrictionPolicy":    {},
+		"GetRecordingConfiguration":       {},
+		"GetStream":                       {},
+		"GetStreamKey":                    {},
+		"GetStreamSession":                {},
+		"ListChannels":                    {},
+		"ListPlaybackKeyPairs":            {},
+		"ListPlaybackRestrictionPolicies": {},
+		"ListRecordingConfigurations":     {},
+		"ListStreamKeys":                  {},
+		"ListStreamSessions":              {},
+		"ListStreams":                     {},
+		"ListTagsForResource":             {},
+	},
+	names.IVSChat: {
+		"GetLoggingConfiguration":   {},
+		"GetRoom":                   {},
+		"ListLoggingConfigurations": {},
+		"ListRooms":                 {},
+		"ListTagsForResource":       {},
+	},
+	names.IdentityStore: {
+		"DescribeGroup":                 {},
+		"DescribeGroupMembership":       {},
+		"DescribeUser":                  {},
+		"GetGroupId":                    {},
+		"GetGroupMembershipId":          {},
+		"GetUserId":                     {},
+		"ListGroupMemberships":          {},
+		"ListGroupMembershipsForMember": {},
+		"ListGroups":                    {},
+		"ListUsers":                     {},
+	},
+	names.ImageBuilder: {
+		"GetComponent":                     {},
+		"GetComponentPolicy":               {},
+		"GetContainerRecipe":               {},
+		"GetContainerRecipePolicy":         {},
+		"GetDistributionConfiguration":     {},
+		"GetImage":                         {},
+		"GetImagePipeline":                 {},
+		"GetImagePolicy":                   {},
+		"GetImageRecipe":                   {},
+		"GetImageRecipePolicy":             {},
+		"GetInfrastructureConfiguration":   {},
+		"GetLifecycleExecution":            {},
+		"GetLifecyclePolicy":               {},
+		"GetMarketplaceResource":           {},
+		"GetWorkflow":                      {},
+		"GetWorkflowExecution":             {},
+		"GetWorkflowStepExecution":         {},
+		"ListComponentBuildVersions":       {},
+		"ListComponents":                   {},
+		"ListContainerRecipes":             {},
+		"ListDistributionConfigurations":   {},
+		"ListImageBuildVersions":           {},
+		"ListImagePackages":                {},
+		"ListImagePipelineImages":          {},
+		"ListImagePipelines":               {},
+		"ListImageRecipes":                 {},
+		"ListImageScanFindingAggregations": {},
+		"ListImageScanFindings":            {},
+		"ListImages":                       {},
+		"ListInfrastructureConfigurations": {},
+		"ListLifecycleExecutionResources":  {},
+		"ListLifecycleExecutions":          {},
+		"ListLifecyclePolicies":            {},
+		"ListTagsForResource":              {},
+		"ListWaitingWorkflowSteps":         {},
+		"ListWorkflowBuildVersions":        {},
+		"ListWorkflowExecutions":           {},
+		"ListWorkflowStepExecutions":       {},
+		"ListWorkflows":                    {},
+	},
+	names.Inspector: {
+		"DescribeAssessmentRuns":         {},
+		"DescribeAssessmentTargets":      {},
+		"DescribeAssessmentTemplates":    {},
+		"DescribeCrossAccountAccessRole": {},
+		"DescribeExclusions":             {},
+		"DescribeFindings":               {},
+		"DescribeResourceGroups":         {},
+		"DescribeRulesPackages":          {},
+		"GetAssessmentReport":            {},
+		"GetExclusionsPreview":           {},
+		"GetTelemetryMetadata":           {},
+		"ListAssessmentRunAgents":        {},
+		"ListAssessmentRuns":             {},
+		"ListAssessmentTargets":          {},
+		"ListAssessmentTemplates":        {},
+		"ListEventSubscriptions":         {},
+		"ListExclusions":                 {},
+		"ListFindings":                   {},
+		"ListRulesPackages":              {},
+		"ListTagsForResource":            {},
+	},
+	names.Inspector2: {
+		"BatchGetAccountStatus":                         {},
+		"BatchGetCodeSnippet":                           {},
+		"BatchGetFindingDetails":                        {},
+		"BatchGetFreeTrialInfo":                         {},
+		"BatchGetMemberEc2DeepInspectionStatus":         {},
+		"DescribeOrganizationConfiguration":             {},
+		"GetCisScanReport":                              {},
+		"GetCisScanResultDetails":                       {},
+		"GetClustersForImage":                           {},
+		"GetCodeSecurityIntegration":                    {},
+		"GetCodeSecurityScan":                           {},
+		"GetCodeSecurityScanConfiguration":              {},
+		"GetConfiguration":                              {},
+		"GetDelegatedAdminAccount":                      {},
+		"GetEc2DeepInspectionConfiguration":             {},
+		"GetEncryptionKey":                              {},
+		"GetFindingsReportStatus":                       {},
+		"GetMember":                                     {},
+		"GetSbomExport":                                 {},
+		"ListAccountPermissions":                        {},
+		"ListCisScanConfigurations":                     {},
+		"ListCisScanResultsAggregatedByChecks":          {},
+		"ListCisScanResultsAggregatedByTargetResource":  {},
+		"ListCisScans":                                  {},
+		"ListCodeSecurityIntegrations":                  {},
+		"ListCodeSecurityScanConfigurationAssociations": {},
+		"ListCodeSecurityScanConfigurations":            {},
+		"ListCoverage":                                  {},
+		"ListCoverageStatistics":                        {},
+		"ListDelegatedAdminAccounts":                    {},
+		"ListFilters":                                   {},
+		"ListFindingAggregations":                       {},
+		"ListFindings":                                  {},
+		"ListMembers":                                   {},
+		"ListTagsForResource":                           {},
+		"ListUsageTotals":                               {},
+		"SearchVulnerabilities":                         {},
+	},
+	names.InternetMonitor: {
+		"GetHealthEvent":      {},
+		"GetInternetEvent":    {},
+		"GetMonitor":          {},
+		"GetQueryResults":     {},
+		"GetQueryStatus":      {},
+		"ListHealthEvents":    {},
+		"ListInternetEvents":  {},
+		"ListMonitors":        {},
+		"ListTagsForResource": {},
+	},
+	names.Invoicing: {
+		"BatchGetInvoiceProfile": {},
+		"GetInvoiceUnit":         {},
+		"ListInvoiceSummaries":   {},
+		"ListInvoiceUnits":       {},
+		"ListTagsForResource":    {},
+	},
+	names.IoT: {
+		"DescribeAccountAuditConfiguration":     {},
+		"DescribeAuditFinding":                  {},
+		"DescribeAuditMitigationActionsTask":    {},
+		"DescribeAuditSuppression":              {},
+		"DescribeAuditTask":                     {},
+		"DescribeAuthorizer":                    {},
+		"DescribeBillingGroup":                  {},
+		"DescribeCACertificate":                 {},
+		"DescribeCertificate":                   {},
+		"DescribeCertificateProvider":           {},
+		"DescribeCustomMetric":                  {},
+		"DescribeDefaultAuthorizer":             {},
+		"DescribeDetectMitigationActionsTask":   {},
+		"DescribeDimension":                     {},
+		"DescribeDomainConfiguration":           {},
+		"DescribeEncryptionConfiguration":       {},
+		"DescribeEndpoint":                      {},
+		"DescribeEventConfigurations":           {},
+		"DescribeFleetMetric":                   {},
+		"DescribeIndex":                         {},
+		"DescribeJob":                           {},
+		"DescribeJobExecution":                  {},
+		"DescribeJobTemplate":                   {},
+		"DescribeManagedJobTemplate":            {},
+		"DescribeMitigationAction":              {},
+		"DescribeProvisioningTemplate":          {},
+		"DescribeProvisioningTemplateVersion":   {},
+		"DescribeRoleAlias":                     {},
+		"DescribeScheduledAudit":                {},
+		"DescribeSecurityProfile":               {},
+		"DescribeStream":                        {},
+		"DescribeThing":                         {},
+		"DescribeThingGroup":                    {},
+		"DescribeThingRegistrationTask":         {},
+		"DescribeThingType":                     {},
+		"GetBehaviorModelTrainingSummaries":     {},
+		"GetBucketsAggregation":                 {},
+		"GetCardinality":                        {},
+		"GetCommand":                            {},
+		"GetCommandExecution":                   {},
+		"GetEffectivePolicies":                  {},
+		"GetIndexingConfiguration":              {},
+		"GetJobDocument":                        {},
+		"GetLoggingOptions":                     {},
+		"GetOTAUpdate":                          {},
+		"GetPackage":                            {},
+		"GetPackageConfiguration":               {},
+		"GetPackageVersion":                     {},
+		"GetPercentiles":                        {},
+		"GetPolicy":                             {},
+		"GetPolicyVersion":                      {},
+		"GetRegistrationCode":                   {},
+		"GetStatistics":                         {},
+		"GetThingConnectivityData":              {},
+		"GetTopicRule":                          {},
+		"GetTopicRuleDestination":               {},
+		"GetV2LoggingOptions":                   {},
+		"ListActiveViolations":                  {},
+		"ListAttachedPolicies":                  {},
+		"ListAuditFindings":                     {},
+		"ListAuditMitigationActionsExecutions":  {},
+		"ListAuditMitigationActionsTasks":       {},
+		"ListAuditSuppressions":                 {},
+		"ListAuditTasks":                        {},
+		"ListAuthorizers":                       {},
+		"ListBillingGroups":                     {},
+		"ListCACertificates":                    {},
+		"ListCertificateProviders":              {},
+		"ListCertificates":                      {},
+		"ListCertificatesByCA":                  {},
+		"ListCommandExecutions":                 {},
+		"ListCommands":                          {},
+		"ListCustomMetrics":                     {},
+		"ListDetectMitigationActionsExecutions": {},
+		"ListDetectMitigationActionsTasks":      {},
+		"ListDimensions":                        {},
+		"ListDomainConfigurations":              {},
+		"ListFleetMetrics":                      {},
+		"ListIndices":                           {},
+		"ListJobExecutionsForJob":               {},
+		"ListJobExecutionsForThing":             {},
+		"ListJobTemplates":                      {},
+		"ListJobs":                              {},
+		"ListManagedJobTemplates":               {},
+		"ListMetricValues":                      {},
+		"ListMitigationActions":                 {},
+		"ListOTAUpdates":                        {},
+		"ListOutgoingCertificates":              {},
+		"ListPackageVersions":                   {},
+		"ListPackages":                          {},
+		"ListPolicies":                          {},
+		"ListPolicyPrincipals":                  {},
+		"ListPolicyVersions":                    {},
+		"ListPrincipalPolicies":                 {},
+		"ListPrincipalThings":                   {},
+		"ListPrincipalThingsV2":                 {},
+		"ListProvisioningTemplateVersions":      {},
+		"ListProvisioningTemplates":             {},
+		"ListRelatedResourcesForAuditFinding":   {},
+		"ListRoleAliases":                       {},
+		"ListSbomValidationResults":             {},
+		"ListScheduledAudits":                   {},
+		"ListSecurityProfiles":                  {},
+		"ListSecurityProfilesForTarget":         {},
+		"ListStreams":                           {},
+		"ListTagsForResource":                   {},
+		"ListTargetsForPolicy":                  {},
+		"ListTargetsForSecurityProfile":         {},
+		"ListThingGroups":                       {},
+		"ListThingGroupsForThing":               {},
+		"ListThingPrincipals":                   {},
+		"ListThingPrincipalsV2":                 {},
+		"ListThingRegistrationTaskReports":      {},
+		"ListThingRegistrationTasks":            {},
+		"ListThingTypes":                        {},
+		"ListThings":                            {},
+		"ListThingsInBillingGroup":              {},
+		"ListThingsInThingGroup":                {},
+		"ListTopicRuleDestinations":             {},
+		"ListTopicRules":                        {},
+		"ListV2LoggingLevels":                   {},
+		"ListViolationEvents":                   {},
+		"SearchIndex":                           {},
+		"ValidateSecurityProfileBehaviors":      {},
+	},
+	names.KMS: {
+		"DescribeCustomKeyStores": {},
+		"DescribeKey":             {},
+		"GetKeyPolicy":            {},
+		"GetKeyRotationStatus":    {},
+		"GetParametersForImport":  {},
+		"GetPublicKey":            {},
+		"ListAliases":             {},
+		"ListGrants":              {},
+		"ListKeyPolicies":         {},
+		"ListKeyRotations":        {},
+		"ListKeys":                {},
+		"ListResourceTags":        {},
+		"ListRetirableGrants":     {},
+		"Verify":                  {},
+		"VerifyMac":               {},
+	},
+	names.Kafka: {
+		"DescribeCluster":               {},
+		"DescribeClusterOperation":      {},
+		"DescribeClusterOperationV2":    {},
+		"DescribeClusterV2":             {},
+		"DescribeConfiguration":         {},
+		"DescribeConfigurationRevision": {},
+		"DescribeReplicator":            {},
+		"DescribeVpcConnection":         {},
+		"GetBootstrapBrokers":           {},
+		"GetClusterPolicy":              {},
+		"GetCompatibleKafkaVersions":    {},
+		"ListClientVpcConnections":      {},
+		"ListClusterOperations":         {},
+		"ListClusterOperationsV2":       {},
+		"ListClusters":                  {},
+		"ListClustersV2":                {},
+		"ListConfigurationRevisions":    {},
+		"ListConfigurations":            {},
+		"ListKafkaVersions":             {},
+		"ListNodes":                     {},
+		"ListReplicators":               {},
+		"ListScramSecrets":              {},
+		"ListTagsForResource":           {},
+		"ListVpcConnections":            {},
+	},
+	names.KafkaConnect: {
+		"DescribeConnector":           {},
+		"DescribeConnectorOperation":  {},
+		"DescribeCustomPlugin":        {},
+		"DescribeWorkerConfiguration": {},
+		"ListConnectorOperations":     {},
+		"ListConnectors":              {},
+		"ListCustomPlugins":           {},
+		"ListTagsForResource":         {},
+		"ListWorkerConfigurations":    {},
+	},
+	names.Kendra: {
+		"BatchGetDocumentStatus":             {},
+		"DescribeAccessControlConfiguration": {},
+		"DescribeDataSource":                 {},
+		"DescribeExperience":                 {},
+		"DescribeFaq":                        {},
+		"DescribeFeaturedResultsSet":         {},
+		"DescribeIndex":                      {},
+		"DescribePrincipalMapping":           {},
+		"DescribeQuerySuggestionsBlockList":  {},
+		"DescribeQuerySuggestionsConfig":     {},
+		"DescribeThesaurus":                  {},
+		"GetQuerySuggestions":                {},
+		"GetSnapshots":                       {},
+		"ListAccessControlConfigurations":    {},
+		"ListDataSourceSyncJobs":             {},
+		"ListDataSources":                    {},
+		"ListEntityPersonas":                 {},
+		"ListExperienceEntities":             {},
+		"ListExperiences":                    {},
+		"ListFaqs":                           {},
+		"ListFeaturedResultsSets":            {},
+		"ListGroupsOlderThanOrderingId":      {},
+		"ListIndices":                        {},
+		"ListQuerySuggestionsBlockLists":     {},
+		"ListTagsForResource":                {},
+		"ListThesauri":                       {},
+		"Query":                              {},
+	},
+	names.Keyspaces: {
+		"GetKeyspace":                 {},
+		"GetTable":                    {},
+		"GetTableAutoScalingSettings": {},
+		"GetType":                     {},
+		"ListKeyspaces":               {},
+		"ListTables":                  {},
+		"ListTagsForResource":         {},
+		"ListTypes":                   {},
+	},
+	names.Kinesis: {
+		"DescribeLimits":         {},
+		"DescribeStream":         {},
+		"DescribeStreamConsumer": {},
+		"DescribeStreamSummary":  {},
+		"GetRecords":             {},
+		"GetResourcePolicy":      {},
+		"GetShardIterator":       {},
+		"ListShards":             {},
+		"ListStreamConsumers":    {},
+		"ListStreams":            {},
+		"ListTagsForResource":    {},
+		"ListTagsForStream":      {},
+	},
+	names.KinesisAnalytics: {
+		"DescribeApplication": {},
+		"ListApplications":    {},
+		"ListTagsForResource": {},
+	},
+	names.KinesisAnalyticsV2: {
+		"DescribeApplication":          {},
+		"DescribeApplicationOperation": {},
+		"DescribeApplicationSnapshot":  {},
+		"DescribeApplicationVersion":   {},
+		"ListApplicationOperations":    {},
+		"ListApplicationSnapshots":     {},
+		"ListApplicationVersions":      {},
+		"ListApplications":             {},
+		"ListTagsForResource":          {},
+	},
+	names.KinesisVideo: {
+		"DescribeEdgeConfiguration":            {},
+		"DescribeImageGenerationConfiguration": {},
+		"DescribeMappedResourceConfiguration":  {},
+		"DescribeMediaStorageConfiguration":    {},
+		"DescribeNotificationConfiguration":    {},
+		"DescribeSignalingChannel":             {},
+		"DescribeStream":                       {},
+		"GetDataEndpoint":                      {},
+		"GetSignalingChannelEndpoint":          {},
+		"ListEdgeAgentConfigurations":          {},
+		"ListSignalingChannels":                {},
+		"ListStreams":                          {},
+		"ListTagsForResource":                  {},
+		"ListTagsForStream":                    {},
+	},
+	names.LakeFormation: {
+		"DescribeLakeFormationIdentityCenterConfiguration": {},
+		"DescribeResource":               {},
+		"DescribeTransaction":            {},
+		"GetDataCellsFilter":             {},
+		"GetDataLakePrincipal":           {},
+		"GetDataLakeSettings":            {},
+		"GetEffectivePermissionsForPath": {},
+		"GetLFTag":                       {},
+		"GetLFTagExpression":             {},
+		"GetQueryState":                  {},
+		"GetQueryStatistics":             {},
+		"GetResourceLFTags":              {},
+		"GetTableObjects":                {},
+		"GetWorkUnitResults":             {},
+		"GetWorkUnits":                   {},
+		"ListDataCellsFilter":            {},
+		"ListLFTagExpressions":           {},
+		"ListLFTags":                     {},
+		"ListLakeFormationOptIns":        {},
+		"ListPermissions":                {},
+		"ListResources":                  {},
+		"ListTableStorageOptimizers":     {},
+		"ListTransactions":               {},
+		"SearchDatabasesByLFTags":        {},
+		"SearchTablesByLFTags":           {},
+	},
+	names.Lambda: {
+		"GetAccountSettings":                {},
+		"GetAlias":                          {},
+		"GetCodeSigningConfig":              {},
+		"GetEventSourceMapping":             {},
+		"GetFunction":                       {},
+		"GetFunctionCodeSigningConfig":      {},
+		"GetFunctionConcurrency":            {},
+		"GetFunctionConfiguration":          {},
+		"GetFunctionEventInvokeConfig":      {},
+		"GetFunctionRecursionConfig":        {},
+		"GetFunctionUrlConfig":              {},
+		"GetLayerVersion":                   {},
+		"GetLayerVersionByArn":              {},
+		"GetLayerVersionPolicy":             {},
+		"GetPolicy":                         {},
+		"GetProvisionedConcurrencyConfig":   {},
+		"GetRuntimeManagementConfig":        {},
+		"ListAliases":                       {},
+		"ListCodeSigningConfigs":            {},
+		"ListEventSourceMappings":           {},
+		"ListFunctionEventInvokeConfigs":    {},
+		"ListFunctionUrlConfigs":            {},
+		"ListFunctions":                     {},
+		"ListFunctionsByCodeSigningConfig":  {},
+		"ListLayerVersions":                 {},
+		"ListLayers":                        {},
+		"ListProvisionedConcurrencyConfigs": {},
+		"ListTags":                          {},
+		"ListVersionsByFunction":            {},
+	},
+	names.LaunchWizard: {
+		"GetDeployment":                  {},
+		"GetWorkload":                    {},
+		"GetWorkloadDeploymentPattern":   {},
+		"ListDeploymentEvents":           {},
+		"ListDeployments":                {},
+		"ListTagsForResource":            {},
+		"ListWorkloadDeploymentPatterns": {},
+		"ListWorkloads":                  {},
+	},
+	names.LexModels: {
+		"GetBot":                    {},
+		"GetBotAlias":               {},
+		"GetBotAliases":             {},
+		"GetBotChannelAssociation":  {},
+		"GetBotChannelAssociations": {},
+		"GetBotVersions":            {},
+		"GetBots":                   {},
+		"GetBuiltinIntent":          {},
+		"GetBuiltinIntents":         {},
+		"GetBuiltinSlotTypes":       {},
+		"GetExport":                 {},
+		"GetImport":                 {},
+		"GetIntent":                 {},
+		"GetIntentVersions":         {},
+		"GetIntents":                {},
+		"GetMigration":              {},
+		"GetMigrations":             {},
+		"GetSlotType":               {},
+		"GetSlotTypeVersions":       {},
+		"GetSlotTypes":              {},
+		"GetUtterancesView":         {},
+		"ListTagsForResource":       {},
+	},
+	names.LexV2Models: {
+		"DescribeBot":                      {},
+		"DescribeBotAlias":                 {},
+		"DescribeBotLocale":                {},
+		"DescribeBotRecommendation":        {},
+		"DescribeBotReplica":               {},
+		"DescribeBotResourceGeneration":    {},
+		"DescribeBotVersion":               {},
+		"DescribeCustomVocabularyMetadata": {},
+		"DescribeExport":                   {},
+		"DescribeImport":                   {},
+		"DescribeIntent":                   {},
+		"DescribeResourcePolicy":           {},
+		"DescribeSlot":                     {},
+		"DescribeSlotType":                 {},
+		"DescribeTestExecution":            {},
+		"DescribeTestSet":                  {},
+		"DescribeTestSetDiscrepancyReport": {},
+		"DescribeTestSetGeneration":        {},
+		"GetTestExecutionArtifactsUrl":     {},
+		"ListAggregatedUtterances":         {},
+		"ListBotAliasReplicas":             {},
+		"ListBotAliases":                   {},
+		"ListBotLocales":                   {},
+		"ListBotRecommendations":           {},
+		"ListBotReplicas":                  {},
+		"ListBotResourceGenerations":       {},
+		"ListBotVersionReplicas":           {},
+		"ListBotVersions":                  {},
+		"ListBots":                         {},
+		"ListBuiltInIntents":               {},
+		"ListBuiltInSlotTypes":             {},
+		"ListCustomVocabularyItems":        {},
+		"ListExports":                      {},
+		"ListImports":                      {},
+		"ListIntentMetrics":                {},
+		"ListIntentPaths":                  {},
+		"ListIntentStageMetrics":           {},
+		"ListIntents":                      {},
+		"ListRecommendedIntents":           {},
+		"ListSessionAnalyticsData":         {},
+		"ListSessionMetrics":               {},
+		"ListSlotTypes":                    {},
+		"ListSlots":                        {},
+		"ListTagsForResource":              {},
+		"ListTestExecutionResultItems":     {},
+		"ListTestExecutions":               {},
+		"ListTestSetRecords":               {},
+		"ListTestSets":                     {},
+		"ListUtteranceAnalyticsData":       {},
+		"ListUtteranceMetrics":             {},
+		"SearchAssociatedTranscripts":      {},
+	},
+	names.LicenseManager: {
+		"GetGrant":                                      {},
+		"GetLicense":                                    {},
+		"GetLicenseConfiguration":                       {},
+		"GetLicenseConversionTask":                      {},
+		"GetLicenseManagerReportGenerator":              {},
+		"GetLicenseUsage":                               {},
+		"GetServiceSettings":                            {},
+		"ListAssociationsForLicenseConfiguration":       {},
+		"ListDistributedGrants":                         {},
+		"ListFailuresForLicenseConfigurationOperations": {},
+		"ListLicenseConfigurations":                     {},
+		"ListLicenseConversionTasks":                    {},
+		"ListLicenseManagerReportGenerators":            {},
+		"ListLicenseSpecificationsForResource":          {},
+		"ListLicenseVersions":                           {},
+		"ListLicenses":                                  {},
+		"ListReceivedGrants":                            {},
+		"ListReceivedGrantsForOrganization":             {},
+		"ListReceivedLicenses":                          {},
+		"ListReceivedLicensesForOrganization":           {},
+		"ListResourceInventory":                         {},
+		"ListTagsForResource":                           {},
+		"ListTokens":                                    {},
+		"ListUsageForLicenseConfiguration":              {},
+	},
+	names.Lightsail: {
+		"DownloadDefaultKeyPair":                  {},
+		"GetActiveNames":                          {},
+		"GetAlarms":                               {},
+		"GetAutoSnapshots":                        {},
+		"GetBlueprints":                           {},
+		"GetBucketAccessKeys":                     {},
+		"GetBucketBundles":                        {},
+		"GetBucketMetricData":                     {},
+		"GetBuckets":                              {},
+		"GetBundles":                              {},
+		"GetCertificates":                         {},
+		"GetCloudFormationStackRecords":           {},
+		"GetContactMethods":                       {},
+		"GetContainerAPIMetadata":                 {},
+		"GetContainerImages":                      {},
+		"GetContainerLog":                         {},
+		"GetContainerServiceDeployments":          {},
+		"GetContainerServiceMetricData":           {},
+		"GetContainerServicePowers":               {},
+		"GetContainerServices":                    {},
+		"GetCostEstimate":                         {},
+		"GetDisk":                                 {},
+		"GetDiskSnapshot":                         {},
+		"GetDiskSnapshots":                        {},
+		"GetDisks":                                {},
+		"GetDistributionBundles":                  {},
+		"GetDistributionLatestCacheReset":         {},
+		"GetDistributionMetricData":               {},
+		"GetDistributions":                        {},
+		"GetDomain":                               {},
+		"GetDomains":                              {},
+		"GetExportSnapshotRecords":                {},
+		"GetInstance":                             {},
+		"GetInstanceAccessDetails":                {},
+		"GetInstanceMetricData":                   {},
+		"GetInstancePortStates":                   {},
+		"GetInstanceSnapshot":                     {},
+		"GetInstanceSnapshots":                    {},
+		"GetInstanceState":                        {},
+		"GetInstances":                            {},
+		"GetKeyPair":                              {},
+		"GetKeyPairs":                             {},
+		"GetLoadBalancer":                         {},
+		"GetLoadBalancerMetricData":               {},
+		"GetLoadBalancerTlsCertificates":          {},
+		"GetLoadBalancerTlsPolicies":              {},
+		"GetLoadBalancers":                        {},
+		"GetOperation":                            {},
+		"GetOperations":                           {},
+		"GetOperationsForResource":                {},
+		"GetRegions":                              {},
+		"GetRelationalDatabase":                   {},
+		"GetRelationalDatabaseBlueprints":         {},
+		"GetRelationalDatabaseBundles":            {},
+		"GetRelationalDatabaseEvents":             {},
+		"GetRelationalDatabaseLogEvents":          {},
+		"GetRelationalDatabaseLogStreams":         {},
+		"GetRelationalDatabaseMasterUserPassword": {},
+		"GetRelationalDatabaseMetricData":         {},
+		"GetRelationalDatabaseParameters":         {},
+		"GetRelationalDatabaseSnapshot":           {},
+		"GetRelationalDatabaseSnapshots":          {},
+		"GetRelationalDatabases":                  {},
+		"GetSetupHistory":                         {},
+		"GetStaticIp":                             {},
+		"GetStaticIps":                            {},
+	},
+	names.Location: {
+		"BatchGetDevicePosition":         {},
+		"DescribeGeofenceCollection":     {},
+		"DescribeKey":                    {},
+		"DescribeMap":                    {},
+		"DescribePlaceIndex":             {},
+		"DescribeRouteCalculator":        {},
+		"DescribeTracker":                {},
+		"GetDevicePosition":              {},
+		"GetDevicePositionHistory":       {},
+		"GetGeofence":                    {},
+		"GetMapGlyphs":                   {},
+		"GetMapSprites":                  {},
+		"GetMapStyleDescriptor":          {},
+		"GetMapTile":                     {},
+		"GetPlace":                       {},
+		"ListDevicePositions":            {},
+		"ListGeofenceCollections":        {},
+		"ListGeofences":                  {},
+		"ListKeys":                       {},
+		"ListMaps":                       {},
+		"ListPlaceIndexes":               {},
+		"ListRouteCalculators":           {},
+		"ListTagsForResource":            {},
+		"ListTrackerConsumers":           {},
+		"ListTrackers":                   {},
+		"SearchPlaceIndexForPosition":    {},
+		"SearchPlaceIndexForSuggestions": {},
+		"SearchPlaceIndexForText":        {},
+		"VerifyDevicePosition":           {},
+	},
+	names.Logs: {
+		"DescribeAccountPolicies":        {},
+		"DescribeConfigurationTemplates": {},
+		"DescribeDeliveries":             {},
+		"DescribeDeliveryDestinations":   {},
+		"DescribeDeliverySources":        {},
+		"DescribeDestinations":           {},
+		"DescribeExportTasks":            {},
+		"DescribeFieldIndexes":           {},
+		"DescribeIndexPolicies":          {},
+		"DescribeLogGroups":              {},
+		"DescribeLogStreams":             {},
+		"DescribeMetricFilters":          {},
+		"DescribeQueries":                {},
+		"DescribeQueryDefinitions":       {},
+		"DescribeResourcePolicies":       {},
+		"DescribeSubscriptionFilters":    {},
+		"GetDataProtectionPolicy":        {},
+		"GetDelivery":                    {},
+		"GetDeliveryDestination":         {},
+		"GetDeliveryDestinationPolicy":   {},
+		"GetDeliverySource":              {},
+		"GetIntegration":                 {},
+		"GetLogAnomalyDetector":          {},
+		"GetLogEvents":                   {},
+		"GetLogGroupFields":              {},
+		"GetLogObject":                   {},
+		"GetLogRecord":                   {},
+		"GetQueryResults":                {},
+		"GetTransformer":                 {},
+		"ListAnomalies":                  {},
+		"ListIntegrations":               {},
+		"ListLogAnomalyDetectors":        {},
+		"ListLogGroups":                  {},
+		"ListLogGroupsForQuery":          {},
+		"ListTagsForResource":            {},
+		"ListTagsLogGroup":               {},
+	},
+	names.M2: {
+		"GetApplication":            {},
+		"GetApplicationVersion":     {},
+		"GetBatchJobExecution":      {},
+		"GetDataSetDetails":         {},
+		"GetDataSetExportTask":      {},
+		"GetDataSetImportTask":      {},
+		"GetDeployment":             {},
+		"GetEnvironment":            {},
+		"GetSignedBluinsightsUrl":   {},
+		"ListApplicationVersions":   {},
+		"ListApplications":          {},
+		"ListBatchJobDefinitions":   {},
+		"ListBatchJobExecutions":    {},
+		"ListBatchJobRestartPoints": {},
+		"ListDataSetExportHistory":  {},
+		"ListDataSetImportHistory":  {},
+		"ListDataSets":              {},
+		"ListDeployments":           {},
+		"ListEngineVersions":        {},
+		"ListEnvironments":          {},
+		"ListTagsForResource":       {},
+	},
+	names.MQ: {
+		"DescribeBroker":                {},
+		"DescribeBrokerEngineTypes":     {},
+		"DescribeBrokerInstanceOptions": {},
+		"DescribeConfiguration":         {},
+		"DescribeConfigurationRevision": {},
+		"DescribeUser":                  {},
+		"ListBrokers":                   {},
+		"ListConfigurationRevisions":    {},
+		"ListConfigurations":            {},
+		"ListTags":                      {},
+		"ListUsers":                     {},
+	},
+	names.MWAA: {
+		"GetEnvironment":      {},
+		"ListEnvironments":    {},
+		"ListTagsForResource": {},
+	},
+	names.Macie2: {
+		"BatchGetCustomDataIdentifiers":           {},
+		"DescribeBuckets":                         {},
+		"DescribeClassificationJob":               {},
+		"DescribeOrganizationConfiguration":       {},
+		"GetAdministratorAccount":                 {},
+		"GetAllowList":                            {},
+		"GetAutomatedDiscoveryConfiguration":      {},
+		"GetBucketStatistics":                     {},
+		"GetClassificationExportConfiguration":    {},
+		"GetClassificationScope":                  {},
+		"GetCustomDataIdentifier":                 {},
+		"GetFindingStatistics":                    {},
+		"GetFindings":                             {},
+		"GetFindingsFilter":                       {},
+		"GetFindingsPublicationConfiguration":     {},
+		"GetInvitationsCount":                     {},
+		"GetMacieSession":                         {},
+		"GetMasterAccount":                        {},
+		"GetMember":                               {},
+		"GetResourceProfile":                      {},
+		"GetRevealConfiguration":                  {},
+		"GetSensitiveDataOccurrences":             {},
+		"GetSensitiveDataOccurrencesAvailability": {},
+		"GetSensitivityInspectionTemplate":        {},
+		"GetUsageStatistics":                      {},
+		"GetUsageTotals":                          {},
+		"ListAllowLists":                          {},
+		"ListAutomatedDiscoveryAccounts":          {},
+		"ListClassificationJobs":                  {},
+		"ListClassificationScopes":                {},
+		"ListCustomDataIdentifiers":               {},
+		"ListFindings":                            {},
+		"ListFindingsFilters":                     {},
+		"ListInvitations":                         {},
+		"ListManagedDataIdentifiers":              {},
+		"ListMembers":                             {},
+		"ListOrganizationAdminAccounts":           {},
+		"ListResourceProfileArtifacts":            {},
+		"ListResourceProfileDetections":           {},
+		"ListSensitivityInspectionTemplates":      {},
+		"ListTagsForResource":                     {},
+		"SearchResources":                         {},
+	},
+	names.MediaConnect: {
+		"DescribeBridge":              {},
+		"DescribeFlow":                {},
+		"DescribeFlowSourceMetadata":  {},
+		"DescribeFlowSourceThumbnail": {},
+		"DescribeGateway":             {},
+		"DescribeGatewayInstance":     {},
+		"DescribeOffering":            {},
+		"DescribeReservation":         {},
+		"ListBridges":                 {},
+		"ListEntitlements":            {},
+		"ListFlows":                   {},
+		"ListGatewayInstances":        {},
+		"ListGateways":                {},
+		"ListOfferings":               {},
+		"ListReservations":            {},
+		"ListTagsForResource":         {},
+	},
+	names.MediaConvert: {
+		"DescribeEndpoints":   {},
+		"GetJob":              {},
+		"GetJobTemplate":      {},
+		"GetPolicy":           {},
+		"GetPreset":           {},
+		"GetQueue":            {},
+		"ListJobTemplates":    {},
+		"ListJobs":            {},
+		"ListPresets":         {},
+		"ListQueues":          {},
+		"ListTagsForResource": {},
+		"ListVersions":        {},
+		"SearchJobs":          {},
+	},
+	names.MediaLive: {
+		"DescribeAccountConfiguration":      {},
+		"DescribeChannel":                   {},
+		"DescribeChannelPlacementGroup":     {},
+		"DescribeCluster":                   {},
+		"DescribeInput":                     {},
+		"DescribeInputDevice":               {},
+		"DescribeInputDeviceThumbnail":      {},
+		"DescribeInputSecurityGroup":        {},
+		"DescribeMultiplex":                 {},
+		"DescribeMultiplexProgram":          {},
+		"DescribeNetwork":                   {},
+		"DescribeNode":                      {},
+		"DescribeOffering":                  {},
+		"DescribeReservation":               {},
+		"DescribeSchedule":                  {},
+		"DescribeSdiSource":                 {},
+		"DescribeThumbnails":                {},
+		"GetCloudWatchAlarmTemplate":        {},
+		"GetCloudWatchAlarmTemplateGroup":   {},
+		"GetEventBridgeRuleTemplate":        {},
+		"GetEventBridgeRuleTemplateGroup":   {},
+		"GetSignalMap":                      {},
+		"ListChannelPlacementGroups":        {},
+		"ListChannels":                      {},
+		"ListCloudWatchAlarmTemplateGroups": {},
+		"ListCloudWatchAlarmTemplates":      {},
+		"ListClusters":                      {},
+		"ListEventBridgeRuleTemplateGroups": {},
+		"ListEventBridgeRuleTemplates":      {},
+		"ListInputDeviceTransfers":          {},
+		"ListInputDevices":                  {},
+		"ListInputSecurityGroups":           {},
+		"ListInputs":                        {},
+		"ListMultiplexPrograms":             {},
+		"ListMultiplexes":                   {},
+		"ListNetworks":                      {},
+		"ListNodes":                         {},
+		"ListOfferings":                     {},
+		"ListReservations":                  {},
+		"ListSdiSources":                    {},
+		"ListSignalMaps":                    {},
+		"ListTagsForResource":               {},
+		"ListVersions":                      {},
+	},
+	names.MediaPackage: {
+		"DescribeChannel":        {},
+		"DescribeHarvestJob":     {},
+		"DescribeOriginEndpoint": {},
+		"ListChannels":           {},
+		"ListHarvestJobs":        {},
+		"ListOriginEndpoints":    {},
+		"ListTagsForResource":    {},
+	},
+	names.MediaPackageV2: {
+		"GetChannel":              {},
+		"GetChannelGroup":         {},
+		"GetChannelPolicy":        {},
+		"GetHarvestJob":           {},
+		"GetOriginEndpoint":       {},
+		"GetOriginEndpointPolicy": {},
+		"ListChannelGroups":       {},
+		"ListChannels":            {},
+		"ListHarvestJobs":         {},
+		"ListOriginEndpoints":     {},
+		"ListTagsForResource":     {},
+	},
+	names.MediaPackageVOD: {
+		"DescribeAsset":                  {},
+		"DescribePackagingConfiguration": {},
+		"DescribePackagingGroup":         {},
+		"ListAssets":                     {},
+		"ListPackagingConfigurations":    {},
+		"ListPackagingGroups":            {},
+		"ListTagsForResource":            {},
+	},
+	names.MediaStore: {
+		"DescribeContainer":   {},
+		"GetContainerPolicy":  {},
+		"GetCorsPolicy":       {},
+		"GetLifecyclePolicy":  {},
+		"GetMetricPolicy":     {},
+		"ListContainers":      {},
+		"ListTagsForResource": {},
+	},
+	names.MemoryDB: {
+		"DescribeACLs":                         {},
+		"DescribeClusters":                     {},
+		"DescribeEngineVersions":               {},
+		"DescribeEvents":                       {},
+		"DescribeMultiRegionClusters":          {},
+		"DescribeMultiRegionParameterGroups":   {},
+		"DescribeMultiRegionParameters":        {},
+		"DescribeParameterGroups":              {},
+		"DescribeParameters":                   {},
+		"DescribeReservedNodes":                {},
+		"DescribeReservedNodesOfferings":       {},
+		"DescribeServiceUpdates":               {},
+		"DescribeSnapshots":                    {},
+		"DescribeSubnetGroups":                 {},
+		"DescribeUsers":                        {},
+		"ListAllowedMultiRegionClusterUpdates": {},
+		"ListAllowedNodeTypeUpdates":           {},
+		"ListTags":                             {},
+	},
+	names.Mgn: {
+		"DescribeJobLogItems":                       {},
+		"DescribeJobs":                              {},
+		"DescribeLaunchConfigurationTemplates":      {},
+		"DescribeReplicationConfigurationTemplates": {},
+		"DescribeSourceServers":                     {},
+		"DescribeVcenterClients":                    {},
+		"GetLaunchConfiguration":                    {},
+		"GetReplicationConfiguration":               {},
+		"ListApplications":                          {},
+		"ListConnectors":                            {},
+		"ListExportErrors":                          {},
+		"ListExports":                               {},
+		"ListImportErrors":                          {},
+		"ListImports":                               {},
+		"ListManagedAccounts":                       {},
+		"ListSourceServerActions":                   {},
+		"ListTagsForResource":                       {},
+		"ListTemplateActions":                       {},
+		"ListWaves":                                 {},
+	},
+	names.Neptune: {
+		"DescribeDBClusterEndpoints":             {},
+		"DescribeDBClusterParameterGroups":       {},
+		"DescribeDBClusterParameters":            {},
+		"DescribeDBClusterSnapshotAttributes":    {},
+		"DescribeDBClusterSnapshots":             {},
+		"DescribeDBClusters":                     {},
+		"DescribeDBEngineVersions":               {},
+		"DescribeDBInstances":                    {},
+		"DescribeDBParameterGroups":              {},
+		"DescribeDBParameters":                   {},
+		"DescribeDBSubnetGroups":                 {},
+		"DescribeEngineDefaultClusterParameters": {},
+		"DescribeEngineDefaultParameters":        {},
+		"DescribeEventCategories":                {},
+		"DescribeEventSubscriptions":             {},
+		"DescribeEvents":                         {},
+		"DescribeGlobalClusters":                 {},
+		"DescribeOrderableDBInstanceOptions":     {},
+		"DescribePendingMaintenanceActions":      {},
+		"DescribeValidDBInstanceModifications":   {},
+		"ListTagsForResource":                    {},
+	},
+	names.NeptuneGraph: {
+		"GetExportTask":             {},
+		"GetGraph":                  {},
+		"GetGraphSnapshot":          {},
+		"GetGraphSummary":           {},
+		"GetImportTask":             {},
+		"GetPrivateGraphEndpoint":   {},
+		"GetQuery":                  {},
+		"ListExportTasks":           {},
+		"ListGraphSnapshots":        {},
+		"ListGraphs":                {},
+		"ListImportTasks":           {},
+		"ListPrivateGraphEndpoints": {},
+		"ListQueries":               {},
+		"ListTagsForResource":       {},
+	},
+	names.NetworkFirewall: {
+		"DescribeFirewall":                   {},
+		"DescribeFirewallMetadata":           {},
+		"DescribeFirewallPolicy":             {},
+		"DescribeFlowOperation":              {},
+		"DescribeLoggingConfiguration":       {},
+		"DescribeResourcePolicy":             {},
+		"DescribeRuleGroup":                  {},
+		"DescribeRuleGroupMetadata":          {},
+		"DescribeRuleGroupSummary":           {},
+		"DescribeTLSInspectionConfiguration": {},
+		"DescribeVpcEndpointAssociation":     {},
+		"GetAnalysisReportResults":           {},
+		"ListAnalysisReports":                {},
+		"ListFirewallPolicies":               {},
+		"ListFirewalls":                      {},
+		"ListFlowOperationResults":           {},
+		"ListFlowOperations":                 {},
+		"ListRuleGroups":                     {},
+		"ListTLSInspectionConfigurations":    {},
+		"ListTagsForResource":                {},
+		"ListVpcEndpointAssociations":        {},
+	},
+	names.NetworkFlowMonitor: {
+		"GetMonitor":                                         {},
+		"GetQueryResultsMonitorTopContributors":              {},
+		"GetQueryResultsWorkloadInsightsTopContributors":     {},
+		"GetQueryResultsWorkloadInsightsTopContributorsData": {},
+		"GetQueryStatusMonitorTopContributors":               {},
+		"GetQueryStatusWorkloadInsightsTopContributors":      {},
+		"GetQueryStatusWorkloadInsightsTopContributorsData":  {},
+		"GetScope":            {},
+		"ListMonitors":        {},
+		"ListScopes":          {},
+		"ListTagsForResource": {},
+	},
+	names.NetworkManager: {
+		"DescribeGlobalNetworks":                   {},
+		"GetConnectAttachment":                     {},
+		"GetConnectPeer":                           {},
+		"GetConnectPeerAssociations":               {},
+		"GetConnections":                           {},
+		"GetCoreNetwork":                           {},
+		"GetCoreNetworkChangeEvents":               {},
+		"GetCoreNetworkChangeSet":                  {},
+		"GetCoreNetworkPolicy":                     {},
+		"GetCustomerGatewayAssociations":           {},
+		"GetDevices":                               {},
+		"GetDirectConnectGatewayAttachment":        {},
+		"GetLinkAssociations":                      {},
+		"GetLinks":                                 {},
+		"GetNetworkResourceCounts":                 {},
+		"GetNetworkResourceRelationships":          {},
+		"GetNetworkResources":                      {},
+		"GetNetworkRoutes":                         {},
+		"GetNetworkTelemetry":                      {},
+		"GetResourcePolicy":                        {},
+		"GetRouteAnalysis":                         {},
+		"GetSiteToSiteVpnAttachment":               {},
+		"GetSites":                                 {},
+		"GetTransitGatewayConnectPeerAssociations": {},
+		"GetTransitGatewayPeering":                 {},
+		"GetTransitGatewayRegistrations":           {},
+		"GetTransitGatewayRouteTableAttachment":    {},
+		"GetVpcAttachment":                         {},
+		"ListAttachments":                          {},
+		"ListConnectPeers":                         {},
+		"ListCoreNetworkPolicyVersions":            {},
+		"ListCoreNetworks":                         {},
+		"ListOrganizationServiceAccessStatus":      {},
+		"ListPeerings":                             {},
+		"ListTagsForResource":                      {},
+	},
+	names.NetworkMonitor: {
+		"GetMonitor":          {},
+		"GetProbe":            {},
+		"ListMonitors":        {},
+		"ListTagsForResource": {},
+	},
+	names.Notifications: {
+		"GetEventRule":                               {},
+		"GetManagedNotificationChildEvent":           {},
+		"GetManagedNotificationConfiguration":        {},
+		"GetManagedNotificationEvent":                {},
+		"GetNotificationConfiguration":               {},
+		"GetNotificationEvent":                       {},
+		"GetNotificationsAccessForOrganization":      {},
+		"ListChannels":                               {},
+		"ListEventRules":                             {},
+		"ListManagedNotificationChannelAssociations": {},
+		"ListManagedNotificationChildEvents":         {},
+		"ListManagedNotificationConfigurations":      {},
+		"ListManagedNotificationEvents":              {},
+		"ListMemberAccounts":                         {},
+		"ListNotificationConfigurations":             {},
+		"ListNotificationEvents":                     {},
+		"ListNotificationHubs":                       {},
+		"ListOrganizationalUnits":                    {},
+		"ListTagsForResource":                        {},
+	},
+	names.NotificationsContacts: {
+		"GetEmailContact":     {},
+		"ListEmailContacts":   {},
+		"ListTagsForResource": {},
+	},
+	names.ODB: {
+		"GetCloudAutonomousVmCluster":                       {},
+		"GetCloudExadataInfrastructure":                     {},
+		"GetCloudExadataInfrastructureUnallocatedResources": {},
+		"GetCloudVmCluster":                                 {},
+		"GetDbNode":                                         {},
+		"GetDbServer":                                       {},
+		"GetOciOnboardingStatus":                            {},
+		"GetOdbNetwork":                                     {},
+		"GetOdbPeeringConnection":                           {},
+		"ListAutonomousVirtualMachines":                     {},
+		"ListCloudAutonomousVmClusters":                     {},
+		"ListCloudExadataInfrastructures":                   {},
+		"ListCloudVmClusters":                               {},
+		"ListDbNodes":                                       {},
+		"ListDbServers":                                     {},
+		"ListDbSystemShapes":                                {},
+		"ListGiVersions":                                    {},
+		"ListOdbNetworks":                                   {},
+		"ListOdbPeeringConnections":                         {},
+		"ListSystemVersions":                                {},
+		"ListTagsForResource":                               {},
+	},
+	names.ObservabilityAccessManager: {
+		"GetLink":             {},
+		"GetSink":             {},
+		"GetSinkPolicy":       {},
+		"ListAttachedLinks":   {},
+		"ListLinks":           {},
+		"ListSinks":           {},
+		"ListTagsForResource": {},
+	},
+	names.ObservabilityAdmin: {
+		"GetCentralizationRuleForOrganization":        {},
+		"GetTelemetryEnrichmentStatus":                {},
+		"GetTelemetryEvaluationStatus":                {},
+		"GetTelemetryEvaluationStatusForOrganization": {},
+		"GetTelemetryRule":                            {},
+		"GetTelemetryRuleForOrganization":             {},
+		"ListCentralizationRulesForOrganization":      {},
+		"ListResourceTelemetry":                       {},
+		"ListResourceTelemetryForOrganization":        {},
+		"ListTagsForResource":                         {},
+		"ListTelemetryRules":                          {},
+		"ListTelemetryRulesForOrganization":           {},
+	},
+	names.OpenSearch: {
+		"DescribeDomain":                    {},
+		"DescribeDomainAutoTunes":           {},
+		"DescribeDomainChangeProgress":      {},
+		"DescribeDomainConfig":              {},
+		"DescribeDomainHealth":              {},
+		"DescribeDomainNodes":               {},
+		"DescribeDomains":                   {},
+		"DescribeDryRunProgress":            {},
+		"DescribeInboundConnections":        {},
+		"DescribeInstanceTypeLimits":        {},
+		"DescribeOutboundConnections":       {},
+		"DescribePackages":                  {},
+		"DescribeReservedInstanceOfferings": {},
+		"DescribeReservedInstances":         {},
+		"DescribeVpcEndpoints":              {},
+		"GetApplication":                    {},
+		"GetCompatibleVersions":             {},
+		"GetDataSource":                     {},
+		"GetDirectQueryDataSource":          {},
+		"GetDomainMaintenanceStatus":        {},
+		"GetPackageVersionHistory":          {},
+		"GetUpgradeHistory":                 {},
+		"GetUpgradeStatus":                  {},
+		"ListApplications":                  {},
+		"ListDataSources":                   {},
+		"ListDirectQueryDataSources":        {},
+		"ListDomainMaintenances":            {},
+		"ListDomainNames":                   {},
+		"ListDomainsForPackage":             {},
+		"ListInstanceTypeDetails":           {},
+		"ListPackagesForDomain":             {},
+		"ListScheduledActions":              {},
+		"ListTags":                          {},
+		"ListVersions":                      {},
+		"ListVpcEndpointAccess":             {},
+		"ListVpcEndpoints":                  {},
+		"ListVpcEndpointsForDomain":         {},
+	},
+	names.OpenSearchIngestion: {
+		"GetPipeline":                     {},
+		"GetPipelineBlueprint":            {},
+		"GetPipelineChangeProgress":       {},
+		"GetResourcePolicy":               {},
+		"ListPipelineBlueprints":          {},
+		"ListPipelineEndpointConnections": {},
+		"ListPipelineEndpoints":           {},
+		"ListPipelines":                   {},
+		"ListTagsForResource":             {},
+		"ValidatePipeline":                {},
+	},
+	names.OpenSearchServerless: {
+		"BatchGetCollection":               {},
+		"BatchGetEffectiveLifecyclePolicy": {},
+		"BatchGetLifecyclePolicy":          {},
+		"BatchGetVpcEndpoint":              {},
+		"GetAccessPolicy":                  {},
+		"GetAccountSettings":               {},
+		"GetIndex":                         {},
+		"GetPoliciesStats":                 {},
+		"GetSecurityConfig":                {},
+		"GetSecurityPolicy":                {},
+		"ListAccessPolicies":               {},
+		"ListCollections":                  {},
+		"ListLifecyclePolicies":            {},
+		"ListSecurityConfigs":              {},
+		"ListSecurityPolicies":             {},
+		"ListTagsForResource":              {},
+		"ListVpcEndpoints":                 {},
+	},
+	names.Organizations: {
+		"DescribeAccount":                        {},
+		"DescribeCreateAccountStatus":            {},
+		"DescribeEffectivePolicy":                {},
+		"DescribeHandshake":                      {},
+		"DescribeOrganization":                   {},
+		"DescribeOrganizationalUnit":             {},
+		"DescribePolicy":                         {},
+		"DescribeResourcePolicy":                 {},
+		"ListAWSServiceAccessForOrganization":    {},
+		"ListAccounts":                           {},
+		"ListAccountsForParent":                  {},
+		"ListAccountsWithInvalidEffectivePolicy": {},
+		"ListChildren":                           {},
+		"ListCreateAccountStatus":                {},
+		"ListDelegatedAdministrators":            {},
+		"ListDelegatedServicesForAccount":        {},
+		"ListEffectivePolicyValidationErrors":    {},
+		"ListHandshakesForAccount":               {},
+		"ListHandshakesForOrganization":          {},
+		"ListOrganizationalUnitsForParent":       {},
+		"ListParents":                            {},
+		"ListPolicies":                           {},
+		"ListPoliciesForTarget":                  {},
+		"ListRoots":                              {},
+		"ListTagsForResource":                    {},
+		"ListTargetsForPolicy":                   {},
+	},
+	names.Outposts: {
+		"GetCapacityTask":                      {},
+		"GetCatalogItem":                       {},
+		"GetConnection":                        {},
+		"GetOrder":                             {},
+		"GetOutpost":                           {},
+		"GetOutpostBillingInformation":         {},
+		"GetOutpostInstanceTypes":              {},
+		"GetOutpostSupportedInstanceTypes":     {},
+		"GetSite":                              {},
+		"GetSiteAddress":                       {},
+		"ListAssetInstances":                   {},
+		"ListAssets":                           {},
+		"ListBlockingInstancesForCapacityTask": {},
+		"ListCapacityTasks":                    {},
+		"ListCatalogItems":                     {},
+		"ListOrders":                           {},
+		"ListOutposts":                         {},
+		"ListSites":                            {},
+		"ListTagsForResource":                  {},
+	},
+	names.PCAConnectorAD: {
+		"GetConnector":                          {},
+		"GetDirectoryRegistration":              {},
+		"GetServicePrincipalName":               {},
+		"GetTemplate":                           {},
+		"GetTemplateGroupAccessControlEntry":    {},
+		"ListConnectors":                        {},
+		"ListDirectoryRegistrations":            {},
+		"ListServicePrincipalNames":             {},
+		"ListTagsForResource":                   {},
+		"ListTemplateGroupAccessControlEntries": {},
+		"ListTemplates":                         {},
+	},
+	names.PCS: {
+		"GetCluster":            {},
+		"GetComputeNodeGroup":   {},
+		"GetQueue":              {},
+		"ListClusters":          {},
+		"ListComputeNodeGroups": {},
+		"ListQueues":            {},
+		"ListTagsForResource":   {},
+	},
+	names.PaymentCryptography: {
+		"GetAlias":                        {},
+		"GetCertificateSigningRequest":    {},
+		"GetDefaultKeyReplicationRegions": {},
+		"GetKey":                          {},
+		"GetParametersForExport":          {},
+		"GetParametersForImport":          {},
+		"GetPublicKeyCertificate":         {},
+		"ListAliases":                     {},
+		"ListKeys":                        {},
+		"ListTagsForResource":             {},
+	},
+	names.Pinpoint: {
+		"GetAdmChannel":                         {},
+		"GetApnsChannel":                        {},
+		"GetApnsSandboxChannel":                 {},
+		"GetApnsVoipChannel":                    {},
+		"GetApnsVoipSandboxChannel":             {},
+		"GetApp":                                {},
+		"GetApplicationDateRangeKpi":            {},
+		"GetApplicationSettings":                {},
+		"GetApps":                               {},
+		"GetBaiduChannel":                       {},
+		"GetCampaign":                           {},
+		"GetCampaignActivities":                 {},
+		"GetCampaignDateRangeKpi":               {},
+		"GetCampaignVersion":                    {},
+		"GetCampaignVersions":                   {},
+		"GetCampaigns":                          {},
+		"GetChannels":                           {},
+		"GetEmailChannel":                       {},
+		"GetEmailTemplate":                      {},
+		"GetEndpoint":                           {},
+		"GetEventStream":                        {},
+		"GetExportJob":                          {},
+		"GetExportJobs":                         {},
+		"GetGcmChannel":                         {},
+		"GetImportJob":                          {},
+		"GetImportJobs":                         {},
+		"GetInAppMessages":                      {},
+		"GetInAppTemplate":                      {},
+		"GetJourney":                            {},
+		"GetJourneyDateRangeKpi":                {},
+		"GetJourneyExecutionActivityMetrics":    {},
+		"GetJourneyExecutionMetrics":            {},
+		"GetJourneyRunExecutionActivityMetrics": {},
+		"GetJourneyRunExecutionMetrics":         {},
+		"GetJourneyRuns":                        {},
+		"GetPushTemplate":                       {},
+		"GetRecommenderConfiguration":           {},
+		"GetRecommenderConfigurations":          {},
+		"GetSegment":                            {},
+		"GetSegmentExportJobs":                  {},
+		"GetSegmentImportJobs":                  {},
+		"GetSegmentVersion":                     {},
+		"GetSegmentVersions":                    {},
+		"GetSegments":                           {},
+		"GetSmsChannel":                         {},
+		"GetSmsTemplate":                        {},
+		"GetUserEndpoints":                      {},
+		"GetVoiceChannel":                       {},
+		"GetVoiceTemplate":                      {},
+		"ListJourneys":                          {},
+		"ListTagsForResource":                   {},
+		"ListTemplateVersions":                  {},
+		"ListTemplates":                         {},
+	},
+	names.PinpointSMSVoiceV2: {
+		"DescribeAccountAttributes":                      {},
+		"DescribeAccountLimits":                          {},
+		"DescribeConfigurationSets":                      {},
+		"DescribeKeywords":                               {},
+		"DescribeOptOutLists":                            {},
+		"DescribeOptedOutNumbers":                        {},
+		"DescribePhoneNumbers":                           {},
+		"DescribePools":                                  {},
+		"DescribeProtectConfigurations":                  {},
+		"DescribeRegistrationAttachments":                {},
+		"DescribeRegistrationFieldDefinitions":           {},
+		"DescribeRegistrationFieldValues":                {},
+		"DescribeRegistrationSectionDefinitions":         {},
+		"DescribeRegistrationTypeDefinitions":            {},
+		"DescribeRegistrationVersions":                   {},
+		"DescribeRegistrations":                          {},
+		"DescribeSenderIds":                              {},
+		"DescribeSpendLimits":                            {},
+		"DescribeVerifiedDestinationNumbers":             {},
+		"GetProtectConfigurationCountryRuleSet":          {},
+		"GetResourcePolicy":                              {},
+		"ListPoolOriginationIdentities":                  {},
+		"ListProtectConfigurationRuleSetNumberOverrides": {},
+		"ListRegistrationAssociations":                   {},
+		"ListTagsForResource":                            {},
+	},
+	names.Pipes: {
+		"DescribePipe":        {},
+		"ListPipes":           {},
+		"ListTagsForResource": {},
+	},
+	names.Polly: {
+		"DescribeVoices":           {},
+		"GetLexicon":               {},
+		"GetSpeechSynthesisTask":   {},
+		"ListLexicons":             {},
+		"ListSpeechSynthesisTasks": {},
+	},
+	names.Pricing: {
+		"DescribeServices":    {},
+		"GetAttributeValues":  {},
+		"GetPriceListFileUrl": {},
+		"GetProducts":         {},
+		"ListPriceLists":      {},
+	},
+	names.QBusiness: {
+		"GetApplication":                 {},
+		"GetChatControlsConfiguration":   {},
+		"GetChatResponseConfiguration":   {},
+		"GetDataAccessor":                {},
+		"GetDataSource":                  {},
+		"GetDocumentContent":             {},
+		"GetGroup":                       {},
+		"GetIndex":                       {},
+		"GetMedia":                       {},
+		"GetPlugin":                      {},
+		"GetPolicy":                      {},
+		"GetRetriever":                   {},
+		"GetUser":                        {},
+		"GetWebExperience":               {},
+		"ListApplications":               {},
+		"ListAttachments":                {},
+		"ListChatResponseConfigurations": {},
+		"ListConversations":              {},
+		"ListDataAccessors":              {},
+		"ListDataSourceSyncJobs":         {},
+		"ListDataSources":                {},
+		"ListDocuments":                  {},
+		"ListGroups":                     {},
+		"ListIndices":                    {},
+		"ListMessages":                   {},
+		"ListPluginActions":              {},
+		"ListPluginTypeActions":          {},
+		"ListPluginTypeMetadata":         {},
+		"ListPlugins":                    {},
+		"ListRetrievers":                 {},
+		"ListSubscriptions":              {},
+		"ListTagsForResource":            {},
+		"ListWebExperiences":             {},
+		"SearchRelevantContent":          {},
+	},
+	names.QLDB: {
+		"DescribeJournalKinesisStream":       {},
+		"DescribeJournalS3Export":            {},
+		"DescribeLedger":                     {},
+		"GetBlock":                           {},
+		"GetDigest":                          {},
+		"GetRevision":                        {},
+		"ListJournalKinesisStreamsForLedger": {},
+		"ListJournalS3Exports":               {},
+		"ListJournalS3ExportsForLedger":      {},
+		"ListLedgers":                        {},
+		"ListTagsForResource":                {},
+	},
+	names.QuickSight: {
+		"DescribeAccountCustomPermission":        {},
+		"DescribeAccountCustomization":           {},
+		"DescribeAccountSettings":                {},
+		"DescribeAccountSubscription":            {},
+		"DescribeActionConnector":                {},
+		"DescribeActionConnectorPermissions":     {},
+		"DescribeAnalysis":                       {},
+		"DescribeAnalysisDefinition":             {},
+		"DescribeAnalysisPermissions":            {},
+		"DescribeAssetBundleExportJob":           {},
+		"DescribeAssetBundleImportJob":           {},
+		"DescribeBrand":                          {},
+		"DescribeBrandAssignment":                {},
+		"DescribeBrandPublishedVersion":          {},
+		"DescribeCustomPermissions":              {},
+		"DescribeDashboard":                      {},
+		"DescribeDashboardDefinition":            {},
+		"DescribeDashboardPermissions":           {},
+		"DescribeDashboardSnapshotJob":           {},
+		"DescribeDashboardSnapshotJobResult":     {},
+		"DescribeDashboardsQAConfiguration":      {},
+		"DescribeDataSet":                        {},
+		"DescribeDataSetPermissions":             {},
+		"DescribeDataSetRefreshProperties":       {},
+		"DescribeDataSource":                     {},
+		"DescribeDataSourcePermissions":          {},
+		"DescribeDefaultQBusinessApplication":    {},
+		"DescribeFolder":                         {},
+		"DescribeFolderPermissions":              {},
+		"DescribeFolderResolvedPermissions":      {},
+		"DescribeGroup":                          {},
+		"DescribeGroupMembership":                {},
+		"DescribeIAMPolicyAssignment":            {},
+		"DescribeIngestion":                      {},
+		"DescribeIpRestriction":                  {},
+		"DescribeKeyRegistration":                {},
+		"DescribeNamespace":                      {},
+		"DescribeQPersonalizationConfiguration":  {},
+		"DescribeQuickSightQSearchConfiguration": {},
+		"DescribeRefreshSchedule":                {},
+		"DescribeRoleCustomPermission":           {},
+		"DescribeTemplate":                       {},
+		"DescribeTemplateAlias":                  {},
+		"DescribeTemplateDefinition":             {},
+		"DescribeTemplatePermissions":            {},
+		"DescribeTheme":                          {},
+		"DescribeThemeAlias":                     {},
+		"DescribeThemePermissions":               {},
+		"DescribeTopic":                          {},
+		"DescribeTopicPermissions":               {},
+		"DescribeTopicRefresh":                   {},
+		"DescribeTopicRefreshSchedule":           {},
+		"DescribeUser":                           {},
+		"DescribeVPCConnection":                  {},
+		"GetFlowMetadata":                        {},
+		"GetFlowPermissions":                     {},
+		"ListActionConnectors":                   {},
+		"ListAnalyses":                           {},
+		"ListAssetBundleExportJobs":              {},
+		"ListAssetBundleImportJobs":              {},
+		"ListBrands":                             {},
+		"ListCustomPermissions":                  {},
+		"ListDashboardVersions":                  {},
+		"ListDashboards":                         {},
+		"ListDataSets":                           {},
+		"ListDataSources":                        {},
+		"ListFlows":                              {},
+		"ListFolderMembers":                      {},
+		"ListFolders":                            {},
+		"ListFoldersForResource":                 {},
+		"ListGroupMemberships":                   {},
+		"ListGroups":                             {},
+		"ListIAMPolicyAssignments":               {},
+		"ListIAMPolicyAssignmentsForUser":        {},
+		"ListIdentityPropagationConfigs":         {},
+		"ListIngestions":                         {},
+		"ListNamespaces":                         {},
+		"ListRefreshSchedules":                   {},
+		"ListRoleMemberships":                    {},
+		"ListTagsForResource":                    {},
+		"ListTemplateAliases":                    {},
+		"ListTemplateVersions":                   {},
+		"ListTemplates":                          {},
+		"ListThemeAliases":                       {},
+		"ListThemeVersions":                      {},
+		"ListThemes":                             {},
+		"ListTopicRefreshSchedules":              {},
+		"ListTopicReviewedAnswers":               {},
+		"ListTopics":                             {},
+		"ListUserGroups":                         {},
+		"ListUsers":                              {},
+		"ListVPCConnections":                     {},
+		"SearchActionConnectors":                 {},
+		"SearchAnalyses":                         {},
+		"SearchDashboards":                       {},
+		"SearchDataSets":                         {},
+		"SearchDataSources":                      {},
+		"SearchFlows":                            {},
+		"SearchFolders":                          {},
+		"SearchGroups":                           {},
+		"SearchTopics":                           {},
+	},
+	names.RAM: {
+		"GetPermission":                         {},
+		"GetResourcePolicies":                   {},
+		"GetResourceShareAssociations":          {},
+		"GetResourceShareInvitations":           {},
+		"GetResourceShares":                     {},
+		"ListPendingInvitationResources":        {},
+		"ListPermissionAssociations":            {},
+		"ListPermissionVersions":                {},
+		"ListPermissions":                       {},
+		"ListPrincipals":                        {},
+		"ListReplacePermissionAssociationsWork": {},
+		"ListResourceSharePermissions":          {},
+		"ListResourceTypes":                     {},
+		"ListResources":                         {},
+	},
+	names.RBin: {
+		"GetRule":             {},
+		"ListRules":           {},
+		"ListTagsForResource": {},
+	},
+	names.RDS: {
+		"DescribeAccountAttributes":              {},
+		"DescribeBlueGreenDeployments":           {},
+		"DescribeCertificates":                   {},
+		"DescribeDBClusterAutomatedBackups":      {},
+		"DescribeDBClusterBacktracks":            {},
+		"DescribeDBClusterEndpoints":             {},
+		"DescribeDBClusterParameterGroups":       {},
+		"DescribeDBClusterParameters":            {},
+		"DescribeDBClusterSnapshotAttributes":    {},
+		"DescribeDBClusterSnapshots":             {},
+		"DescribeDBClusters":                     {},
+		"DescribeDBEngineVersions":               {},
+		"DescribeDBInstanceAutomatedBackups":     {},
+		"DescribeDBInstances":                    {},
+		"DescribeDBLogFiles":                     {},
+		"DescribeDBMajorEngineVersions":          {},
+		"DescribeDBParameterGroups":              {},
+		"DescribeDBParameters":                   {},
+		"DescribeDBProxies":                      {},
+		"DescribeDBProxyEndpoints":               {},
+		"DescribeDBProxyTargetGroups":            {},
+		"DescribeDBProxyTargets":                 {},
+		"DescribeDBRecommendations":              {},
+		"DescribeDBSecurityGroups":               {},
+		"DescribeDBShardGroups":                  {},
+		"DescribeDBSnapshotAttributes":           {},
+		"DescribeDBSnapshotTenantDatabases":      {},
+		"DescribeDBSnapshots":                    {},
+		"DescribeDBSubnetGroups":                 {},
+		"DescribeEngineDefaultClusterParameters": {},
+		"DescribeEngineDefaultParameters":        {},
+		"DescribeEventCategories":                {},
+		"DescribeEventSubscriptions":             {},
+		"DescribeEvents":                         {},
+		"DescribeExportTasks":                    {},
+		"DescribeGlobalClusters":                 {},
+		"DescribeIntegrations":                   {},
+		"DescribeOptionGroupOptions":             {},
+		"DescribeOptionGroups":                   {},
+		"DescribeOrderableDBInstanceOptions":     {},
+		"DescribePendingMaintenanceActions":      {},
+		"DescribeReservedDBInstances":            {},
+		"DescribeReservedDBInstancesOfferings":   {},
+		"DescribeSourceRegions":                  {},
+		"DescribeTenantDatabases":                {},
+		"DescribeValidDBInstanceModifications":   {},
+		"DownloadDBLogFilePortion":               {},
+		"ListTagsForResource":                    {},
+	},
+	names.RUM: {
+		"BatchGetRumMetricDefinitions": {},
+		"GetAppMonitor":                {},
+		"GetAppMonitorData":            {},
+		"GetResourcePolicy":            {},
+		"ListAppMonitors":              {},
+		"ListRumMetricsDestinations":   {},
+		"ListTagsForResource":          {},
+	},
+	names.Redshift: {
+		"DescribeAccountAttributes":                   {},
+		"DescribeAuthenticationProfiles":              {},
+		"DescribeClusterDbRevisions":                  {},
+		"DescribeClusterParameterGroups":              {},
+		"DescribeClusterParameters":                   {},
+		"DescribeClusterSecurityGroups":               {},
+		"DescribeClusterSnapshots":                    {},
+		"DescribeClusterSubnetGroups":                 {},
+		"DescribeClusterTracks":                       {},
+		"DescribeClusterVersions":                     {},
+		"DescribeClusters":                            {},
+		"DescribeCustomDomainAssociations":            {},
+		"DescribeDataShares":                          {},
+		"DescribeDataSharesForConsumer":               {},
+		"DescribeDataSharesForProducer":               {},
+		"DescribeDefaultClusterParameters":            {},
+		"DescribeEndpointAccess":                      {},
+		"DescribeEndpointAuthorization":               {},
+		"DescribeEventCategories":                     {},
+		"DescribeEventSubscriptions":                  {},
+		"DescribeEvents":                              {},
+		"DescribeHsmClientCertificates":               {},
+		"DescribeHsmConfigurations":                   {},
+		"DescribeInboundIntegrations":                 {},
+		"DescribeIntegrations":                        {},
+		"DescribeLoggingStatus":                       {},
+		"DescribeNodeConfigurationOptions":            {},
+		"DescribeOrderableClusterOptions":             {},
+		"DescribePartners":                            {},
+		"DescribeRedshiftIdcApplications":             {},
+		"DescribeReservedNodeExchangeStatus":          {},
+		"DescribeReservedNodeOfferings":               {},
+		"DescribeReservedNodes":                       {},
+		"DescribeResize":                              {},
+		"DescribeScheduledActions":                    {},
+		"DescribeSnapshotCopyGrants":                  {},
+		"DescribeSnapshotSchedules":                   {},
+		"DescribeStorage":                             {},
+		"DescribeTableRestoreStatus":                  {},
+		"DescribeTags":                                {},
+		"DescribeUsageLimits":                         {},
+		"GetReservedNodeExchangeConfigurationOptions": {},
+		"GetReservedNodeExchangeOfferings":            {},
+		"GetResourcePolicy":                           {},
+		"ListRecommendations":                         {},
+	},
+	names.RedshiftData: {
+		"DescribeStatement":    {},
+		"DescribeTable":        {},
+		"GetStatementResult":   {},
+		"GetStatementResultV2": {},
+		"ListDatabases":        {},
+		"ListSchemas":          {},
+		"ListStatements":       {},
+		"ListTables":           {},
+	},
+	names.RedshiftServerless: {
+		"GetCustomDomainAssociation":     {},
+		"GetEndpointAccess":              {},
+		"GetNamespace":                   {},
+		"GetRecoveryPoint":               {},
+		"GetReservation":                 {},
+		"GetReservationOffering":         {},
+		"GetResourcePolicy":              {},
+		"GetScheduledAction":             {},
+		"GetSnapshot":                    {},
+		"GetTableRestoreStatus":          {},
+		"GetTrack":                       {},
+		"GetUsageLimit":                  {},
+		"GetWorkgroup":                   {},
+		"ListCustomDomainAssociations":   {},
+		"ListEndpointAccess":             {},
+		"ListManagedWorkgroups":          {},
+		"ListNamespaces":                 {},
+		"ListRecoveryPoints":             {},
+		"ListReservationOfferings":       {},
+		"ListReservations":               {},
+		"ListScheduledActions":           {},
+		"ListSnapshotCopyConfigurations": {},
+		"ListSnapshots":                  {},
+		"ListTableRestoreStatus":         {},
+		"ListTagsForResource":            {},
+		"ListTracks":                     {},
+		"ListUsageLimits":                {},
+		"ListWorkgroups":                 {},
+	},
+	names.Rekognition: {
+		"DescribeCollection":            {},
+		"DescribeDataset":               {},
+		"DescribeProjectVersions":       {},
+		"DescribeProjects":              {},
+		"DescribeStreamProcessor":       {},
+		"GetCelebrityInfo":              {},
+		"GetCelebrityRecognition":       {},
+		"GetContentModeration":          {},
+		"GetFaceDetection":              {},
+		"GetFaceLivenessSessionResults": {},
+		"GetFaceSearch":                 {},
+		"GetLabelDetection":             {},
+		"GetMediaAnalysisJob":           {},
+		"GetPersonTracking":             {},
+		"GetSegmentDetection":           {},
+		"GetTextDetection":              {},
+		"ListCollections":               {},
+		"ListDatasetEntries":            {},
+		"ListDatasetLabels":             {},
+		"ListFaces":                     {},
+		"ListMediaAnalysisJobs":         {},
+		"ListProjectPolicies":           {},
+		"ListStreamProcessors":          {},
+		"ListTagsForResource":           {},
+		"ListUsers":                     {},
+		"SearchFaces":                   {},
+		"SearchFacesByImage":            {},
+		"SearchUsers":                   {},
+		"SearchUsersByImage":            {},
+	},
+	names.ResilienceHub: {
+		"DescribeApp":                                  {},
+		"DescribeAppAssessment":                        {},
+		"DescribeAppVersion":                           {},
+		"DescribeAppVersionAppComponent":               {},
+		"DescribeAppVersionResource":                   {},
+		"DescribeAppVersionResourcesResolutionStatus":  {},
+		"DescribeAppVersionTemplate":                   {},
+		"DescribeDraftAppVersionResourcesImportStatus": {},
+		"DescribeMetricsExport":                        {},
+		"DescribeResiliencyPolicy":                     {},
+		"DescribeResourceGroupingRecommendationTask":   {},
+		"ListAlarmRecommendations":                     {},
+		"ListAppAssessmentComplianceDrifts":            {},
+		"ListAppAssessmentResourceDrifts":              {},
+		"ListAppAssessments":                           {},
+		"ListAppComponentCompliances":                  {},
+		"ListAppComponentRecommendations":              {},
+		"ListAppInputSources":                          {},
+		"ListAppVersionAppComponents":                  {},
+		"ListAppVersionResourceMappings":               {},
+		"ListAppVersionResources":                      {},
+		"ListAppVersions":                              {},
+		"ListApps":                                     {},
+		"ListMetrics":                                  {},
+		"ListRecommendationTemplates":                  {},
+		"ListResiliencyPolicies":                       {},
+		"ListResourceGroupingRecommendations":          {},
+		"ListSopRecommendations":                       {},
+		"ListSuggestedResiliencyPolicies":              {},
+		"ListTagsForResource":                          {},
+		"ListTestRecommendations":                      {},
+		"ListUnsupportedAppVersionResources":           {},
+	},
+	names.ResourceExplorer2: {
+		"BatchGetView":                        {},
+		"GetAccountLevelServiceConfiguration": {},
+		"GetDefaultView":                      {},
+		"GetIndex":                            {},
+		"GetManagedView":                      {},
+		"GetResourceExplorerSetup":            {},
+		"GetServiceIndex":                     {},
+		"GetServiceView":                      {},
+		"GetView":                             {},
+		"ListIndexes":                         {},
+		"ListIndexesForMembers":               {},
+		"ListManagedViews":                    {},
+		"ListResources":                       {},
+		"ListServiceIndexes":                  {},
+		"ListServiceViews":                    {},
+		"ListStreamingAccessForServices":      {},
+		"ListSupportedResourceTypes":          {},
+		"ListTagsForResource":                 {},
+		"ListViews":                           {},
+		"Search":                              {},
+	},
+	names.ResourceGroups: {
+		"GetAccountSettings":    {},
+		"GetGroup":              {},
+		"GetGroupConfiguration": {},
+		"GetGroupQuery":         {},
+		"GetTagSyncTask":        {},
+		"GetTags":               {},
+		"ListGroupResources":    {},
+		"ListGroupingStatuses":  {},
+		"ListGroups":            {},
+		"ListTagSyncTasks":      {},
+		"SearchResources":       {},
+	},
+	names.ResourceGroupsTaggingAPI: {
+		"DescribeReportCreation": {},
+		"GetComplianceSummary":   {},
+		"GetResources":           {},
+		"GetTagKeys":             {},
+		"GetTagValues":           {},
+	},
+	names.RolesAnywhere: {
+		"GetCrl":              {},
+		"GetProfile":          {},
+		"GetSubject":          {},
+		"GetTrustAnchor":      {},
+		"ListCrls":            {},
+		"ListProfiles":        {},
+		"ListSubjects":        {},
+		"ListTagsForResource": {},
+		"ListTrustAnchors":    {},
+	},
+	names.Route53: {
+		"GetAccountLimit":                        {},
+		"GetChange":                              {},
+		"GetCheckerIpRanges":                     {},
+		"GetDNSSEC":                              {},
+		"GetGeoLocation":                         {},
+		"GetHealthCheck":                         {},
+		"GetHealthCheckCount":                    {},
+		"GetHealthCheckLastFailureReason":        {},
+		"GetHealthCheckStatus":                   {},
+		"GetHostedZone":                          {},
+		"GetHostedZoneCount":                     {},
+		"GetHostedZoneLimit":                     {},
+		"GetQueryLoggingConfig":                  {},
+		"GetReusableDelegationSet":               {},
+		"GetReusableDelegationSetLimit":          {},
+		"GetTrafficPolicy":                       {},
+		"GetTrafficPolicyInstance":               {},
+		"GetTrafficPolicyInstanceCount":          {},
+		"ListCidrBlocks":                         {},
+		"ListCidrCollections":                    {},
+		"ListCidrLocations":                      {},
+		"ListGeoLocations":                       {},
+		"ListHealthChecks":                       {},
+		"ListHostedZones":                        {},
+		"ListHostedZonesByName":                  {},
+		"ListHostedZonesByVPC":                   {},
+		"ListQueryLoggingConfigs":                {},
+		"ListResourceRecordSets":                 {},
+		"ListReusableDelegationSets":             {},
+		"ListTagsForResource":                    {},
+		"ListTagsForResources":                   {},
+		"ListTrafficPolicies":                    {},
+		"ListTrafficPolicyInstances":             {},
+		"ListTrafficPolicyInstancesByHostedZone": {},
+		"ListTrafficPolicyInstancesByPolicy":     {},
+		"ListTrafficPolicyVersions":              {},
+		"ListVPCAssociationAuthorizations":       {},
+	},
+	names.Route53Domains: {
+		"GetContactReachabilityStatus": {},
+		"GetDomainDetail":              {},
+		"GetDomainSuggestions":         {},
+		"GetOperationDetail":           {},
+		"ListDomains":                  {},
+		"ListOperations":               {},
+		"ListPrices":                   {},
+		"ListTagsForDomain":            {},
+	},
+	names.Route53Profiles: {
+		"GetProfile":                      {},
+		"GetProfileAssociation":           {},
+		"GetProfileResourceAssociation":   {},
+		"ListProfileAssociations":         {},
+		"ListProfileResourceAssociations": {},
+		"ListProfiles":                    {},
+		"ListTagsForResource":             {},
+	},
+	names.Route53RecoveryControlConfig: {
+		"DescribeCluster":                   {},
+		"DescribeControlPanel":              {},
+		"DescribeRoutingControl":            {},
+		"DescribeSafetyRule":                {},
+		"GetResourcePolicy":                 {},
+		"ListAssociatedRoute53HealthChecks": {},
+		"ListClusters":                      {},
+		"ListControlPanels":                 {},
+		"ListRoutingControls":               {},
+		"ListSafetyRules":                   {},
+		"ListTagsForResource":               {},
+	},
+	names.Route53RecoveryReadiness: {
+		"GetArchitectureRecommendations":   {},
+		"GetCell":                          {},
+		"GetCellReadinessSummary":          {},
+		"GetReadinessCheck":                {},
+		"GetReadinessCheckResourceStatus":  {},
+		"GetReadinessCheckStatus":          {},
+		"GetRecoveryGroup":                 {},
+		"GetRecoveryGroupReadinessSummary": {},
+		"GetResourceSet":                   {},
+		"ListCells":                        {},
+		"ListCrossAccountAuthorizations":   {},
+		"ListReadinessChecks":              {},
+		"ListRecoveryGroups":               {},
+		"ListResourceSets":                 {},
+		"ListRules":                        {},
+		"ListTagsForResources":             {},
+	},
+	names.Route53Resolver: {
+		"GetFirewallConfig":                      {},
+		"GetFirewallDomainList":                  {},
+		"GetFirewallRuleGroup":                   {},
+		"GetFirewallRuleGroupAssociation":        {},
+		"GetFirewallRuleGroupPolicy":             {},
+		"GetOutpostResolver":                     {},
+		"GetResolverConfig":                      {},
+		"GetResolverDnssecConfig":                {},
+		"GetResolverEndpoint":                    {},
+		"GetResolverQueryLogConfig":              {},
+		"GetResolverQueryLogConfigAssociation":   {},
+		"GetResolverQueryLogConfigPolicy":        {},
+		"GetResolverRule":                        {},
+		"GetResolverRuleAssociation":             {},
+		"GetResolverRulePolicy":                  {},
+		"ListFirewallConfigs":                    {},
+		"ListFirewallDomainLists":                {},
+		"ListFirewallDomains":                    {},
+		"ListFirewallRuleGroupAssociations":      {},
+		"ListFirewallRuleGroups":                 {},
+		"ListFirewallRules":                      {},
+		"ListOutpostResolvers":                   {},
+		"ListResolverConfigs":                    {},
+		"ListResolverDnssecConfigs":              {},
+		"ListResolverEndpointIpAddresses":        {},
+		"ListResolverEndpoints":                  {},
+		"ListResolverQueryLogConfigAssociations": {},
+		"ListResolverQueryLogConfigs":            {},
+		"ListResolverRuleAssociations":           {},
+		"ListResolverRules":                      {},
+		"ListTagsForResource":                    {},
+	},
+	names.S3: {
+		"GetBucketAccelerateConfiguration":           {},
+		"GetBucketAcl":                               {},
+		"GetBucketAnalyticsConfiguration":            {},
+		"GetBucketCors":                              {},
+		"GetBucketEncryption":                        {},
+		"GetBucketIntelligentTieringConfiguration":   {},
+		"GetBucketInventoryConfiguration":            {},
+		"GetBucketLifecycleConfiguration":            {},
+		"GetBucketLocation":                          {},
+		"GetBucketLogging":                           {},
+		"GetBucketMetadataConfiguration":             {},
+		"GetBucketMetadataTableConfiguration":        {},
+		"GetBucketMetricsConfiguration":              {},
+		"GetBucketNotificationConfiguration":         {},
+		"GetBucketOwnershipControls":                 {},
+		"GetBucketPolicy":                            {},
+		"GetBucketPolicyStatus":                      {},
+		"GetBucketReplication":                       {},
+		"GetBucketRequestPayment":                    {},
+		"GetBucketTagging":                           {},
+		"GetBucketVersioning":                        {},
+		"GetBucketWebsite":                           {},
+		"GetObject":                                  {},
+		"GetObjectAcl":                               {},
+		"GetObjectAttributes":                        {},
+		"GetObjectLegalHold":                         {},
+		"GetObjectLockConfiguration":                 {},
+		"GetObjectRetention":                         {},
+		"GetObjectTagging":                           {},
+		"GetObjectTorrent":                           {},
+		"GetPublicAccessBlock":                       {},
+		"HeadBucket":                                 {},
+		"HeadObject":                                 {},
+		"ListBucketAnalyticsConfigurations":          {},
+		"ListBucketIntelligentTieringConfigurations": {},
+		"ListBucketInventoryConfigurations":          {},
+		"ListBucketMetricsConfigurations":            {},
+		"ListBuckets":                                {},
+		"ListDirectoryBuckets":                       {},
+		"ListMultipartUploads":                       {},
+		"ListObjectVersions":                         {},
+		"ListObjects":                                {},
+		"ListObjectsV2":                              {},
+		"ListParts":                                  {},
+		"SelectObjectContent":                        {},
+	},
+	names.S3Control: {
+		"DescribeJob": {},
+		"DescribeMultiRegionAccessPointOperation": {},
+		"GetAccessGrant":                             {},
+		"GetAccessGrantsInstance":                    {},
+		"GetAccessGrantsInstanceForPrefix":           {},
+		"GetAccessGrantsInstanceResourcePolicy":      {},
+		"GetAccessGrantsLocation":                    {},
+		"GetAccessPoint":                             {},
+		"GetAccessPointConfigurationForObjectLambda": {},
+		"GetAccessPointForObjectLambda":              {},
+		"GetAccessPointPolicy":                       {},
+		"GetAccessPointPolicyForObjectLambda":        {},
+		"GetAccessPointPolicyStatus":                 {},
+		"GetAccessPointPolicyStatusForObjectLambda":  {},
+		"GetAccessPointScope":                        {},
+		"GetBucket":                                  {},
+		"GetBucketLifecycleConfiguration":            {},
+		"GetBucketPolicy":                            {},
+		"GetBucketReplication":                       {},
+		"GetBucketTagging":                           {},
+		"GetBucketVersioning":                        {},
+		"GetDataAccess":                              {},
+		"GetJobTagging":                              {},
+		"GetMultiRegionAccessPoint":                  {},
+		"GetMultiRegionAccessPointPolicy":            {},
+		"GetMultiRegionAccessPointPolicyStatus":      {},
+		"GetMultiRegionAccessPointRoutes":            {},
+		"GetPublicAccessBlock":                       {},
+		"GetStorageLensConfiguration":                {},
+		"GetStorageLensConfigurationTagging":         {},
+		"GetStorageLensGroup":                        {},
+		"ListAccessGrants":                           {},
+		"ListAccessGrantsInstances":                  {},
+		"ListAccessGrantsLocations":                  {},
+		"ListAccessPoints":                           {},
+		"ListAccessPointsForDirectoryBuckets":        {},
+		"ListAccessPointsForObjectLambda":            {},
+		"ListCallerAccessGrants":                     {},
+		"ListJobs":                                   {},
+		"ListMultiRegionAccessPoints":                {},
+		"ListRegionalBuckets":                        {},
+		"ListStorageLensConfigurations":              {},
+		"ListStorageLensGroups":                      {},
+		"ListTagsForResource":                        {},
+	},
+	names.S3Outposts: {
+		"ListEndpoints":       {},
+		"ListOutpostsWithS3":  {},
+		"ListSharedEndpoints": {},
+	},
+	names.S3Vectors: {
+		"GetIndex":              {},
+		"GetVectorBucket":       {},
+		"GetVectorBucketPolicy": {},
+		"GetVectors":            {},
+		"ListIndexes":           {},
+		"ListVectorBuckets":     {},
+		"ListVectors":           {},
+		"QueryVectors":          {},
+	},
+	names.SES: {
+		"DescribeActiveReceiptRuleSet":         {},
+		"DescribeConfigurationSet":             {},
+		"DescribeReceiptRule":                  {},
+		"DescribeReceiptRuleSet":               {},
+		"GetAccountSendingEnabled":             {},
+		"GetCustomVerificationEmailTemplate":   {},
+		"GetIdentityDkimAttributes":            {},
+		"GetIdentityMailFromDomainAttributes":  {},
+		"GetIdentityNotificationAttributes":    {},
+		"GetIdentityPolicies":                  {},
+		"GetIdentityVerificationAttributes":    {},
+		"GetSendQuota":                         {},
+		"GetSendStatistics":                    {},
+		"GetTemplate":                          {},
+		"ListConfigurationSets":                {},
+		"ListCustomVerificationEmailTemplates": {},
+		"ListIdentities":                       {},
+		"ListIdentityPolicies":                 {},
+		"ListReceiptFilters":                   {},
+		"ListReceiptRuleSets":                  {},
+		"ListTemplates":                        {},
+		"ListVerifiedEmailAddresses":           {},
+	},
+	names.SESV2: {
+		"BatchGetMetricData":                   {},
+		"GetAccount":                           {},
+		"GetBlacklistReports":                  {},
+		"GetConfigurationSet":                  {},
+		"GetConfigurationSetEventDestinations": {},
+		"GetContact":                           {},
+		"GetContactList":                       {},
+		"GetCustomVerificationEmailTemplate":   {},
+		"GetDedicatedIp":                       {},
+		"GetDedicatedIpPool":                   {},
+		"GetDedicatedIps":                      {},
+		"GetDeliverabilityDashboardOptions":    {},
+		"GetDeliverabilityTestReport":          {},
+		"GetDomainDeliverabilityCampaign":      {},
+		"GetDomainStatisticsReport":            {},
+		"GetEmailIdentity":                     {},
+		"GetEmailIdentityPolicies":             {},
+		"GetEmailTemplate":                     {},
+		"GetExportJob":                         {},
+		"GetImportJob":                         {},
+		"GetMessageInsights":                   {},
+		"GetMultiRegionEndpoint":               {},
+		"GetReputationEntity":                  {},
+		"GetSuppressedDestination":             {},
+		"GetTenant":                            {},
+		"ListConfigurationSets":                {},
+		"ListContactLists":                     {},
+		"ListContacts":                         {},
+		"ListCustomVerificationEmailTemplates": {},
+		"ListDedicatedIpPools":                 {},
+		"ListDeliverabilityTestReports":        {},
+		"ListDomainDeliverabilityCampaigns":    {},
+		"ListEmailIdentities":                  {},
+		"ListEmailTemplates":                   {},
+		"ListExportJobs":                       {},
+		"ListImportJobs":                       {},
+		"ListMultiRegionEndpoints":             {},
+		"ListRecommendations":                  {},
+		"ListReputationEntities":               {},
+		"ListResourceTenants":                  {},
+		"ListSuppressedDestinations":           {},
+		"ListTagsForResource":                  {},
+		"ListTenantResources":                  {},
+		"ListTenants":                          {},
+	},
+	names.SFN: {
+		"DescribeActivity":                 {},
+		"DescribeExecution":                {},
+		"DescribeMapRun":                   {},
+		"DescribeStateMachine":             {},
+		"DescribeStateMachineAlias":        {},
+		"DescribeStateMachineForExecution": {},
+		"GetActivityTask":                  {},
+		"GetExecutionHistory":              {},
+		"ListActivities":                   {},
+		"ListExecutions":                   {},
+		"ListMapRuns":                      {},
+		"ListStateMachineAliases":          {},
+		"ListStateMachineVersions":         {},
+		"ListStateMachines":                {},
+		"ListTagsForResource":              {},
+		"ValidateStateMachineDefinition":   {},
+	},
+	names.SNS: {
+		"GetDataProtectionPolicy":            {},
+		"GetEndpointAttributes":              {},
+		"GetPlatformApplicationAttributes":   {},
+		"GetSMSAttributes":                   {},
+		"GetSMSSandboxAccountStatus":         {},
+		"GetSubscriptionAttributes":          {},
+		"GetTopicAttributes":                 {},
+		"ListEndpointsByPlatformApplication": {},
+		"ListOriginationNumbers":             {},
+		"ListPhoneNumbersOptedOut":           {},
+		"ListPlatformApplications":           {},
+		"ListSMSSandboxPhoneNumbers":         {},
+		"ListSubscriptions":                  {},
+		"ListSubscriptionsByTopic":           {},
+		"ListTagsForResource":                {},
+		"ListTopics":                         {},
+	},
+	names.SQS: {
+		"GetQueueAttributes":         {},
+		"GetQueueUrl":                {},
+		"ListDeadLetterSourceQueues": {},
+		"ListMessageMoveTasks":       {},
+		"ListQueueTags":              {},
+		"ListQueues":                 {},
+	},
+	names.SSM: {
+		"DescribeActivations":                               {},
+		"DescribeAssociation":                               {},
+		"DescribeAssociationExecutionTargets":               {},
+		"DescribeAssociationExecutions":                     {},
+		"DescribeAutomationExecutions":                      {},
+		"DescribeAutomationStepExecutions":                  {},
+		"DescribeAvailablePatches":                          {},
+		"DescribeDocument":                                  {},
+		"DescribeDocumentPermission":                        {},
+		"DescribeEffectiveInstanceAssociations":             {},
+		"DescribeEffectivePatchesForPatchBaseline":          {},
+		"DescribeInstanceAssociationsStatus":                {},
+		"DescribeInstanceInformation":                       {},
+		"DescribeInstancePatchStates":                       {},
+		"DescribeInstancePatchStatesForPatchGroup":          {},
+		"DescribeInstancePatches":                           {},
+		"DescribeInstanceProperties":                        {},
+		"DescribeInventoryDeletions":                        {},
+		"DescribeMaintenanceWindowExecutionTaskInvocations": {},
+		"DescribeMaintenanceWindowExecutionTasks":           {},
+		"DescribeMaintenanceWindowExecutions":               {},
+		"DescribeMaintenanceWindowSchedule":                 {},
+		"DescribeMaintenanceWindowTargets":                  {},
+		"DescribeMaintenanceWindowTasks":                    {},
+		"DescribeMaintenanceWindows":                        {},
+		"DescribeMaintenanceWindowsForTarget":               {},
+		"DescribeOpsItems":                                  {},
+		"DescribeParameters":                                {},
+		"DescribePatchBaselines":                            {},
+		"DescribePatchGroupState":                           {},
+		"DescribePatchGroups":                               {},
+		"DescribePatchProperties":                           {},
+		"DescribeSessions":                                  {},
+		"GetAutomationExecution":                            {},
+		"GetCalendarState":                                  {},
+		"GetCommandInvocation":                              {},
+		"GetConnectionStatus":                               {},
+		"GetDefaultPatchBaseline":                           {},
+		"GetDeployablePatchSnapshotForInstance":             {},
+		"GetDocument":                                       {},
+		"GetExecutionPreview":                               {},
+		"GetInventory":                                      {},
+		"GetInventorySchema":                                {},
+		"GetMaintenanceWindow":                              {},
+		"GetMaintenanceWindowExecution":                     {},
+		"GetMaintenanceWindowExecutionTask":                 {},
+		"GetMaintenanceWindowExecutionTaskInvocation":       {},
+		"GetMaintenanceWindowTask":                          {},
+		"GetOpsItem":                                        {},
+		"GetOpsMetadata":                                    {},
+		"GetOpsSummary":                                     {},
+		"GetParameter":                                      {},
+		"GetParameterHistory":                               {},
+		"GetParameters":                                     {},
+		"GetParametersByPath":                               {},
+		"GetPatchBaseline":                                  {},
+		"GetPatchBaselineForPatchGroup":                     {},
+		"GetResourcePolicies":                               {},
+		"GetServiceSetting":                                 {},
+		"ListAssociationVersions":                           {},
+		"ListAssociations":                                  {},
+		"ListCommandInvocations":                            {},
+		"ListCommands":                                      {},
+		"ListComplianceItems":                               {},
+		"ListComplianceSummaries":                           {},
+		"ListDocumentMetadataHistory":                       {},
+		"ListDocumentVersions":                              {},
+		"ListDocuments":                                     {},
+		"ListInventoryEntries":                              {},
+		"ListNodes":                                         {},
+		"ListNodesSummary":                                  {},
+		"ListOpsItemEvents":                                 {},
+		"ListOpsItemRelatedItems":                           {},
+		"ListOpsMetadata":                                   {},
+		"ListResourceComplianceSummaries":                   {},
+		"ListResourceDataSync":                              {},
+		"ListTagsForResource":                               {},
+	},
+	names.SSMContacts: {
+		"DescribeEngagement":        {},
+		"DescribePage":              {},
+		"GetContact":                {},
+		"GetContactChannel":         {},
+		"GetContactPolicy":          {},
+		"GetRotation":               {},
+		"GetRotationOverride":       {},
+		"ListContactChannels":       {},
+		"ListContacts":              {},
+		"ListEngagements":           {},
+		"ListPageReceipts":          {},
+		"ListPageResolutions":       {},
+		"ListPagesByContact":        {},
+		"ListPagesByEngagement":     {},
+		"ListPreviewRotationShifts": {},
+		"ListRotationOverrides":     {},
+		"ListRotationShifts":        {},
+		"ListRotations":             {},
+		"ListTagsForResource":       {},
+	},
+	names.SSMIncidents: {
+		"BatchGetIncidentFindings": {},
+		"GetIncidentRecord":        {},
+		"GetReplicationSet":        {},
+		"GetResourcePolicies":      {},
+		"GetResponsePlan":          {},
+		"GetTimelineEvent":         {},
+		"ListIncidentFindings":     {},
+		"ListIncidentRecords":      {},
+		"ListRelatedItems":         {},
+		"ListReplicationSets":      {},
+		"ListResponsePlans":        {},
+		"ListTagsForResource":      {},
+		"ListTimelineEvents":       {},
+	},
+	names.SSMQuickSetup: {
+		"GetConfiguration":          {},
+		"GetConfigurationManager":   {},
+		"GetServiceSettings":        {},
+		"ListConfigurationManagers": {},
+		"ListConfigurations":        {},
+		"ListQuickSetupTypes":       {},
+		"ListTagsForResource":       {},
+	},
+	names.SSMSAP: {
+		"GetApplication":                    {},
+		"GetComponent":                      {},
+		"GetConfigurationCheckOperation":    {},
+		"GetDatabase":                       {},
+		"GetOperation":                      {},
+		"GetResourcePermission":             {},
+		"ListApplications":                  {},
+		"ListComponents":                    {},
+		"ListConfigurationCheckDefinitions": {},
+		"ListConfigurationCheckOperations":  {},
+		"ListDatabases":                     {},
+		"ListOperationEvents":               {},
+		"ListOperations":                    {},
+		"ListSubCheckResults":               {},
+		"ListSubCheckRuleResults":           {},
+		"ListTagsForResource":               {},
+	},
+	names.SSO: {
+		"ListAccountRoles": {},
+		"ListAccounts":     {},
+	},
+	names.SSOAdmin: {
+		"DescribeAccountAssignmentCreationStatus":             {},
+		"DescribeAccountAssignmentDeletionStatus":             {},
+		"DescribeApplication":                                 {},
+		"DescribeApplicationAssignment":                       {},
+		"DescribeApplicationProvider":                         {},
+		"DescribeInstance":                                    {},
+		"DescribeInstanceAccessControlAttributeConfiguration": {},
+		"DescribePermissionSet":                               {},
+		"DescribePermissionSetProvisioningStatus":             {},
+		"DescribeTrustedTokenIssuer":                          {},
+		"GetApplicationAccessScope":                           {},
+		"GetApplicationAssignmentConfiguration":               {},
+		"GetApplicationAuthenticationMethod":                  {},
+		"GetApplicationGrant":                                 {},
+		"GetApplicationSessionConfiguration":                  {},
+		"GetInlinePolicyForPermissionSet":                     {},
+		"GetPermissionsBoundaryForPermissionSet":              {},
+		"ListAccountAssignmentCreationStatus":                 {},
+		"ListAccountAssignmentDeletionStatus":                 {},
+		"ListAccountAssignments":                              {},
+		"ListAccountAssignmentsForPrincipal":                  {},
+		"ListAccountsForProvisionedPermissionSet":             {},
+		"ListApplicationAccessScopes":                         {},
+		"ListApplicationAssignments":                          {},
+		"ListApplicationAssignmentsForPrincipal":              {},
+		"ListApplicationAuthenticationMethods":                {},
+		"ListApplicationGrants":                               {},
+		"ListApplicationProviders":                            {},
+		"ListApplications":                                    {},
+		"ListCustomerManagedPolicyReferencesInPermissionSet":  {},
+		"ListInstances":                                       {},
+		"ListManagedPoliciesInPermissionSet":                  {},
+		"ListPermissionSetProvisioningStatus":                 {},
+		"ListPermissionSets":                                  {},
+		"ListPermissionSetsProvisionedToAccount":              {},
+		"ListTagsForResource":                                 {},
+		"ListTrustedTokenIssuers":                             {},
+	},
+	names.STS: {
+		"GetAccessKeyInfo":  {},
+		"GetCallerIdentity": {},
+	},
+	names.SWF: {
+		"DescribeActivityType":         {},
+		"DescribeDomain":               {},
+		"DescribeWorkflowExecution":    {},
+		"DescribeWorkflowType":         {},
+		"GetWorkflowExecutionHistory":  {},
+		"ListActivityTypes":            {},
+		"ListClosedWorkflowExecutions": {},
+		"ListDomains":                  {},
+		"ListOpenWorkflowExecutions":   {},
+		"ListTagsForResource":          {},
+		"ListWorkflowTypes":            {},
+	},
+	names.SageMaker: {
+		"BatchDescribeModelPackage":                  {},
+		"DescribeAction":                             {},
+		"DescribeAlgorithm":                          {},
+		"DescribeApp":                                {},
+		"DescribeAppImageConfig":                     {},
+		"DescribeArtifact":                           {},
+		"DescribeAutoMLJob":                          {},
+		"DescribeAutoMLJobV2":                        {},
+		"DescribeCluster":                            {},
+		"DescribeClusterEvent":                       {},
+		"DescribeClusterNode":                        {},
+		"DescribeClusterSchedulerConfig":             {},
+		"DescribeCodeRepository":                     {},
+		"DescribeCompilationJob":                     {},
+		"DescribeComputeQuota":                       {},
+		"DescribeContext":                            {},
+		"DescribeDataQualityJobDefinition":           {},
+		"DescribeDevice":                             {},
+		"DescribeDeviceFleet":                        {},
+		"DescribeDomain":                             {},
+		"DescribeEdgeDeploymentPlan":                 {},
+		"DescribeEdgePackagingJob":                   {},
+		"DescribeEndpoint":                           {},
+		"DescribeEndpointConfig":                     {},
+		"DescribeExperiment":                         {},
+		"DescribeFeatureGroup":                       {},
+		"DescribeFeatureMetadata":                    {},
+		"DescribeFlowDefinition":                     {},
+		"DescribeHub":                                {},
+		"DescribeHubContent":                         {},
+		"DescribeHumanTaskUi":                        {},
+		"DescribeHyperParameterTuningJob":            {},
+		"DescribeImage":                              {},
+		"DescribeImageVersion":                       {},
+		"DescribeInferenceComponent":                 {},
+		"DescribeInferenceExperiment":                {},
+		"DescribeInferenceRecommendationsJob":        {},
+		"DescribeLabelingJob":                        {},
+		"DescribeLineageGroup":                       {},
+		"DescribeMlflowTrackingServer":               {},
+		"DescribeModel":                              {},
+		"DescribeModelBiasJobDefinition":             {},
+		"DescribeModelCard":                          {},
+		"DescribeModelCardExportJob":                 {},
+		"DescribeModelExplainabilityJobDefinition":   {},
+		"DescribeModelPackage":                       {},
+		"DescribeModelPackageGroup":                  {},
+		"DescribeModelQualityJobDefinition":          {},
+		"DescribeMonitoringSchedule":                 {},
+		"DescribeNotebookInstance":                   {},
+		"DescribeNotebookInstanceLifecycleConfig":    {},
+		"DescribeOptimizationJob":                    {},
+		"DescribePartnerApp":                         {},
+		"DescribePipeline":                           {},
+		"DescribePipelineDefinitionForExecution":     {},
+		"DescribePipelineExecution":                  {},
+		"DescribeProcessingJob":                      {},
+		"DescribeProject":                            {},
+		"DescribeReservedCapacity":                   {},
+		"DescribeSpace":                              {},
+		"DescribeStudioLifecycleConfig":              {},
+		"DescribeSubscribedWorkteam":                 {},
+		"DescribeTrainingJob":                        {},
+		"DescribeTrainingPlan":                       {},
+		"DescribeTransformJob":                       {},
+		"DescribeTrial":                              {},
+		"DescribeTrialComponent":                     {},
+		"DescribeUserProfile":                        {},
+		"DescribeWorkforce":                          {},
+		"DescribeWorkteam":                           {},
+		"GetDeviceFleetReport":                       {},
+		"GetLineageGroupPolicy":                      {},
+		"GetModelPackageGroupPolicy":                 {},
+		"GetSagemakerServicecatalogPortfolioStatus":  {},
+		"GetScalingConfigurationRecommendation":      {},
+		"GetSearchSuggestions":                       {},
+		"ListActions":                                {},
+		"ListAlgorithms":                             {},
+		"ListAliases":                                {},
+		"ListAppImageConfigs":                        {},
+		"ListApps":                                   {},
+		"ListArtifacts":                              {},
+		"ListAssociations":                           {},
+		"ListAutoMLJobs":                             {},
+		"ListCandidatesForAutoMLJob":                 {},
+		"ListClusterEvents":                          {},
+		"ListClusterNodes":                           {},
+		"ListClusterSchedulerConfigs":                {},
+		"ListClusters":                               {},
+		"ListCodeRepositories":                       {},
+		"ListCompilationJobs":                        {},
+		"ListComputeQuotas":                          {},
+		"ListContexts":                               {},
+		"ListDataQualityJobDefinitions":              {},
+		"ListDeviceFleets":                           {},
+		"ListDevices":                                {},
+		"ListDomains":                                {},
+		"ListEdgeDeploymentPlans":                    {},
+		"ListEdgePackagingJobs":                      {},
+		"ListEndpointConfigs":                        {},
+		"ListEndpoints":                              {},
+		"ListExperiments":                            {},
+		"ListFeatureGroups":                          {},
+		"ListFlowDefinitions":                        {},
+		"ListHubContentVersions":                     {},
+		"ListHubContents":                            {},
+		"ListHubs":                                   {},
+		"ListHumanTaskUis":                           {},
+		"ListHyperParameterTuningJobs":               {},
+		"ListImageVersions":                          {},
+		"ListImages":                                 {},
+		"ListInferenceComponents":                    {},
+		"ListInferenceExperiments":                   {},
+		"ListInferenceRecommendationsJobSteps":       {},
+		"ListInferenceRecommendationsJobs":           {},
+		"ListLabelingJobs":                           {},
+		"ListLabelingJobsForWorkteam":                {},
+		"ListLineageGroups":                          {},
+		"ListMlflowTrackingServers":                  {},
+		"ListModelBiasJobDefinitions":                {},
+		"ListModelCardExportJobs":                    {},
+		"ListModelCardVersions":                      {},
+		"ListModelCards":                             {},
+		"ListModelExplainabilityJobDefinitions":      {},
+		"ListModelMetadata":                          {},
+		"ListModelPackageGroups":                     {},
+		"ListModelPackages":                          {},
+		"ListModelQualityJobDefinitions":             {},
+		"ListModels":                                 {},
+		"ListMonitoringAlertHistory":                 {},
+		"ListMonitoringAlerts":                       {},
+		"ListMonitoringExecutions":                   {},
+		"ListMonitoringSchedules":                    {},
+		"ListNotebookInstanceLifecycleConfigs":       {},
+		"ListNotebookInstances":                      {},
+		"ListOptimizationJobs":                       {},
+		"ListPartnerApps":                            {},
+		"ListPipelineExecutionSteps":                 {},
+		"ListPipelineExecutions":                     {},
+		"ListPipelineParametersForExecution":         {},
+		"ListPipelineVersions":                       {},
+		"ListPipelines":                              {},
+		"ListProcessingJobs":                         {},
+		"ListProjects":                               {},
+		"ListResourceCatalogs":                       {},
+		"ListSpaces":                                 {},
+		"ListStageDevices":                           {},
+		"ListStudioLifecycleConfigs":                 {},
+		"ListSubscribedWorkteams":                    {},
+		"ListTags":                                   {},
+		"ListTrainingJobs":                           {},
+		"ListTrainingJobsForHyperParameterTuningJob": {},
+		"ListTrainingPlans":                          {},
+		"ListTransformJobs":                          {},
+		"ListTrialComponents":                        {},
+		"ListTrials":                                 {},
+		"ListUltraServersByReservedCapacity":         {},
+		"ListUserProfiles":                           {},
+		"ListWorkforces":                             {},
+		"ListWorkteams":                              {},
+		"QueryLineage":                               {},
+		"Search":                                     {},
+		"SearchTrainingPlanOfferings":                {},
+	},
+	names.Scheduler: {
+		"GetSchedule":         {},
+		"GetScheduleGroup":    {},
+		"ListScheduleGroups":  {},
+		"ListSchedules":       {},
+		"ListTagsForResource": {},
+	},
+	names.Schemas: {
+		"DescribeCodeBinding":  {},
+		"DescribeDiscoverer":   {},
+		"DescribeRegistry":     {},
+		"DescribeSchema":       {},
+		"GetCodeBindingSource": {},
+		"GetDiscoveredSchema":  {},
+		"GetResourcePolicy":    {},
+		"ListDiscoverers":      {},
+		"ListRegistries":       {},
+		"ListSchemaVersions":   {},
+		"ListSchemas":          {},
+		"ListTagsForResource":  {},
+		"SearchSchemas":        {},
+	},
+	names.SecretsManager: {
+		"BatchGetSecretValue":    {},
+		"DescribeSecret":         {},
+		"GetRandomPassword":      {},
+		"GetResourcePolicy":      {},
+		"GetSecretValue":         {},
+		"ListSecretVersionIds":   {},
+		"ListSecrets":            {},
+		"ValidateResourcePolicy": {},
+	},
+	names.SecurityHub: {
+		"BatchGetAutomationRules":                 {},
+		"BatchGetConfigurationPolicyAssociations": {},
+		"BatchGetSecurityControls":                {},
+		"BatchGetStandardsControlAssociations":    {},
+		"DescribeActionTargets":                   {},
+		"DescribeHub":                             {},
+		"DescribeOrganizationConfiguration":       {},
+		"DescribeProducts":                        {},
+		"DescribeProductsV2":                      {},
+		"DescribeSecurityHubV2":                   {},
+		"DescribeStandards":                       {},
+		"DescribeStandardsControls":               {},
+		"GetAdministratorAccount":                 {},
+		"GetAggregatorV2":                         {},
+		"GetAutomationRuleV2":                     {},
+		"GetConfigurationPolicy":                  {},
+		"GetConfigurationPolicyAssociation":       {},
+		"GetConnectorV2":                          {},
+		"GetEnabledStandards":                     {},
+		"GetFindingAggregator":                    {},
+		"GetFindingHistory":                       {},
+		"GetFindingStatisticsV2":                  {},
+		"GetFindings":                             {},
+		"GetFindingsV2":                           {},
+		"GetInsightResults":                       {},
+		"GetInsights":                             {},
+		"GetInvitationsCount":                     {},
+		"GetMasterAccount":                        {},
+		"GetMembers":                              {},
+		"GetResourcesStatisticsV2":                {},
+		"GetResourcesV2":                          {},
+		"GetSecurityControlDefinition":            {},
+		"ListAggregatorsV2":                       {},
+		"ListAutomationRules":                     {},
+		"ListAutomationRulesV2":                   {},
+		"ListConfigurationPolicies":               {},
+		"ListConfigurationPolicyAssociations":     {},
+		"ListConnectorsV2":                        {},
+		"ListEnabledProductsForImport":            {},
+		"ListFindingAggregators":                  {},
+		"ListInvitations":                         {},
+		"ListMembers":                             {},
+		"ListOrganizationAdminAccounts":           {},
+		"ListSecurityControlDefinitions":          {},
+		"ListStandardsControlAssociations":        {},
+		"ListTagsForResource":                     {},
+	},
+	names.SecurityLake: {
+		"GetDataLakeExceptionSubscription":     {},
+		"GetDataLakeOrganizationConfiguration": {},
+		"GetDataLakeSources":                   {},
+		"GetSubscriber":                        {},
+		"ListDataLakeExceptions":               {},
+		"ListDataLakes":                        {},
+		"ListLogSources":                       {},
+		"ListSubscribers":                      {},
+		"ListTagsForResource":                  {},
+	},
+	names.ServerlessRepo: {
+		"GetApplication":              {},
+		"GetApplicationPolicy":        {},
+		"GetCloudFormationTemplate":   {},
+		"ListApplicationDependencies": {},
+		"ListApplicationVersions":     {},
+		"ListApplications":            {},
+	},
+	names.ServiceCatalog: {
+		"DescribeConstraint":                        {},
+		"DescribeCopyProductStatus":                 {},
+		"DescribePortfolio":                         {},
+		"DescribePortfolioShareStatus":              {},
+		"DescribePortfolioShares":                   {},
+		"DescribeProduct":                           {},
+		"DescribeProductAsAdmin":                    {},
+		"DescribeProductView":                       {},
+		"DescribeProvisionedProduct":                {},
+		"DescribeProvisionedProductPlan":            {},
+		"DescribeProvisioningArtifact":              {},
+		"DescribeProvisioningParameters":            {},
+		"DescribeRecord":                            {},
+		"DescribeServiceAction":                     {},
+		"DescribeServiceActionExecutionParameters":  {},
+		"DescribeTagOption":                         {},
+		"GetAWSOrganizationsAccessStatus":           {},
+		"GetProvisionedProductOutputs":              {},
+		"ListAcceptedPortfolioShares":               {},
+		"ListBudgetsForResource":                    {},
+		"ListConstraintsForPortfolio":               {},
+		"ListLaunchPaths":                           {},
+		"ListOrganizationPortfolioAccess":           {},
+		"ListPortfolioAccess":                       {},
+		"ListPortfolios":                            {},
+		"ListPortfoliosForProduct":                  {},
+		"ListPrincipalsForPortfolio":                {},
+		"ListProvisionedProductPlans":               {},
+		"ListProvisioningArtifacts":                 {},
+		"ListProvisioningArtifactsForServiceAction": {},
+		"ListRecordHistory":                         {},
+		"ListResourcesForTagOption":                 {},
+		"ListServiceActions":                        {},
+		"ListServiceActionsForProvisioningArtifact": {},
+		"ListStackInstancesForProvisionedProduct":   {},
+		"ListTagOptions":                            {},
+		"ScanProvisionedProducts":                   {},
+		"SearchProducts":                            {},
+		"SearchProductsAsAdmin":                     {},
+		"SearchProvisionedProducts":                 {},
+	},
+	names.ServiceCatalogAppRegistry: {
+		"GetApplication":                    {},
+		"GetAssociatedResource":             {},
+		"GetAttributeGroup":                 {},
+		"GetConfiguration":                  {},
+		"ListApplications":                  {},
+		"ListAssociatedAttributeGroups":     {},
+		"ListAssociatedResources":           {},
+		"ListAttributeGroups":               {},
+		"ListAttributeGroupsForApplication": {},
+		"ListTagsForResource":               {},
+	},
+	names.ServiceDiscovery: {
+		"GetInstance":              {},
+		"GetInstancesHealthStatus": {},
+		"GetNamespace":             {},
+		"GetOperation":             {},
+		"GetService":               {},
+		"GetServiceAttributes":     {},
+		"ListInstances":            {},
+		"ListNamespaces":           {},
+		"ListOperations":           {},
+		"ListServices":             {},
+		"ListTagsForResource":      {},
+	},
+	names.ServiceQuotas: {
+		"GetAWSDefaultServiceQuota":                     {},
+		"GetAssociationForServiceQuotaTemplate":         {},
+		"GetAutoManagementConfiguration":                {},
+		"GetRequestedServiceQuotaChange":                {},
+		"GetServiceQuota":                               {},
+		"GetServiceQuotaIncreaseRequestFromTemplate":    {},
+		"ListAWSDefaultServiceQuotas":                   {},
+		"ListRequestedServiceQuotaChangeHistory":        {},
+		"ListRequestedServiceQuotaChangeHistoryByQuota": {},
+		"ListServiceQuotaIncreaseRequestsInTemplate":    {},
+		"ListServiceQuotas":                             {},
+		"ListServices":                                  {},
+		"ListTagsForResource":                           {},
+	},
+	names.Shield: {
+		"DescribeAttack":                   {},
+		"DescribeAttackStatistics":         {},
+		"DescribeDRTAccess":                {},
+		"DescribeEmergencyContactSettings": {},
+		"DescribeProtection":               {},
+		"DescribeProtectionGroup":          {},
+		"DescribeSubscription":             {},
+		"GetSubscriptionState":             {},
+		"ListAttacks":                      {},
+		"ListProtectionGroups":             {},
+		"ListProtections":                  {},
+		"ListResourcesInProtectionGroup":   {},
+		"ListTagsForResource":              {},
+	},
+	names.Signer: {
+		"DescribeSigningJob":     {},
+		"GetRevocationStatus":    {},
+		"GetSigningPlatform":     {},
+		"GetSigningProfile":      {},
+		"ListProfilePermissions": {},
+		"ListSigningJobs":        {},
+		"ListSigningPlatforms":   {},
+		"ListSigningProfiles":    {},
+		"ListTagsForResource":    {},
+	},
+	names.StorageGateway: {
+		"DescribeAvailabilityMonitorTest":    {},
+		"DescribeBandwidthRateLimit":         {},
+		"DescribeBandwidthRateLimitSchedule": {},
+		"DescribeCache":                      {},
+		"DescribeCacheReport":                {},
+		"DescribeCachediSCSIVolumes":         {},
+		"DescribeChapCredentials":            {},
+		"DescribeFileSystemAssociations":     {},
+		"DescribeGatewayInformation":         {},
+		"DescribeMaintenanceStartTime":       {},
+		"DescribeNFSFileShares":              {},
+		"DescribeSMBFileShares":              {},
+		"DescribeSMBSettings":                {},
+		"DescribeSnapshotSchedule":           {},
+		"DescribeStorediSCSIVolumes":         {},
+		"DescribeTapeArchives":               {},
+		"DescribeTapeRecoveryPoints":         {},
+		"DescribeTapes":                      {},
+		"DescribeUploadBuffer":               {},
+		"DescribeVTLDevices":                 {},
+		"DescribeWorkingStorage":             {},
+		"ListAutomaticTapeCreationPolicies":  {},
+		"ListCacheReports":                   {},
+		"ListFileShares":                     {},
+		"ListFileSystemAssociations":         {},
+		"ListGateways":                       {},
+		"ListLocalDisks":                     {},
+		"ListTagsForResource":                {},
+		"ListTapePools":                      {},
+		"ListTapes":                          {},
+		"ListVolumeInitiators":               {},
+		"ListVolumeRecoveryPoints":           {},
+		"ListVolumes":                        {},
+	},
+	names.Synthetics: {
+		"DescribeCanaries":        {},
+		"DescribeCanariesLastRun": {},
+		"DescribeRuntimeVersions": {},
+		"GetCanary":               {},
+		"GetCanaryRuns":           {},
+		"GetGroup":                {},
+		"ListAssociatedGroups":    {},
+		"ListGroupResources":      {},
+		"ListGroups":              {},
+		"ListTagsForResource":     {},
+	},
+	names.TaxSettings: {
+		"BatchGetTaxExemptions":            {},
+		"GetTaxExemptionTypes":             {},
+		"GetTaxInheritance":                {},
+		"GetTaxRegistration":               {},
+		"GetTaxRegistrationDocument":       {},
+		"ListSupplementalTaxRegistrations": {},
+		"ListTaxExemptions":                {},
+		"ListTaxRegistrations":             {},
+	},
+	names.TimestreamInfluxDB: {
+		"GetDbCluster":              {},
+		"GetDbInstance":             {},
+		"GetDbParameterGroup":       {},
+		"ListDbClusters":            {},
+		"ListDbInstances":           {},
+		"ListDbInstancesForCluster": {},
+		"ListDbParameterGroups":     {},
+		"ListTagsForResource":       {},
+	},
+	names.TimestreamQuery: {
+		"DescribeAccountSettings": {},
+		"DescribeEndpoints":       {},
+		"DescribeScheduledQuery":  {},
+		"ListScheduledQueries":    {},
+		"ListTagsForResource":     {},
+		"Query":                   {},
+	},
+	names.TimestreamWrite: {
+		"DescribeBatchLoadTask": {},
+		"DescribeDatabase":      {},
+		"DescribeEndpoints":     {},
+		"DescribeTable":         {},
+		"ListBatchLoadTasks":    {},
+		"ListDatabases":         {},
+		"ListTables":            {},
+		"ListTagsForResource":   {},
+	},
+	names.Transcribe: {
+		"DescribeLanguageModel":        {},
+		"GetCallAnalyticsCategory":     {},
+		"GetCallAnalyticsJob":          {},
+		"GetMedicalScribeJob":          {},
+		"GetMedicalTranscriptionJob":   {},
+		"GetMedicalVocabulary":         {},
+		"GetTranscriptionJob":          {},
+		"GetVocabulary":                {},
+		"GetVocabularyFilter":          {},
+		"ListCallAnalyticsCategories":  {},
+		"ListCallAnalyticsJobs":        {},
+		"ListLanguageModels":           {},
+		"ListMedicalScribeJobs":        {},
+		"ListMedicalTranscriptionJobs": {},
+		"ListMedicalVocabularies":      {},
+		"ListTagsForResource":          {},
+		"ListTranscriptionJobs":        {},
+		"ListVocabularies":             {},
+		"ListVocabularyFilters":        {},
+	},
+	names.Transfer: {
+		"DescribeAccess":              {},
+		"DescribeAgreement":           {},
+		"DescribeCertificate":         {},
+		"DescribeConnector":           {},
+		"DescribeExecution":           {},
+		"DescribeHostKey":             {},
+		"DescribeProfile":             {},
+		"DescribeSecurityPolicy":      {},
+		"DescribeServer":              {},
+		"DescribeUser":                {},
+		"DescribeWebApp":              {},
+		"DescribeWebAppCustomization": {},
+		"DescribeWorkflow":            {},
+		"ListAccesses":                {},
+		"ListAgreements":              {},
+		"ListCertificates":            {},
+		"ListConnectors":              {},
+		"ListExecutions":              {},
+		"ListFileTransferResults":     {},
+		"ListHostKeys":                {},
+		"ListProfiles":                {},
+		"ListSecurityPolicies":        {},
+		"ListServers":                 {},
+		"ListTagsForResource":         {},
+		"ListUsers":                   {},
+		"ListWebApps":                 {},
+		"ListWorkflows":               {},
+	},
+	names.VPCLattice: {
+		"GetAccessLogSubscription":                  {},
+		"GetAuthPolicy":                             {},
+		"GetListener":                               {},
+		"GetResourceConfiguration":                  {},
+		"GetResourceGateway":                        {},
+		"GetResourcePolicy":                         {},
+		"GetRule":                                   {},
+		"GetService":                                {},
+		"GetServiceNetwork":                         {},
+		"GetServiceNetworkResourceAssociation":      {},
+		"GetServiceNetworkServiceAssociation":       {},
+		"GetServiceNetworkVpcAssociation":           {},
+		"GetTargetGroup":                            {},
+		"ListAccessLogSubscriptions":                {},
+		"ListListeners":                             {},
+		"ListResourceConfigurations":                {},
+		"ListResourceEndpointAssociations":          {},
+		"ListResourceGateways":                      {},
+		"ListRules":                                 {},
+		"ListServiceNetworkResourceAssociations":    {},
+		"ListServiceNetworkServiceAssociations":     {},
+		"ListServiceNetworkVpcAssociations":         {},
+		"ListServiceNetworkVpcEndpointAssociations": {},
+		"ListServiceNetworks":                       {},
+		"ListServices":                              {},
+		"ListTagsForResource":                       {},
+		"ListTargetGroups":                          {},
+		"ListTargets":                               {},
+	},
+	names.VerifiedPermissions: {
+		"BatchGetPolicy":      {},
+		"GetIdentitySource":   {},
+		"GetPolicy":           {},
+		"GetPolicyStore":      {},
+		"GetPolicyTemplate":   {},
+		"GetSchema":           {},
+		"ListIdentitySources": {},
+		"ListPolicies":        {},
+		"ListPolicyStores":    {},
+		"ListPolicyTemplates": {},
+		"ListTagsForResource": {},
+	},
+	names.WAF: {
+		"GetByteMatchSet":               {},
+		"GetChangeToken":                {},
+		"GetChangeTokenStatus":          {},
+		"GetGeoMatchSet":                {},
+		"GetIPSet":                      {},
+		"GetLoggingConfiguration":       {},
+		"GetPermissionPolicy":           {},
+		"GetRateBasedRule":              {},
+		"GetRateBasedRuleManagedKeys":   {},
+		"GetRegexMatchSet":              {},
+		"GetRegexPatternSet":            {},
+		"GetRule":                       {},
+		"GetRuleGroup":                  {},
+		"GetSampledRequests":            {},
+		"GetSizeConstraintSet":          {},
+		"GetSqlInjectionMatchSet":       {},
+		"GetWebACL":                     {},
+		"GetXssMatchSet":                {},
+		"ListActivatedRulesInRuleGroup": {},
+		"ListByteMatchSets":             {},
+		"ListGeoMatchSets":              {},
+		"ListIPSets":                    {},
+		"ListLoggingConfigurations":     {},
+		"ListRateBasedRules":            {},
+		"ListRegexMatchSets":            {},
+		"ListRegexPatternSets":          {},
+		"ListRuleGroups":                {},
+		"ListRules":                     {},
+		"ListSizeConstraintSets":        {},
+		"ListSqlInjectionMatchSets":     {},
+		"ListSubscribedRuleGroups":      {},
+		"ListTagsForResource":           {},
+		"ListWebACLs":                   {},
+		"ListXssMatchSets":              {},
+	},
+	names.WAFRegional: {
+		"GetByteMatchSet":               {},
+		"GetChangeToken":                {},
+		"GetChangeTokenStatus":          {},
+		"GetGeoMatchSet":                {},
+		"GetIPSet":                      {},
+		"GetLoggingConfiguration":       {},
+		"GetPermissionPolicy":           {},
+		"GetRateBasedRule":              {},
+		"GetRateBasedRuleManagedKeys":   {},
+		"GetRegexMatchSet":              {},
+		"GetRegexPatternSet":            {},
+		"GetRule":                       {},
+		"GetRuleGroup":                  {},
+		"GetSampledRequests":            {},
+		"GetSizeConstraintSet":          {},
+		"GetSqlInjectionMatchSet":       {},
+		"GetWebACL":                     {},
+		"GetWebACLForResource":          {},
+		"GetXssMatchSet":                {},
+		"ListActivatedRulesInRuleGroup": {},
+		"ListByteMatchSets":             {},
+		"ListGeoMatchSets":              {},
+		"ListIPSets":                    {},
+		"ListLoggingConfigurations":     {},
+		"ListRateBasedRules":            {},
+		"ListRegexMatchSets":            {},
+		"ListRegexPatternSets":          {},
+		"ListResourcesForWebACL":        {},
+		"ListRuleGroups":                {},
+		"ListRules":                     {},
+		"ListSizeConstraintSets":        {},
+		"ListSqlInjectionMatchSets":     {},
+		"ListSubscribedRuleGroups":      {},
+		"ListTagsForResource":           {},
+		"ListWebACLs":                   {},
+		"ListXssMatchSets":              {},
+	},
+	names.WAFV2: {
+		"DescribeAllManagedProducts":            {},
+		"DescribeManagedProductsByVendor":       {},
+		"DescribeManagedRuleGroup":              {},
+		"GetDecryptedAPIKey":                    {},
+		"GetIPSet":                              {},
+		"GetLoggingConfiguration":               {},
+		"GetManagedRuleSet":                     {},
+		"GetMobileSdkRelease":                   {},
+		"GetPermissionPolicy":                   {},
+		"GetRateBasedStatementManagedKeys":      {},
+		"GetRegexPatternSet":                    {},
+		"GetRuleGroup":                          {},
+		"GetSampledRequests":                    {},
+		"GetWebACL":                             {},
+		"GetWebACLForResource":                  {},
+		"ListAPIKeys":                           {},
+		"ListAvailableManagedRuleGroupVersions": {},
+		"ListAvailableManagedRuleGroups":        {},
+		"ListIPSets":                            {},
+		"ListLoggingConfigurations":             {},
+		"ListManagedRuleSets":                   {},
+		"ListMobileSdkReleases":                 {},
+		"ListRegexPatternSets":                  {},
+		"ListResourcesForWebACL":                {},
+		"ListRuleGroups":                        {},
+		"ListTagsForResource":                   {},
+		"ListWebACLs":                           {},
+	},
+	names.WellArchitected: {
+		"GetAnswer":                   {},
+		"GetConsolidatedReport":       {},
+		"GetGlobalSettings":           {},
+		"GetLens":                     {},
+		"GetLensReview":               {},
+		"GetLensReviewReport":         {},
+		"GetLensVersionDifference":    {},
+		"GetMilestone":                {},
+		"GetProfile":                  {},
+		"GetProfileTemplate":          {},
+		"GetReviewTemplate":           {},
+		"GetReviewTemplateAnswer":     {},
+		"GetReviewTemplateLensReview": {},
+		"GetWorkload":                 {},
+		"ListAnswers":                 {},
+		"ListCheckDetails":            {},
+		"ListCheckSummaries":          {},
+		"ListLensReviewImprovements":  {},
+		"ListLensReviews":             {},
+		"ListLensShares":              {},
+		"ListLenses":                  {},
+		"ListMilestones":              {},
+		"ListNotifications":           {},
+		"ListProfileNotifications":    {},
+		"ListProfileShares":           {},
+		"ListProfiles":                {},
+		"ListReviewTemplateAnswers":   {},
+		"ListReviewTemplates":         {},
+		"ListShareInvitations":        {},
+		"ListTagsForResource":         {},
+		"ListTemplateShares":          {},
+		"ListWorkloadShares":          {},
+		"ListWorkloads":               {},
+	},
+	names.WorkMail: {
+		"DescribeEmailMonitoringConfiguration":  {},
+		"DescribeEntity":                        {},
+		"DescribeGroup":                         {},
+		"DescribeIdentityProviderConfiguration": {},
+		"DescribeInboundDmarcSettings":          {},
+		"DescribeMailboxExportJob":              {},
+		"DescribeOrganization":                  {},
+		"DescribeResource":                      {},
+		"DescribeUser":                          {},
+		"GetAccessControlEffect":                {},
+		"GetDefaultRetentionPolicy":             {},
+		"GetImpersonationRole":                  {},
+		"GetImpersonationRoleEffect":            {},
+		"GetMailDomain":                         {},
+		"GetMailboxDetails":                     {},
+		"GetMobileDeviceAccessEffect":           {},
+		"GetMobileDeviceAccessOverride":         {},
+		"GetPersonalAccessTokenMetadata":        {},
+		"ListAccessControlRules":                {},
+		"ListAliases":                           {},
+		"ListAvailabilityConfigurations":        {},
+		"ListGroupMembers":                      {},
+		"ListGroups":                            {},
+		"ListGroupsForEntity":                   {},
+		"ListImpersonationRoles":                {},
+		"ListMailDomains":                       {},
+		"ListMailboxExportJobs":                 {},
+		"ListMailboxPermissions":                {},
+		"ListMobileDeviceAccessOverrides":       {},
+		"ListMobileDeviceAccessRules":           {},
+		"ListOrganizations":                     {},
+		"ListPersonalAccessTokens":              {},
+		"ListResourceDelegates":                 {},
+		"ListResources":                         {},
+		"ListTagsForResource":                   {},
+		"ListUsers":                             {},
+	},
+	names.WorkSpaces: {
+		"DescribeAccount":                    {},
+		"DescribeAccountModifications":       {},
+		"DescribeApplicationAssociations":    {},
+		"DescribeApplications":               {},
+		"DescribeBundleAssociations":         {},
+		"DescribeClientBranding":             {},
+		"DescribeClientProperties":           {},
+		"DescribeConnectClientAddIns":        {},
+		"DescribeConnectionAliasPermissions": {},
+		"DescribeConnectionAliases":          {},
+		"DescribeCustomWorkspaceImageImport": {},
+		"DescribeImageAssociations":          {},
+		"DescribeIpGroups":                   {},
+		"DescribeTags":                       {},
+		"DescribeWorkspaceAssociations":      {},
+		"DescribeWorkspaceBundles":           {},
+		"DescribeWorkspaceDirectories":       {},
+		"DescribeWorkspaceImagePermissions":  {},
+		"DescribeWorkspaceImages":            {},
+		"DescribeWorkspaceSnapshots":         {},
+		"DescribeWorkspaces":                 {},
+		"DescribeWorkspacesConnectionStatus": {},
+		"DescribeWorkspacesPoolSessions":     {},
+		"DescribeWorkspacesPools":            {},
+		"GetAccountLink":                     {},
+		"ListAccountLinks":                   {},
+		"ListAvailableManagementCidrRanges":  {},
+	},
+	names.WorkSpacesWeb: {
+		"GetBrowserSettings":               {},
+		"GetDataProtectionSettings":        {},
+		"GetIdentityProvider":              {},
+		"GetIpAccessSettings":              {},
+		"GetNetworkSettings":               {},
+		"GetPortal":                        {},
+		"GetPortalServiceProviderMetadata": {},
+		"GetSession":                       {},
+		"GetSessionLogger":                 {},
+		"GetTrustStore":                    {},
+		"GetTrustStoreCertificate":         {},
+		"GetUserAccessLoggingSettings":     {},
+		"GetUserSettings":                  {},
+		"ListBrowserSettings":              {},
+		"ListDataProtectionSettings":       {},
+		"ListIdentityProviders":            {},
+		"ListIpAccessSettings":             {},
+		"ListNetworkSettings":              {},
+		"ListPortals":                      {},
+		"ListSessionLoggers":               {},
+		"ListSessions":                     {},
+		"ListTagsForResource":              {},
+		"ListTrustStoreCertificates":       {},
+		"ListTrustStores":                  {},
+		"ListUserAccessLoggingSettings":    {},
+		"ListUserSettings":                 {},
+	},
+	names.XRay: {
+		"BatchGetTraces":                 {},
+		"GetEncryptionConfig":            {},
+		"GetGroup":                       {},
+		"GetGroups":                      {},
+		"GetIndexingRules":               {},
+		"GetInsight":                     {},
+		"GetInsightEvents":               {},
+		"GetInsightImpactGraph":          {},
+		"GetInsightSummaries":            {},
+		"GetRetrievedTracesGraph":        {},
+		"GetSamplingRules":               {},
+		"GetSamplingStatisticSummaries":  {},
+		"GetSamplingTargets":             {},
+		"GetServiceGraph":                {},
+		"GetTimeSeriesServiceStatistics": {},
+		"GetTraceGraph":                  {},
+		"GetTraceSegmentDestination":     {},
+		"GetTraceSummaries":              {},
+		"ListResourcePolicies":           {},
+		"ListRetrievedTraces":            {},
+		"ListTagsForResource":            {},
+	},
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestReadOnlyModeMiddleware(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		enabled   bool
+		operation string
+		wantError bool
+	}{
+		"disabled": {
+			operation: "RunInstances",
+		},
+		"read-only operation": {
+			enabled:   true,
+			operation: "DescribeInstances",
+		},
+		"mutating operation": {
+			enabled:   true,
+			operation: "RunInstances",
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := WithTypeName(NewResourceContext(t.Context(), names.EC2, "Instance", ""), "aws_instance")
+
+			stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+			if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+				ServiceID:     "EC2",
+				OperationName: testCase.operation,
+			}, middleware.Before); err != nil {
+				t.Fatalf("adding middleware: %s", err)
+			}
+			for _, f := range readOnlyModeAPIOptions(names.EC2, testCase.enabled) {
+				if err := f(stack); err != nil {
+					t.Fatalf("adding middleware: %s", err)
+				}
+			}
+
+			var called bool
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input any) (any, middleware.Metadata, error) {
+				called = true
+				return &smithyhttp.Response{}, middleware.Metadata{}, nil
+			}), stack)
+
+			_, _, err := handler.Handle(ctx, struct{}{})
+
+			if testCase.wantError {
+				v, ok := errs.As[*ReadOnlyModeError](err)
+				if !ok {
+					t.Fatalf("expected ReadOnlyModeError, got %v", err)
+				}
+				if got, expected := v.Error(), "read-only mode: AWS API operation EC2:RunInstances is not on the read-only allowlist (called by aws_instance)"; got != expected {
+					t.Errorf("got %q, expected %q", got, expected)
+				}
+				if called {
+					t.Errorf("API operation called")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if !called {
+					t.Errorf("API operation not called")
+				}
+			}
+		})
+	}
+}
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		servicePackageName string
+		operation          string
+		expected           bool
+	}{
+		{names.CognitoIdentity, "DescribeIdentityPool", true},
+		{names.CognitoIdentity, "GetId", false},
+		{names.CognitoIdentity, "GetOpenIdTokenForDeveloperIdentity", false},
+		{names.CognitoIDP, "DescribeUserPool", true},
+		{names.CognitoIDP, "VerifySoftwareToken", false},
+		{names.CognitoIDP, "VerifyUserAttribute", false},
+		{names.KMS, "Verify", true},
+		{names.SES, "GetIdentityVerificationAttributes", true},
+		{names.SES, "VerifyDomainDkim", false},
+		{names.SES, "VerifyDomainIdentity", false},
+		{names.SES, "VerifyEmailIdentity", false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.servicePackageName+":"+testCase.operation, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := isReadOnlyOperation(testCase.servicePackageName, testCase.operation), testCase.expected; got != expected {
+				t.Errorf("got %t, expected %t", got, expected)
+			}
+		})
+	}
+}
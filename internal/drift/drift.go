@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package drift explains changes made to resources outside of Terraform.
+package drift
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+const (
+	// Environment variable enabling drift explanation diagnostics
+	//
+	// Any value accepted by strconv.ParseBool can be used. A true value takes
+	// precedence over the `explain_drift` provider configuration.
+	ExplainDriftEnvVar = "TF_AWS_EXPLAIN_DRIFT"
+)
+
+// metadataAttributePrefixes are the prefixes of top-level attribute names that
+// describe when, or by whom, a resource was last modified.
+var metadataAttributePrefixes = []string{
+	"last_changed",
+	"last_modified",
+	"last_update",
+}
+
+// metadataAttributeNames are top-level attribute names that describe when a
+// resource was last modified.
+var metadataAttributeNames = []string{
+	"modified_at",
+	"modified_date",
+	"update_date",
+	"updated_at",
+	"updated_date",
+}
+
+// IsMetadataAttribute returns whether the specified top-level attribute
+// records modification metadata, e.g. `last_modified_date`.
+func IsMetadataAttribute(name string) bool {
+	return slices.Contains(metadataAttributeNames, name) || slices.ContainsFunc(metadataAttributePrefixes, func(prefix string) bool {
+		return strings.HasPrefix(name, prefix)
+	})
+}
+
+// Explanation describes the top-level attributes of a resource that changed
+// outside of Terraform during a refresh.
+type Explanation struct {
+	attributes map[string]struct{}
+	metadata   map[string]string
+}
+
+// NewExplanation returns a new, empty, Explanation.
+func NewExplanation() *Explanation {
+	return &Explanation{
+		attributes: make(map[string]struct{}),
+		metadata:   make(map[string]string),
+	}
+}
+
+// AddChange records that the specified top-level attribute changed.
+// Modification metadata attributes are expected to change with any drift and are not recorded.
+func (e *Explanation) AddChange(name string) {
+	if IsMetadataAttribute(name) {
+		return
+	}
+
+	e.attributes[name] = struct{}{}
+}
+
+// AddMetadata records the refreshed value of a modification metadata attribute.
+func (e *Explanation) AddMetadata(name, value string) {
+	if value == "" {
+		return
+	}
+
+	e.metadata[name] = value
+}
+
+// HasChanges returns whether any attributes changed.
+func (e *Explanation) HasChanges() bool {
+	return len(e.attributes) > 0
+}
+
+// Attributes returns the sorted names of the changed top-level attributes.
+func (e *Explanation) Attributes() []string {
+	return slices.Sorted(maps.Keys(e.attributes))
+}
+
+// Summary returns a diagnostic summary for the specified resource type.
+func (e *Explanation) Summary(typeName string) string {
+	if typeName == "" {
+		return "Resource changed outside of Terraform"
+	}
+
+	return fmt.Sprintf("%s changed outside of Terraform", typeName)
+}
+
+// Detail returns a diagnostic detail listing the changed attributes and any modification metadata.
+func (e *Explanation) Detail(id string) string {
+	var sb strings.Builder
+
+	if id != "" {
+		fmt.Fprintf(&sb, "Refreshing %q found ", id)
+	} else {
+		sb.WriteString("Refreshing found ")
+	}
+	fmt.Fprintf(&sb, "that the following attributes differ from their values in prior state: %s.", strings.Join(e.Attributes(), ", "))
+
+	if len(e.metadata) > 0 {
+		sb.WriteString("\n\nModification metadata reported by AWS:")
+		for _, k := range slices.Sorted(maps.Keys(e.metadata)) {
+			fmt.Fprintf(&sb, "\n  %s = %s", k, e.metadata[k])
+		}
+	}
+
+	return sb.String()
+}
+
+// ExplainFlatmap returns an Explanation of the differences between prior and refreshed
+// Plugin SDK V2 flatmap state attributes.
+func ExplainFlatmap(prior, refreshed map[string]string) *Explanation {
+	e := NewExplanation()
+
+	for k := range keys(prior, refreshed) {
+		v1, ok1 := prior[k]
+		v2, ok2 := refreshed[k]
+		if ok1 == ok2 && v1 == v2 {
+			continue
+		}
+
+		e.AddChange(topLevelName(k))
+	}
+
+	for k, v := range refreshed {
+		if !strings.Contains(k, ".") && IsMetadataAttribute(k) {
+			e.AddMetadata(k, v)
+		}
+	}
+
+	return e
+}
+
+// keys returns the union of the keys of the specified maps.
+func keys(m1, m2 map[string]string) map[string]struct{} {
+	s := make(map[string]struct{}, len(m1))
+	for k := range m1 {
+		s[k] = struct{}{}
+	}
+	for k := range m2 {
+		s[k] = struct{}{}
+	}
+
+	return s
+}
+
+// topLevelName returns the top-level attribute name of a flatmap key, e.g. `tags` for `tags.%`.
+func topLevelName(k string) string {
+	name, _, _ := strings.Cut(k, ".")
+	return name
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drift
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIsMetadataAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"last_modified":      true,
+		"last_modified_by":   true,
+		"last_modified_date": true,
+		"last_updated":       true,
+		"last_update_date":   true,
+		"updated_at":         true,
+		"arn":                false,
+		"name":               false,
+		"updated":            false,
+	}
+
+	for name, expected := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsMetadataAttribute(name); got != expected {
+				t.Errorf("got %t, expected %t", got, expected)
+			}
+		})
+	}
+}
+
+func TestExplainFlatmap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prior, refreshed map[string]string
+		wantAttributes   []string
+		wantDetail       string
+	}{
+		"no changes": {
+			prior: map[string]string{
+				"id":     "abc",
+				"name":   "test",
+				"tags.%": "0",
+			},
+			refreshed: map[string]string{
+				"id":     "abc",
+				"name":   "test",
+				"tags.%": "0",
+			},
+		},
+		"scalar and nested changes": {
+			prior: map[string]string{
+				"id":                 "abc",
+				"description":        "a",
+				"tags.%":             "1",
+				"tags.Name":          "test",
+				"rule.#":             "1",
+				"rule.0.port":        "80",
+				"last_modified_date": "2025-01-01T00:00:00Z",
+			},
+			refreshed: map[string]string{
+				"id":                 "abc",
+				"description":        "b",
+				"tags.%":             "1",
+				"tags.Name":          "test",
+				"rule.#":             "1",
+				"rule.0.port":        "443",
+				"last_modified_date": "2025-06-01T00:00:00Z",
+			},
+			wantAttributes: []string{"description", "rule"},
+			wantDetail: `Refreshing "abc" found that the following attributes differ from their values in prior state: description, rule.
+
+Modification metadata reported by AWS:
+  last_modified_date = 2025-06-01T00:00:00Z`,
+		},
+		"added and removed keys": {
+			prior: map[string]string{
+				"id":        "abc",
+				"tags.%":    "1",
+				"tags.Name": "test",
+			},
+			refreshed: map[string]string{
+				"id":      "abc",
+				"tags.%":  "0",
+				"comment": "new",
+			},
+			wantAttributes: []string{"comment", "tags"},
+			wantDetail:     `Refreshing "abc" found that the following attributes differ from their values in prior state: comment, tags.`,
+		},
+		"only metadata changes": {
+			prior: map[string]string{
+				"id":           "abc",
+				"last_updated": "1",
+			},
+			refreshed: map[string]string{
+				"id":           "abc",
+				"last_updated": "2",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			e := ExplainFlatmap(testCase.prior, testCase.refreshed)
+
+			if diff := cmp.Diff(e.Attributes(), testCase.wantAttributes); diff != "" {
+				t.Errorf("unexpected attributes diff (+wanted, -got): %s", diff)
+			}
+			if got, expected := e.HasChanges(), len(testCase.wantAttributes) > 0; got != expected {
+				t.Errorf("HasChanges: got %t, expected %t", got, expected)
+			}
+			if e.HasChanges() {
+				if got, expected := e.Detail(testCase.prior["id"]), testCase.wantDetail; got != expected {
+					t.Errorf("Detail: got %q, expected %q", got, expected)
+				}
+			}
+		})
+	}
+}
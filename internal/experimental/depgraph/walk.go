@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package depgraph
+
+import (
+	"context"
+	"fmt"
+)
+
+// WalkFunc is the function called by Walk for each node in the graph.
+type WalkFunc func(ctx context.Context, node string) error
+
+// WalkOptions configures a graph walk.
+type WalkOptions struct {
+	// Concurrency is the maximum number of nodes processed at once.
+	// A value less than 1 means no limit.
+	Concurrency int
+	// ContinueOnError causes nodes to be processed even if one of their dependencies failed.
+	ContinueOnError bool
+}
+
+// DependencyError is the result for a node that was not processed because one of its dependencies failed.
+type DependencyError struct {
+	Node       string
+	Dependency string
+	Err        error
+}
+
+func (e *DependencyError) Error() string {
+	return fmt.Sprintf("%s: dependency %s failed: %s", e.Node, e.Dependency, e.Err)
+}
+
+func (e *DependencyError) Unwrap() error {
+	return e.Err
+}
+
+// Walk calls f concurrently for each node in the graph.
+// f is only called for a node once it has returned for all of the node's dependencies.
+// If f returns an error for a node then, unless WalkOptions.ContinueOnError is set, f is not called for any node that depends on it
+// and the result for those nodes is a *DependencyError.
+// Once ctx is done, f is not called for any further nodes and the result for those nodes is the context's error.
+// Returns the result of each node, or an error if a dependency cycle is detected.
+func (g *Graph) Walk(ctx context.Context, f WalkFunc, optFns ...func(*WalkOptions)) (map[string]error, error) {
+	if _, err := g.OverallOrder(); err != nil {
+		return nil, err
+	}
+
+	var opts WalkOptions
+	for _, fn := range optFns {
+		fn(&opts)
+	}
+
+	type walkResult struct {
+		node string
+		err  error
+	}
+
+	results := make(map[string]error, g.Len())
+	remaining := make(map[string]int, g.Len()) // Number of unprocessed dependencies of each node.
+	ready := make([]string, 0)
+
+	for _, node := range g.nodes {
+		remaining[node] = len(g.outgoingEdges[node])
+		if remaining[node] == 0 {
+			ready = append(ready, node)
+		}
+	}
+
+	complete := func(node string, err error) {
+		results[node] = err
+
+		for _, dependent := range g.incomingEdges[node] {
+			remaining[dependent]--
+			if remaining[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	done := make(chan walkResult)
+	running := 0
+
+	for len(ready) > 0 || running > 0 {
+		for len(ready) > 0 && (opts.Concurrency < 1 || running < opts.Concurrency) {
+			node := ready[0]
+			ready = ready[1:]
+
+			if err := ctx.Err(); err != nil {
+				complete(node, err)
+				continue
+			}
+
+			if !opts.ContinueOnError {
+				if err := g.failedDependency(node, results); err != nil {
+					complete(node, err)
+					continue
+				}
+			}
+
+			running++
+			go func() {
+				done <- walkResult{node: node, err: f(ctx, node)}
+			}()
+		}
+
+		if running > 0 {
+			result := <-done
+			running--
+			complete(result.node, result.err)
+		}
+	}
+
+	return results, nil
+}
+
+// failedDependency returns a *DependencyError for the first of the specified node's direct dependencies that failed, if any.
+func (g *Graph) failedDependency(node string, results map[string]error) error {
+	for _, dependency := range g.outgoingEdges[node] {
+		if err := results[dependency]; err != nil {
+			return &DependencyError{
+				Node:       node,
+				Dependency: dependency,
+				Err:        err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// WithConcurrency is a helper to set WalkOptions.Concurrency.
+func WithConcurrency(n int) func(*WalkOptions) {
+	return func(o *WalkOptions) {
+		o.Concurrency = n
+	}
+}
+
+// WithContinueOnError is a helper to set WalkOptions.ContinueOnError.
+func WithContinueOnError(b bool) func(*WalkOptions) {
+	return func(o *WalkOptions) {
+		o.ContinueOnError = b
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package depgraph
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDependencyGraphWalk(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	g := New()
+	g.AddNode("a")
+	g.AddNode("b")
+	g.AddNode("c")
+	g.AddNode("d")
+	g.AddDependency("a", "b")
+	g.AddDependency("a", "c")
+	g.AddDependency("b", "d")
+	g.AddDependency("c", "d")
+
+	var mu sync.Mutex
+	order := make([]string, 0)
+
+	results, err := g.Walk(ctx, func(_ context.Context, node string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		order = append(order, node)
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(results), 4; got != expected {
+		t.Fatalf("incorrect number of results. Expected: %d, got: %d", expected, got)
+	}
+	for node, err := range results {
+		if err != nil {
+			t.Errorf("unexpected error for %s: %s", node, err)
+		}
+	}
+
+	index := func(s string) int { return slices.Index(order, s) }
+	for _, edge := range [][2]string{{"a", "b"}, {"a", "c"}, {"b", "d"}, {"c", "d"}} {
+		if index(edge[0]) < index(edge[1]) {
+			t.Errorf("%s processed before its dependency %s: %v", edge[0], edge[1], order)
+		}
+	}
+}
+
+func TestDependencyGraphWalkConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	g := New()
+	for _, node := range []string{"a", "b", "c", "d", "e", "f"} {
+		g.AddNode(node)
+	}
+
+	var current, maxSeen atomic.Int32
+
+	_, err := g.Walk(ctx, func(_ context.Context, node string) error {
+		n := current.Add(1)
+		defer current.Add(-1)
+		for {
+			m := maxSeen.Load()
+			if n <= m || maxSeen.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	}, WithConcurrency(2))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := maxSeen.Load(), int32(2); got != expected {
+		t.Fatalf("incorrect maximum concurrency. Expected: %d, got: %d", expected, got)
+	}
+}
+
+func TestDependencyGraphWalkError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	errFailed := errors.New("failed")
+
+	newGraph := func() *Graph {
+		g := New()
+		g.AddNode("a")
+		g.AddNode("b")
+		g.AddNode("c")
+		g.AddNode("d")
+		g.AddDependency("a", "b")
+		g.AddDependency("b", "c")
+		return g
+	}
+	f := func(_ context.Context, node string) error {
+		if node == "c" {
+			return errFailed
+		}
+		return nil
+	}
+
+	results, err := newGraph().Walk(ctx, f)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := results["c"]; !errors.Is(got, errFailed) {
+		t.Errorf("incorrect result for c: %v", got)
+	}
+	for _, node := range []string{"a", "b"} {
+		var dependencyErr *DependencyError
+		if got := results[node]; !errors.As(got, &dependencyErr) || !errors.Is(got, errFailed) {
+			t.Errorf("incorrect result for %s: %v", node, got)
+		}
+	}
+	if got := results["d"]; got != nil {
+		t.Errorf("incorrect result for d: %v", got)
+	}
+
+	results, err = newGraph().Walk(ctx, f, WithContinueOnError(true))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, node := range []string{"a", "b", "d"} {
+		if got := results[node]; got != nil {
+			t.Errorf("incorrect result for %s: %v", node, got)
+		}
+	}
+}
+
+func TestDependencyGraphWalkCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	g := New()
+	g.AddNode("a")
+	g.AddNode("b")
+	g.AddDependency("a", "b")
+
+	results, err := g.Walk(ctx, func(_ context.Context, node string) error {
+		cancel()
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := results["b"]; got != nil {
+		t.Errorf("incorrect result for b: %v", got)
+	}
+	if got := results["a"]; !errors.Is(got, context.Canceled) {
+		t.Errorf("incorrect result for a: %v", got)
+	}
+}
+
+func TestDependencyGraphWalkCycle(t *testing.T) {
+	t.Parallel()
+
+	g := New()
+	g.AddNode("a")
+	g.AddNode("b")
+	g.AddDependency("a", "b")
+	g.AddDependency("b", "a")
+
+	if _, err := g.Walk(context.Background(), func(context.Context, string) error { return nil }); err == nil {
+		t.Fatalf("expected error")
+	}
+}
@@ -19,6 +19,9 @@ import (
 
 const (
 	mapBlockKeyFieldName = "MapBlockKey"
+
+	// unionMemberValueFieldName is the name of the field holding an AWS API union member's value.
+	unionMemberValueFieldName = "Value"
 )
 
 // Expand  = TF -->  AWS
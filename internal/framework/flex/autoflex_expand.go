@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"iter"
 	"reflect"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -1182,6 +1183,13 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	}
 
 	if valTo.Kind() == reflect.Interface {
+		opts := flexer.getOptions()
+		if tMembers, ok := opts.unionMembers(valTo.Type()); ok {
+			tflog.SubsystemInfo(ctx, subsystemName, "Target is a union")
+			diags.Append(expandUnion(ctx, sourcePath, valFrom, targetPath, valTo, tMembers, flexer)...)
+			return diags
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
@@ -1233,6 +1241,98 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	return diags
 }
 
+// expandUnion copies the single set field of struct `valFrom` to the corresponding member of AWS API union `valTo`.
+func expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, tMembers []reflect.Type, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tUnion := valTo.Type()
+	var memberNames []string
+
+	for _, tMember := range tMembers {
+		memberName := unionMemberName(tUnion, tMember)
+
+		fromField, ok := unionMemberSourceField(ctx, valFrom.Type(), memberName, flexer.getOptions())
+		if !ok {
+			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
+				logAttrKeyTargetFieldname: memberName,
+			})
+			continue
+		}
+		fromFieldVal := valFrom.FieldByIndex(fromField.Index)
+
+		if !isUnionMemberSet(fromFieldVal) {
+			continue
+		}
+
+		memberNames = append(memberNames, fromField.Name)
+		if len(memberNames) > 1 {
+			tflog.SubsystemError(ctx, subsystemName, "Multiple union members set", map[string]any{
+				"members": memberNames,
+			})
+			diags.Append(diagExpandingMultipleUnionMembers(valFrom.Type(), tUnion, memberNames))
+			return diags
+		}
+
+		if tMember.Kind() != reflect.Pointer || tMember.Elem().Kind() != reflect.Struct {
+			diags.Append(diagExpandingIncompatibleTypes(valFrom.Type(), tMember))
+			return diags
+		}
+
+		to := reflect.New(tMember.Elem())
+		toFieldVal := to.Elem().FieldByName(unionMemberValueFieldName)
+		if !toFieldVal.IsValid() || !toFieldVal.CanSet() {
+			tflog.SubsystemError(ctx, subsystemName, "Union member has no settable Value field", map[string]any{
+				logAttrKeyTargetType: fullTypeName(tMember),
+			})
+			diags.Append(diagExpandingIncompatibleTypes(valFrom.Type(), tMember))
+			return diags
+		}
+
+		tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+			logAttrKeySourceFieldname: fromField.Name,
+			logAttrKeyTargetType:      fullTypeName(tMember),
+		})
+
+		diags.Append(flexer.convert(ctx, sourcePath.AtName(fromField.Name), fromFieldVal, targetPath.AtName(unionMemberValueFieldName), toFieldVal, fieldOpts{})...)
+		if diags.HasError() {
+			return diags
+		}
+
+		valTo.Set(to)
+	}
+
+	if len(memberNames) == 0 {
+		tflog.SubsystemTrace(ctx, subsystemName, "No union member set")
+	}
+
+	return diags
+}
+
+// unionMemberSourceField returns the field of struct type `typ` corresponding to the named union member.
+func unionMemberSourceField(ctx context.Context, typ reflect.Type, memberName string, opts AutoFlexOptions) (reflect.StructField, bool) {
+	for field := range expandSourceFields(ctx, typ, opts) {
+		if strings.EqualFold(field.Name, memberName) {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+// isUnionMemberSet returns whether the specified Plugin Framework value represents a configured union member.
+func isUnionMemberSet(val reflect.Value) bool {
+	v, ok := val.Interface().(attr.Value)
+	if !ok || v.IsNull() || v.IsUnknown() {
+		return false
+	}
+
+	if v, ok := v.(interface{ Elements() []attr.Value }); ok {
+		return len(v.Elements()) > 0
+	}
+
+	return true
+}
+
 func expandSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOptions) iter.Seq[reflect.StructField] {
 	return func(yield func(reflect.StructField) bool) {
 		for field := range tfreflect.ExportedStructFields(typ) {
@@ -1462,6 +1562,16 @@ func diagExpandingIncompatibleTypes(sourceType, targetType reflect.Type) diag.Er
 	)
 }
 
+func diagExpandingMultipleUnionMembers(sourceType, unionType reflect.Type, memberNames []string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Source type %q sets multiple members (%s) of union type %q.", fullTypeName(sourceType), strings.Join(memberNames, ", "), fullTypeName(unionType)),
+	)
+}
+
 // xmlWrapper handles expansion from TF collection types to AWS XML wrapper structs
 // that follow the pattern: {Items: []T, Quantity: *int32}
 func (expander autoExpander) xmlWrapper(ctx context.Context, vFrom valueWithElementsAs, vTo reflect.Value, wrapperField string) diag.Diagnostics {
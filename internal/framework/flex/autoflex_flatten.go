@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"iter"
 	"reflect"
+	"slices"
 	"strings"
 	"time"
 
@@ -193,7 +194,7 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo)...)
+		diags.Append(flattener.interface_(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -612,7 +613,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 	return diags
 }
 
-func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interface_(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -653,7 +654,7 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattener.interfaceToNestedObject(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
+		diags.Append(flattener.interfaceToNestedObject(ctx, sourcePath, vFrom, vFrom.IsNil(), targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -1215,7 +1216,7 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, sourceP
 }
 
 // interfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -1237,6 +1238,31 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 
 	toFlattener, ok := to.(Flattener)
 	if !ok {
+		opts := flattener.getOptions()
+		if tMembers, ok := opts.unionMembers(vFrom.Type()); ok {
+			tflog.SubsystemInfo(ctx, subsystemName, "Source is a union")
+
+			diags.Append(flattenPrePopulate(ctx, reflect.ValueOf(to))...)
+			if diags.HasError() {
+				return diags
+			}
+
+			diags.Append(flattenUnion(ctx, sourcePath, vFrom, targetPath, to, tMembers, flattener)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			// Set the target structure as a mapped Object.
+			val, d := tTo.ValueFromObjectPtr(ctx, to)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(val))
+			return diags
+		}
+
 		val, d := tTo.NullValue(ctx)
 		diags.Append(d...)
 		if diags.HasError() {
@@ -1277,6 +1303,63 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 	return diags
 }
 
+// flattenUnion copies the member of AWS API union `vFrom` to the corresponding field of struct `to`.
+func flattenUnion(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, to any, tMembers []reflect.Type, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vFrom.IsNil() {
+		tflog.SubsystemTrace(ctx, subsystemName, "Flattening null union")
+		return diags
+	}
+
+	tUnion := vFrom.Type()
+	vMember := vFrom.Elem()
+	tMember := vMember.Type()
+	ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeySourceType, fullTypeName(tMember))
+
+	if !slices.Contains(tMembers, tMember) {
+		tflog.SubsystemWarn(ctx, subsystemName, "Unknown union member")
+		return diags
+	}
+
+	vValue := reflect.Indirect(vMember).FieldByName(unionMemberValueFieldName)
+	if !vValue.IsValid() {
+		tflog.SubsystemError(ctx, subsystemName, "Union member has no Value field")
+		diags.Append(DiagFlatteningIncompatibleTypes(tMember, reflect.TypeOf(to)))
+		return diags
+	}
+
+	valTo := reflect.ValueOf(to).Elem()
+	memberName := unionMemberName(tUnion, tMember)
+
+	for toField := range tfreflect.ExportedStructFields(valTo.Type()) {
+		if toNameOverride, _ := autoflexTags(toField); toNameOverride == "-" || !strings.EqualFold(toField.Name, memberName) {
+			continue
+		}
+
+		toFieldVal := valTo.FieldByIndex(toField.Index)
+		if !toFieldVal.CanSet() {
+			tflog.SubsystemDebug(ctx, subsystemName, "Field cannot be set", map[string]any{
+				logAttrKeyTargetFieldname: toField.Name,
+			})
+			return diags
+		}
+
+		tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+			logAttrKeyTargetFieldname: toField.Name,
+		})
+
+		diags.Append(flexer.convert(ctx, sourcePath.AtName(unionMemberValueFieldName), vValue, targetPath.AtName(toField.Name), toFieldVal, fieldOpts{})...)
+		return diags
+	}
+
+	tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
+		logAttrKeySourceFieldname: memberName,
+	})
+
+	return diags
+}
+
 // sliceOfPrimtiveToList copies an AWS API slice of primitive (or pointer to primitive) value to a compatible Plugin Framework List value.
 func (flattener autoFlattener) sliceOfPrimtiveToList(ctx context.Context, vFrom reflect.Value, tTo basetypes.ListTypable, vTo reflect.Value, elementType attr.Type, attrValueFromReflectValue attrValueFromReflectValueFunc, fieldOpts fieldOpts) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -1456,6 +1539,9 @@ func (flattener autoFlattener) sliceOfStructToNestedObjectCollection(ctx context
 		return diags
 	}
 
+	opts := flattener.getOptions()
+	tMembers, isUnion := opts.unionMembers(vFrom.Type().Elem())
+
 	t := reflect.ValueOf(to)
 	for i := range n {
 		sourcePath := sourcePath.AtListIndex(i)
@@ -1468,7 +1554,16 @@ func (flattener autoFlattener) sliceOfStructToNestedObjectCollection(ctx context
 			return diags
 		}
 
-		diags.Append(flattenStruct(ctx, sourcePath, vFrom.Index(i).Interface(), targetPath, target, flattener)...)
+		if isUnion {
+			diags.Append(flattenPrePopulate(ctx, reflect.ValueOf(target))...)
+			if diags.HasError() {
+				return diags
+			}
+
+			diags.Append(flattenUnion(ctx, sourcePath, vFrom.Index(i), targetPath, target, tMembers, flattener)...)
+		} else {
+			diags.Append(flattenStruct(ctx, sourcePath, vFrom.Index(i).Interface(), targetPath, target, flattener)...)
+		}
 		if diags.HasError() {
 			return diags
 		}
@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+// Tests AutoFlex's Expand/Flatten of AWS API union types, where each union
+// member is represented by a Terraform nested block or attribute.
+//
+// This test file uses golden snapshots for log verification. These can be found in
+// testdata/autoflex/union/*.golden
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// awsUnion mirrors the shape of AWS SDK for Go v2 union interfaces.
+type awsUnion interface {
+	isAWSUnion()
+}
+
+type awsUnionMemberStatic struct {
+	Value awsUnionStatic
+}
+
+func (*awsUnionMemberStatic) isAWSUnion() {}
+
+type awsUnionMemberTemplateLinked struct {
+	Value string
+}
+
+func (*awsUnionMemberTemplateLinked) isAWSUnion() {}
+
+type awsUnionMemberUnregistered struct {
+	Value string
+}
+
+func (*awsUnionMemberUnregistered) isAWSUnion() {}
+
+type awsUnionStatic struct {
+	Description *string
+	Statement   *string
+}
+
+type awsUnionSingle struct {
+	Definition awsUnion
+}
+
+type awsUnionSlice struct {
+	Definitions []awsUnion
+}
+
+type tfUnionStatic struct {
+	Description types.String `tfsdk:"description"`
+	Statement   types.String `tfsdk:"statement"`
+}
+
+type tfUnion struct {
+	Static         fwtypes.ListNestedObjectValueOf[tfUnionStatic] `tfsdk:"static"`
+	TemplateLinked types.String                                   `tfsdk:"template_linked"`
+}
+
+type tfUnionSingle struct {
+	Definition fwtypes.ListNestedObjectValueOf[tfUnion] `tfsdk:"definition"`
+}
+
+type tfUnionSlice struct {
+	Definitions fwtypes.ListNestedObjectValueOf[tfUnion] `tfsdk:"definitions"`
+}
+
+func withTestUnion() AutoFlexOptionsFunc {
+	return WithUnion[awsUnion](&awsUnionMemberStatic{}, &awsUnionMemberTemplateLinked{})
+}
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"struct member": {
+			Options: []AutoFlexOptionsFunc{withTestUnion()},
+			Source: &tfUnionSingle{
+				Definition: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Static: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionStatic{
+						Description: types.StringValue("a"),
+						Statement:   types.StringValue("b"),
+					}),
+					TemplateLinked: types.StringNull(),
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Definition: &awsUnionMemberStatic{
+					Value: awsUnionStatic{
+						Description: aws.String("a"),
+						Statement:   aws.String("b"),
+					},
+				},
+			},
+		},
+		"string member": {
+			Options: []AutoFlexOptionsFunc{withTestUnion()},
+			Source: &tfUnionSingle{
+				Definition: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Static:         fwtypes.NewListNestedObjectValueOfNull[tfUnionStatic](ctx),
+					TemplateLinked: types.StringValue("c"),
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Definition: &awsUnionMemberTemplateLinked{
+					Value: "c",
+				},
+			},
+		},
+		"no member set": {
+			Options: []AutoFlexOptionsFunc{withTestUnion()},
+			Source: &tfUnionSingle{
+				Definition: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Static:         fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnionStatic{}),
+					TemplateLinked: types.StringNull(),
+				}),
+			},
+			Target:     &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{},
+		},
+		"multiple members set": {
+			Options: []AutoFlexOptionsFunc{withTestUnion()},
+			Source: &tfUnionSingle{
+				Definition: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Static: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionStatic{
+						Statement: types.StringValue("b"),
+					}),
+					TemplateLinked: types.StringValue("c"),
+				}),
+			},
+			Target: &awsUnionSingle{},
+			ExpectedDiags: diag.Diagnostics{
+				diagExpandingMultipleUnionMembers(reflect.TypeFor[tfUnion](), reflect.TypeFor[awsUnion](), []string{"Static", "TemplateLinked"}),
+			},
+		},
+		"slice of unions": {
+			Options: []AutoFlexOptionsFunc{withTestUnion()},
+			Source: &tfUnionSlice{
+				Definitions: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Static:         fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionStatic{Statement: types.StringValue("b")}),
+						TemplateLinked: types.StringNull(),
+					},
+					{
+						Static:         fwtypes.NewListNestedObjectValueOfNull[tfUnionStatic](ctx),
+						TemplateLinked: types.StringValue("c"),
+					},
+				}),
+			},
+			Target: &awsUnionSlice{},
+			WantTarget: &awsUnionSlice{
+				Definitions: []awsUnion{
+					&awsUnionMemberStatic{Value: awsUnionStatic{Statement: aws.String("b")}},
+					&awsUnionMemberTemplateLinked{Value: "c"},
+				},
+			},
+		},
+		"union not registered": {
+			Source: &tfUnionSingle{
+				Definition: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Static:         fwtypes.NewListNestedObjectValueOfNull[tfUnionStatic](ctx),
+					TemplateLinked: types.StringValue("c"),
+				}),
+			},
+			Target:     &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases, runChecks{CompareDiags: true, CompareTarget: true, GoldenLogs: true})
+}
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"struct member": {
+			Options: []AutoFlexOptionsFunc{withTestUnion()},
+			Source: &awsUnionSingle{
+				Definition: &awsUnionMemberStatic{
+					Value: awsUnionStatic{
+						Description: aws.String("a"),
+						Statement:   aws.String("b"),
+					},
+				},
+			},
+			Target: &tfUnionSingle{},
+			WantTarget: &tfUnionSingle{
+				Definition: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Static: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionStatic{
+						Description: types.StringValue("a"),
+						Statement:   types.StringValue("b"),
+					}),
+					TemplateLinked: types.StringNull(),
+				}),
+			},
+		},
+		"string member": {
+			Options: []AutoFlexOptionsFunc{withTestUnion()},
+			Source: &awsUnionSingle{
+				Definition: &awsUnionMemberTemplateLinked{
+					Value: "c",
+				},
+			},
+			Target: &tfUnionSingle{},
+			WantTarget: &tfUnionSingle{
+				Definition: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Static:         fwtypes.NewListNestedObjectValueOfNull[tfUnionStatic](ctx),
+					TemplateLinked: types.StringValue("c"),
+				}),
+			},
+		},
+		"nil union": {
+			Options: []AutoFlexOptionsFunc{withTestUnion()},
+			Source:  &awsUnionSingle{},
+			Target:  &tfUnionSingle{},
+			WantTarget: &tfUnionSingle{
+				Definition: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+		},
+		"unknown member": {
+			Options: []AutoFlexOptionsFunc{withTestUnion()},
+			Source: &awsUnionSingle{
+				Definition: &awsUnionMemberUnregistered{
+					Value: "d",
+				},
+			},
+			Target: &tfUnionSingle{},
+			WantTarget: &tfUnionSingle{
+				Definition: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					Static:         fwtypes.NewListNestedObjectValueOfNull[tfUnionStatic](ctx),
+					TemplateLinked: types.StringNull(),
+				}),
+			},
+		},
+		"slice of unions": {
+			Options: []AutoFlexOptionsFunc{withTestUnion()},
+			Source: &awsUnionSlice{
+				Definitions: []awsUnion{
+					&awsUnionMemberStatic{Value: awsUnionStatic{Statement: aws.String("b")}},
+					&awsUnionMemberTemplateLinked{Value: "c"},
+				},
+			},
+			Target: &tfUnionSlice{},
+			WantTarget: &tfUnionSlice{
+				Definitions: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Static:         fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionStatic{Description: types.StringNull(), Statement: types.StringValue("b")}),
+						TemplateLinked: types.StringNull(),
+					},
+					{
+						Static:         fwtypes.NewListNestedObjectValueOfNull[tfUnionStatic](ctx),
+						TemplateLinked: types.StringValue("c"),
+					},
+				}),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases, runChecks{CompareDiags: true, CompareTarget: true, GoldenLogs: true})
+}
@@ -11,10 +11,23 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
 )
 
+// FieldChange describes a difference between the plan and state values of a single field
+type FieldChange struct {
+	// FieldName is the name of the field in the Terraform model
+	FieldName string
+	// AWSFieldName is the name of the corresponding field in the AWS API input struct.
+	// Empty if no AWS API input struct was specified or there is no corresponding field
+	AWSFieldName string
+	PlanValue    attr.Value
+	StateValue   attr.Value
+}
+
 type Results struct {
+	changes               []FieldChange
 	hasChanges            bool
 	ignoredFieldNames     []string
 	flexIgnoredFieldNames []AutoFlexOptionsFunc
@@ -25,6 +38,29 @@ func (r *Results) HasChanges() bool {
 	return r.hasChanges
 }
 
+// Changes returns the fields whose plan and state values differ
+func (r *Results) Changes() []FieldChange {
+	return r.changes
+}
+
+// HasChange returns whether the plan and state values of the specified Terraform model field differ
+func (r *Results) HasChange(fieldName string) bool {
+	return slices.ContainsFunc(r.changes, func(c FieldChange) bool {
+		return c.FieldName == fieldName
+	})
+}
+
+// ChangedAWSFieldNames returns the names of the AWS API input struct fields corresponding to changed fields
+func (r *Results) ChangedAWSFieldNames() []string {
+	var fieldNames []string
+	for _, c := range r.changes {
+		if c.AWSFieldName != "" {
+			fieldNames = append(fieldNames, c.AWSFieldName)
+		}
+	}
+	return fieldNames
+}
+
 // IgnoredFieldNamesOpts returns the list of ignored field names as AutoFlexOptionsFunc
 func (r *Results) IgnoredFieldNamesOpts() []AutoFlexOptionsFunc {
 	for _, v := range r.ignoredFieldNames {
@@ -56,6 +92,14 @@ func Diff(ctx context.Context, plan, state any, options ...ChangeOption) (*Resul
 		return &result, diags
 	}
 
+	ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeySourceType, fullTypeName(planType))
+
+	var flexer autoFlexer
+	if opts.AWSInputType != nil {
+		ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeyTargetType, fullTypeName(opts.AWSInputType))
+		flexer = newAutoExpander(opts.AutoFlexOptions)
+	}
+
 	var hasChanges bool
 	for field := range tfreflect.ExportedStructFields(planValue.Type()) {
 		fieldName := field.Name
@@ -82,6 +126,23 @@ func Diff(ctx context.Context, plan, state any, options ...ChangeOption) (*Resul
 
 		if !planFieldValue.Equal(stateFieldValue) {
 			hasChanges = true
+
+			change := FieldChange{
+				FieldName:  fieldName,
+				PlanValue:  planFieldValue,
+				StateValue: stateFieldValue,
+			}
+			if opts.AWSInputType != nil {
+				if awsField, ok := (&fuzzyFieldFinder{}).findField(ctx, fieldName, planType, opts.AWSInputType, flexer); ok {
+					change.AWSFieldName = awsField.Name
+				}
+			}
+			result.changes = append(result.changes, change)
+
+			tflog.SubsystemDebug(ctx, subsystemName, "Field changed", map[string]any{
+				logAttrKeySourceFieldname: change.FieldName,
+				logAttrKeyTargetFieldname: change.AWSFieldName,
+			})
 		} else {
 			ignoredFields = append(ignoredFields, fieldName)
 		}
@@ -3,12 +3,20 @@
 
 package flex
 
+import (
+	"reflect"
+)
+
 // ChangeOption is a type alias for a functional option that modifies ChangeOptions
 type ChangeOption func(*ChangeOptions)
 
 // ChangeOptions holds configuration for calculating plan changes
 type ChangeOptions struct {
 	IgnoredFields []string
+	// AWSInputType is the AWS API input struct type that changed fields are mapped to
+	AWSInputType reflect.Type
+	// AutoFlexOptions are used when mapping changed fields to AWSInputType fields
+	AutoFlexOptions []AutoFlexOptionsFunc
 }
 
 // WithIgnoredField specifies a field name to be ignored when calculating plan changes
@@ -18,6 +26,21 @@ func WithIgnoredField(fieldName string) ChangeOption {
 	}
 }
 
+// WithAWSInput specifies the AWS API input struct that changed fields are mapped to
+//
+// Use this option to build minimal update requests. Field names are matched as
+// by Expand, which can be tuned with optFns, e.g. WithFieldNamePrefix.
+func WithAWSInput(input any, optFns ...AutoFlexOptionsFunc) ChangeOption {
+	return func(o *ChangeOptions) {
+		typ := reflect.TypeOf(input)
+		if typ != nil && typ.Kind() == reflect.Pointer {
+			typ = typ.Elem()
+		}
+		o.AWSInputType = typ
+		o.AutoFlexOptions = optFns
+	}
+}
+
 // NewChangeOptions initializes ChangeOptions with the provided options
 func NewChangeOptions(options ...ChangeOption) *ChangeOptions {
 	opts := &ChangeOptions{
@@ -183,3 +183,83 @@ func TestDiffWithChangeOption(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffChanges(t *testing.T) {
+	t.Parallel()
+
+	type awsUpdateInput struct {
+		Name         *string
+		NumberOfDays *int64
+	}
+
+	testCases := map[string]struct {
+		plan                     any
+		state                    any
+		opts                     []fwflex.ChangeOption
+		expectedChanges          []fwflex.FieldChange
+		expectedAWSFieldNames    []string
+		expectedHasChangedNumber bool
+	}{
+		"no change": {
+			plan:  testResourceData1{Name: types.StringValue("test"), Number: types.Int64Value(1), Age: types.Int64Value(100)},
+			state: testResourceData1{Name: types.StringValue("test"), Number: types.Int64Value(1), Age: types.Int64Value(100)},
+			opts:  []fwflex.ChangeOption{fwflex.WithAWSInput(&awsUpdateInput{})},
+		},
+		"no AWS input": {
+			plan:  testResourceData1{Name: types.StringValue("test"), Number: types.Int64Value(2), Age: types.Int64Value(100)},
+			state: testResourceData1{Name: types.StringValue("test"), Number: types.Int64Value(1), Age: types.Int64Value(100)},
+			expectedChanges: []fwflex.FieldChange{
+				{FieldName: "Number", PlanValue: types.Int64Value(2), StateValue: types.Int64Value(1)},
+			},
+			expectedHasChangedNumber: true,
+		},
+		"AWS input": {
+			plan:  testResourceData1{Name: types.StringValue("test2"), Number: types.Int64Value(1), Age: types.Int64Value(200)},
+			state: testResourceData1{Name: types.StringValue("test"), Number: types.Int64Value(1), Age: types.Int64Value(100)},
+			opts:  []fwflex.ChangeOption{fwflex.WithAWSInput(&awsUpdateInput{})},
+			expectedChanges: []fwflex.FieldChange{
+				{FieldName: "Name", AWSFieldName: "Name", PlanValue: types.StringValue("test2"), StateValue: types.StringValue("test")},
+				{FieldName: "Age", PlanValue: types.Int64Value(200), StateValue: types.Int64Value(100)},
+			},
+			expectedAWSFieldNames: []string{"Name"},
+		},
+		"AWS input with field name suffix": {
+			plan:  testResourceData1{Name: types.StringValue("test"), Number: types.Int64Value(2), Age: types.Int64Value(100)},
+			state: testResourceData1{Name: types.StringValue("test"), Number: types.Int64Value(1), Age: types.Int64Value(100)},
+			opts:  []fwflex.ChangeOption{fwflex.WithAWSInput(awsUpdateInput{}, fwflex.WithFieldNameSuffix("OfDays"))},
+			expectedChanges: []fwflex.FieldChange{
+				{FieldName: "Number", AWSFieldName: "NumberOfDays", PlanValue: types.Int64Value(2), StateValue: types.Int64Value(1)},
+			},
+			expectedAWSFieldNames:    []string{"NumberOfDays"},
+			expectedHasChangedNumber: true,
+		},
+		"ignored field": {
+			plan:  testResourceData1{Name: types.StringValue("test2"), Number: types.Int64Value(1), Age: types.Int64Value(100)},
+			state: testResourceData1{Name: types.StringValue("test"), Number: types.Int64Value(1), Age: types.Int64Value(100)},
+			opts:  []fwflex.ChangeOption{fwflex.WithIgnoredField("Name"), fwflex.WithAWSInput(&awsUpdateInput{})},
+		},
+	}
+
+	for name, test := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := fwflex.Diff(context.Background(), test.plan, test.state, test.opts...)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(results.Changes(), test.expectedChanges); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(results.ChangedAWSFieldNames(), test.expectedAWSFieldNames); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if got, want := results.HasChange("Number"), test.expectedHasChangedNumber; got != want {
+				t.Errorf("unexpected HasChange(\"Number\"). got: %t, want: %t", got, want)
+			}
+		})
+	}
+}
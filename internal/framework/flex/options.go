@@ -3,7 +3,11 @@
 
 package flex
 
-import "slices"
+import (
+	"reflect"
+	"slices"
+	"strings"
+)
 
 var (
 	DefaultIgnoredFieldNames = []string{
@@ -27,6 +31,9 @@ type AutoFlexOptions struct {
 	// ignoredFieldNames stores names which expanders and flatteners will
 	// not read from or write to
 	ignoredFieldNames []string
+
+	// unionMemberTypes maps AWS union interface types to their member types
+	unionMemberTypes map[reflect.Type][]reflect.Type
 }
 
 // WithFieldNamePrefix specifies a prefix to be accounted for when
@@ -77,7 +84,46 @@ func WithNoIgnoredFieldNames() AutoFlexOptionsFunc {
 	}
 }
 
+// WithUnion registers the members of an AWS union interface type
+//
+// Use this option to expand a Terraform nested block per union member to
+// the corresponding `Member*` struct and to flatten it back. The union's
+// Terraform model must contain one nested block field per member, named
+// after the member with the union name and "Member" prefix removed, e.g.
+//
+//	WithUnion[awstypes.PolicyDefinition](
+//		&awstypes.PolicyDefinitionMemberStatic{},
+//		&awstypes.PolicyDefinitionMemberTemplateLinked{},
+//	)
+//
+// maps `PolicyDefinitionMemberStatic` to the model's `Static` field.
+func WithUnion[T any](members ...T) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		if o.unionMemberTypes == nil {
+			o.unionMemberTypes = make(map[reflect.Type][]reflect.Type)
+		}
+		tUnion := reflect.TypeFor[T]()
+		for _, member := range members {
+			o.unionMemberTypes[tUnion] = append(o.unionMemberTypes[tUnion], reflect.TypeOf(member))
+		}
+	}
+}
+
 // isIgnoredField returns true if s is in the list of ignored field names
 func (o *AutoFlexOptions) isIgnoredField(s string) bool {
 	return slices.Contains(o.ignoredFieldNames, s)
 }
+
+// unionMembers returns the registered member types of the specified union interface type
+func (o *AutoFlexOptions) unionMembers(tUnion reflect.Type) ([]reflect.Type, bool) {
+	members, ok := o.unionMemberTypes[tUnion]
+	return members, ok
+}
+
+// unionMemberName returns the name of the Terraform field corresponding to the specified union member type
+func unionMemberName(tUnion, tMember reflect.Type) string {
+	if tMember.Kind() == reflect.Pointer {
+		tMember = tMember.Elem()
+	}
+	return strings.TrimPrefix(tMember.Name(), tUnion.Name()+"Member")
+}
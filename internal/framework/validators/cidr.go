@@ -7,11 +7,18 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatorfuncerr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
-// ipv4CIDRNetworkAddressValidator validates that a string Attribute's value is a valid IPv4 CIDR that represents a network address.
+var (
+	_ validator.String                  = ipv4CIDRNetworkAddressValidator{}
+	_ function.StringParameterValidator = ipv4CIDRNetworkAddressValidator{}
+)
+
+// ipv4CIDRNetworkAddressValidator validates that a string Attribute's or function parameter's value is a valid IPv4 CIDR that represents a network address.
 type ipv4CIDRNetworkAddressValidator struct{}
 
 // Description describes the validation in plain text formatting.
@@ -40,6 +47,22 @@ func (validator ipv4CIDRNetworkAddressValidator) ValidateString(ctx context.Cont
 	}
 }
 
+// ValidateParameterString performs the validation of a function parameter.
+func (validator ipv4CIDRNetworkAddressValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	if err := verify.ValidateIPv4CIDRBlock(request.Value.ValueString()); err != nil {
+		response.Error = validatorfuncerr.InvalidParameterValueFuncError(
+			request.ArgumentPosition,
+			validator.Description(ctx),
+			request.Value.ValueString(),
+		)
+		return
+	}
+}
+
 // IPv4CIDRNetworkAddress returns a string validator which ensures that any configured
 // attribute value:
 //
@@ -50,7 +73,22 @@ func IPv4CIDRNetworkAddress() validator.String {
 	return ipv4CIDRNetworkAddressValidator{}
 }
 
-// ipv6CIDRNetworkAddressValidator validates that a string Attribute's value is a valid IPv6 CIDR that represents a network address.
+// IPv4CIDRNetworkAddressParameter returns a function parameter validator which ensures that any
+// string parameter value:
+//
+//   - Represents a valid IPv4 CIDR network address.
+//
+// Null and unknown values are skipped.
+func IPv4CIDRNetworkAddressParameter() function.StringParameterValidator {
+	return ipv4CIDRNetworkAddressValidator{}
+}
+
+var (
+	_ validator.String                  = ipv6CIDRNetworkAddressValidator{}
+	_ function.StringParameterValidator = ipv6CIDRNetworkAddressValidator{}
+)
+
+// ipv6CIDRNetworkAddressValidator validates that a string Attribute's or function parameter's value is a valid IPv6 CIDR that represents a network address.
 type ipv6CIDRNetworkAddressValidator struct{}
 
 // Description describes the validation in plain text formatting.
@@ -80,6 +118,22 @@ func (validator ipv6CIDRNetworkAddressValidator) ValidateString(ctx context.Cont
 	}
 }
 
+// ValidateParameterString performs the validation of a function parameter.
+func (validator ipv6CIDRNetworkAddressValidator) ValidateParameterString(ctx context.Context, request function.StringParameterValidatorRequest, response *function.StringParameterValidatorResponse) {
+	if request.Value.IsNull() || request.Value.IsUnknown() {
+		return
+	}
+
+	if err := verify.ValidateIPv6CIDRBlock(request.Value.ValueString()); err != nil {
+		response.Error = validatorfuncerr.InvalidParameterValueFuncError(
+			request.ArgumentPosition,
+			validator.Description(ctx),
+			request.Value.ValueString(),
+		)
+		return
+	}
+}
+
 // IPv6CIDRNetworkAddress returns a string validator which ensures that any configured
 // attribute value:
 //
@@ -89,3 +143,13 @@ func (validator ipv6CIDRNetworkAddressValidator) ValidateString(ctx context.Cont
 func IPv6CIDRNetworkAddress() validator.String {
 	return ipv6CIDRNetworkAddressValidator{}
 }
+
+// IPv6CIDRNetworkAddressParameter returns a function parameter validator which ensures that any
+// string parameter value:
+//
+//   - Represents a valid IPv6 CIDR network address.
+//
+// Null and unknown values are skipped.
+func IPv6CIDRNetworkAddressParameter() function.StringParameterValidator {
+	return ipv6CIDRNetworkAddressValidator{}
+}
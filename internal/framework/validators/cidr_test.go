@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -154,3 +155,89 @@ func TestIPv6CIDRNetworkAddressValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestIPv4CIDRNetworkAddressValidator_parameter(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val           types.String
+		expectedError *function.FuncError
+	}
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid IPv4 CIDR": {
+			val: types.StringValue("10.2.2.0/24"),
+		},
+		"invalid IPv4 CIDR": {
+			val:           types.StringValue("10.2.2.2/24"),
+			expectedError: function.NewArgumentFuncError(1, "Invalid Parameter Value: value must be a valid IPv4 CIDR that represents a network address, got: 10.2.2.2/24"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := function.StringParameterValidatorRequest{
+				ArgumentPosition: 1,
+				Value:            test.val,
+			}
+			response := function.StringParameterValidatorResponse{}
+			fwvalidators.IPv4CIDRNetworkAddressParameter().ValidateParameterString(ctx, request, &response)
+
+			if diff := cmp.Diff(response.Error, test.expectedError); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv6CIDRNetworkAddressValidator_parameter(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val           types.String
+		expectedError *function.FuncError
+	}
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid IPv6 CIDR": {
+			val: types.StringValue("2001:db8::/122"),
+		},
+		"invalid IPv6 CIDR": {
+			val:           types.StringValue("2001::/15"),
+			expectedError: function.NewArgumentFuncError(0, "Invalid Parameter Value: value must be a valid IPv6 CIDR that represents a network address, got: 2001::/15"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := function.StringParameterValidatorRequest{
+				ArgumentPosition: 0,
+				Value:            test.val,
+			}
+			response := function.StringParameterValidatorResponse{}
+			fwvalidators.IPv6CIDRNetworkAddressParameter().ValidateParameterString(ctx, request, &response)
+
+			if diff := cmp.Diff(response.Error, test.expectedError); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
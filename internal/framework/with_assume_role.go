@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// WithAssumeRoleModel is embedded in the model of a resource that supports per-resource assume role override.
+type WithAssumeRoleModel struct {
+	AssumeRole fwtypes.ListNestedObjectValueOf[AssumeRoleModel] `tfsdk:"assume_role"`
+}
+
+type AssumeRoleModel struct {
+	Duration    fwtypes.Duration `tfsdk:"duration"`
+	ExternalID  types.String     `tfsdk:"external_id"`
+	RoleARN     fwtypes.ARN      `tfsdk:"role_arn"`
+	SessionName types.String     `tfsdk:"session_name"`
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+const (
+	// VPC and subnet sizing reference:
+	// https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html
+
+	// ipv4CIDRBlockPrefixLengthMin is the minimum (largest) IPv4 VPC and subnet CIDR block prefix length
+	ipv4CIDRBlockPrefixLengthMin = 16
+	// ipv4CIDRBlockPrefixLengthMax is the maximum (smallest) IPv4 VPC and subnet CIDR block prefix length
+	ipv4CIDRBlockPrefixLengthMax = 28
+	// ipv4SubnetReservedAddressCount is the number of IPv4 addresses AWS reserves in each subnet
+	// (the first four and the last one)
+	ipv4SubnetReservedAddressCount = 5
+)
+
+var cidrSubnetsAWSResultAttrTypes = map[string]attr.Type{
+	"cidr_block":           types.StringType,
+	"first_usable_address": types.StringType,
+	"last_usable_address":  types.StringType,
+	"usable_address_count": types.Int64Type,
+}
+
+var _ function.Function = cidrSubnetsAWSFunction{}
+
+func NewCIDRSubnetsAWSFunction() function.Function {
+	return &cidrSubnetsAWSFunction{}
+}
+
+type cidrSubnetsAWSFunction struct{}
+
+func (f cidrSubnetsAWSFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets_aws"
+}
+
+func (f cidrSubnetsAWSFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_subnets_aws Function",
+		MarkdownDescription: "Allocates consecutive IPv4 subnet CIDR blocks within a VPC CIDR block, " +
+			"enforcing the AWS /16 to /28 size limits and accounting for the five addresses AWS reserves in each subnet",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "vpc_cidr_block",
+				MarkdownDescription: "IPv4 CIDR block of the VPC",
+				Validators: []function.StringParameterValidator{
+					fwvalidators.IPv4CIDRNetworkAddressParameter(),
+				},
+			},
+			function.ListParameter{
+				Name:                "newbits",
+				MarkdownDescription: "Number of additional prefix bits for each subnet, as for the built-in `cidrsubnets` function",
+				ElementType:         types.Int64Type,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: cidrSubnetsAWSResultAttrTypes,
+			},
+		},
+	}
+}
+
+func (f cidrSubnetsAWSFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vpcCIDRBlock string
+	var newbits []int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &vpcCIDRBlock, &newbits))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := parseIPv4VPCCIDRBlock(vpcCIDRBlock)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	subnets, err := allocateIPv4Subnets(prefix, newbits)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	elems := make([]attr.Value, 0, len(subnets))
+	for _, subnet := range subnets {
+		first, last, count := ipv4SubnetUsableAddresses(subnet)
+		value := map[string]attr.Value{
+			"cidr_block":           types.StringValue(subnet.String()),
+			"first_usable_address": types.StringValue(first.String()),
+			"last_usable_address":  types.StringValue(last.String()),
+			"usable_address_count": types.Int64Value(count),
+		}
+
+		elem, d := types.ObjectValue(cidrSubnetsAWSResultAttrTypes, value)
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+		elems = append(elems, elem)
+	}
+
+	result, d := types.ListValue(types.ObjectType{AttrTypes: cidrSubnetsAWSResultAttrTypes}, elems)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// parseIPv4VPCCIDRBlock parses an IPv4 VPC CIDR block, checking the AWS size limits.
+func parseIPv4VPCCIDRBlock(s string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	if bits := prefix.Bits(); bits < ipv4CIDRBlockPrefixLengthMin || bits > ipv4CIDRBlockPrefixLengthMax {
+		return netip.Prefix{}, fmt.Errorf("VPC CIDR block %q must have a prefix length between /%d and /%d", s, ipv4CIDRBlockPrefixLengthMin, ipv4CIDRBlockPrefixLengthMax)
+	}
+
+	return prefix, nil
+}
+
+// allocateIPv4Subnets allocates consecutive subnets within the specified VPC CIDR block.
+// As for the built-in cidrsubnets function, each subnet is aligned to its own size,
+// which may leave gaps between subnets of different sizes.
+func allocateIPv4Subnets(vpc netip.Prefix, newbits []int64) ([]netip.Prefix, error) {
+	vpcStart := ipv4ToUint32(vpc.Addr())
+	vpcEnd := uint64(vpcStart) + ipv4PrefixSize(vpc.Bits())
+
+	subnets := make([]netip.Prefix, 0, len(newbits))
+	next := uint64(vpcStart)
+	for i, n := range newbits {
+		if n < 0 {
+			return nil, fmt.Errorf("newbits[%d]: must not be negative", i)
+		}
+
+		bits := int64(vpc.Bits()) + n
+		if bits < ipv4CIDRBlockPrefixLengthMin || bits > ipv4CIDRBlockPrefixLengthMax {
+			return nil, fmt.Errorf("newbits[%d]: subnet prefix length /%d must be between /%d and /%d", i, bits, ipv4CIDRBlockPrefixLengthMin, ipv4CIDRBlockPrefixLengthMax)
+		}
+
+		size := ipv4PrefixSize(int(bits))
+		if rem := next % size; rem != 0 {
+			next += size - rem
+		}
+		if next+size > vpcEnd {
+			return nil, fmt.Errorf("newbits[%d]: not enough remaining address space in %s for a /%d subnet", i, vpc, bits)
+		}
+
+		subnets = append(subnets, netip.PrefixFrom(uint32ToIPv4(uint32(next)), int(bits)))
+		next += size
+	}
+
+	return subnets, nil
+}
+
+// ipv4SubnetUsableAddresses returns the first and last addresses, and the number of addresses,
+// that are available for use in the specified subnet.
+func ipv4SubnetUsableAddresses(subnet netip.Prefix) (netip.Addr, netip.Addr, int64) {
+	start := ipv4ToUint32(subnet.Addr())
+	size := ipv4PrefixSize(subnet.Bits())
+
+	// The network address, VPC router, DNS server and future use addresses are reserved at
+	// the start of the subnet and the network broadcast address is reserved at the end.
+	first := uint32ToIPv4(start + 4)
+	last := uint32ToIPv4(start + uint32(size) - 2)
+
+	return first, last, int64(size) - ipv4SubnetReservedAddressCount
+}
+
+func ipv4PrefixSize(bits int) uint64 {
+	return 1 << (32 - bits)
+}
+
+func ipv4ToUint32(addr netip.Addr) uint32 {
+	b := addr.As4()
+	return binary.BigEndian.Uint32(b[:])
+}
+
+func uint32ToIPv4(v uint32) netip.Addr {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	return netip.AddrFrom4(b)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSubnetsAWSFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsAWSFunctionConfig("10.0.0.0/16", "8, 8, 4"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("cidr_blocks", "10.0.0.0/24,10.0.1.0/24,10.0.16.0/20"),
+					resource.TestCheckOutput("first_usable_address", "10.0.0.4"),
+					resource.TestCheckOutput("last_usable_address", "10.0.0.254"),
+					resource.TestCheckOutput("usable_address_count", "251"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsAWSFunction_invalidVPCCIDRBlock(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsAWSFunctionConfig("10.0.0.1/16", "8"),
+				ExpectError: regexache.MustCompile(`must[\s\n]*be[\s\n]*a[\s\n]*valid[\s\n]*IPv4[\s\n]*CIDR`),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsAWSFunction_vpcTooLarge(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsAWSFunctionConfig("10.0.0.0/8", "8"),
+				ExpectError: regexache.MustCompile(`prefix[\s\n]*length[\s\n]*between[\s\n]*/16[\s\n]*and[\s\n]*/28`),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsAWSFunction_subnetTooSmall(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsAWSFunctionConfig("10.0.0.0/16", "13"),
+				ExpectError: regexache.MustCompile(`/29[\s\n]*must[\s\n]*be[\s\n]*between`),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsAWSFunction_addressSpaceExhausted(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsAWSFunctionConfig("10.0.0.0/16", "1, 1, 1"),
+				ExpectError: regexache.MustCompile(`not[\s\n]*enough[\s\n]*remaining[\s\n]*address[\s\n]*space`),
+			},
+		},
+	})
+}
+
+func testCIDRSubnetsAWSFunctionConfig(vpcCIDRBlock, newbits string) string {
+	return fmt.Sprintf(`
+locals {
+  subnets = provider::aws::cidr_subnets_aws(%[1]q, [%[2]s])
+}
+
+output "cidr_blocks" {
+  value = join(",", local.subnets[*].cidr_block)
+}
+
+output "first_usable_address" {
+  value = local.subnets[0].first_usable_address
+}
+
+output "last_usable_address" {
+  value = local.subnets[0].last_usable_address
+}
+
+output "usable_address_count" {
+  value = local.subnets[0].usable_address_count
+}
+`, vpcCIDRBlock, newbits)
+}
@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// IAM policy element reference:
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements.html
+
+const (
+	iamPolicyVersionLatest = "2012-10-17"
+)
+
+var (
+	// iamPolicyVersions are the supported values of the Version element, oldest first.
+	iamPolicyVersions = []string{"2008-10-17", iamPolicyVersionLatest}
+
+	// iamPolicyStatementStringOrSliceElements are the statement elements whose value
+	// is either a single string or an unordered list of strings.
+	iamPolicyStatementStringOrSliceElements = []string{"Action", "NotAction", "Resource", "NotResource"}
+
+	// iamPolicyStatementPrincipalElements are the statement elements whose value
+	// is either "*" or a map of principal type to identifiers.
+	iamPolicyStatementPrincipalElements = []string{"Principal", "NotPrincipal"}
+)
+
+// iamPolicyDocument is a loosely typed IAM policy document.
+// Statements are kept as generic maps so that elements not otherwise
+// handled here round-trip unchanged.
+type iamPolicyDocument struct {
+	Version    string              `json:",omitempty"`
+	Id         string              `json:",omitempty"`
+	Statements iamPolicyStatements `json:"Statement"`
+}
+
+type iamPolicyStatement map[string]any
+
+func (s iamPolicyStatement) sid() string {
+	if v, ok := s["Sid"].(string); ok {
+		return v
+	}
+
+	return ""
+}
+
+type iamPolicyStatements []iamPolicyStatement
+
+// UnmarshalJSON handles a Statement element that is either a single statement object
+// or an array of statement objects.
+func (s *iamPolicyStatements) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+
+	switch {
+	case bytes.Equal(b, []byte("null")):
+		*s = nil
+	case bytes.HasPrefix(b, []byte("{")):
+		var statement iamPolicyStatement
+		if err := json.Unmarshal(b, &statement); err != nil {
+			return err
+		}
+		*s = iamPolicyStatements{statement}
+	default:
+		var statements []iamPolicyStatement
+		if err := json.Unmarshal(b, &statements); err != nil {
+			return err
+		}
+		*s = statements
+	}
+
+	return nil
+}
+
+// parseIAMPolicy parses a JSON IAM policy document.
+func parseIAMPolicy(s string) (*iamPolicyDocument, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, errors.New("policy must not be empty")
+	}
+
+	if !json.Valid([]byte(s)) {
+		return nil, errors.New("policy is not valid JSON")
+	}
+
+	var doc iamPolicyDocument
+	if err := json.Unmarshal([]byte(s), &doc); err != nil {
+		return nil, fmt.Errorf("parsing policy: %w", err)
+	}
+
+	if doc.Version != "" && !slices.Contains(iamPolicyVersions, doc.Version) {
+		return nil, fmt.Errorf("unsupported policy Version %q, must be one of %s", doc.Version, strings.Join(iamPolicyVersions, ", "))
+	}
+
+	for i, statement := range doc.Statements {
+		if statement == nil {
+			return nil, fmt.Errorf("parsing policy: statement %d is empty", i)
+		}
+	}
+
+	return &doc, nil
+}
+
+// normalizedIAMPolicy returns the normalized JSON representation of the policy document.
+// The result is checked to be equivalent to the policy document as compared by the provider's
+// IAM policy difference suppression, so that using it never causes a spurious diff.
+func normalizedIAMPolicy(d *iamPolicyDocument) (string, error) {
+	original, err := d.marshal()
+	if err != nil {
+		return "", err
+	}
+
+	d.normalize()
+
+	normalized, err := d.marshal()
+	if err != nil {
+		return "", err
+	}
+
+	if !verify.PolicyStringsEquivalent(original, normalized) {
+		return "", fmt.Errorf("normalized policy (%s) is not equivalent to policy (%s)", normalized, original)
+	}
+
+	return normalized, nil
+}
+
+// normalize rewrites the policy document into a canonical form:
+// single element lists are collapsed to strings, unordered lists are sorted
+// and the Statement element is always a list.
+// Duplicate list elements are kept as verify.PolicyStringsEquivalent does not treat lists with and without duplicates as equivalent.
+func (d *iamPolicyDocument) normalize() {
+	if d.Statements == nil {
+		d.Statements = iamPolicyStatements{}
+	}
+
+	for _, statement := range d.Statements {
+		for _, k := range iamPolicyStatementStringOrSliceElements {
+			if v, ok := statement[k]; ok {
+				statement[k] = normalizeIAMPolicyStringOrSlice(v, true)
+			}
+		}
+
+		for _, k := range iamPolicyStatementPrincipalElements {
+			if v, ok := statement[k].(map[string]any); ok {
+				for typ, identifiers := range v {
+					v[typ] = normalizeIAMPolicyStringOrSlice(identifiers, true)
+				}
+			}
+		}
+
+		// Condition values are not reordered.
+		if v, ok := statement["Condition"].(map[string]any); ok {
+			for _, operator := range v {
+				if operator, ok := operator.(map[string]any); ok {
+					for key, values := range operator {
+						operator[key] = normalizeIAMPolicyStringOrSlice(values, false)
+					}
+				}
+			}
+		}
+	}
+}
+
+// marshal returns the JSON representation of the policy document.
+// Elements are in iamPolicyDocument field order, so Version is first.
+func (d *iamPolicyDocument) marshal() (string, error) {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// merge merges the statements from other into the policy document.
+// Statements with a Sid replace any existing statement with the same Sid.
+// Statements without a Sid are appended unless an equivalent statement is already present.
+func (d *iamPolicyDocument) merge(other *iamPolicyDocument) error {
+	if other.Id != "" {
+		d.Id = other.Id
+	}
+
+	if slices.Index(iamPolicyVersions, other.Version) > slices.Index(iamPolicyVersions, d.Version) {
+		d.Version = other.Version
+	}
+
+	for _, statement := range other.Statements {
+		if sid := statement.sid(); sid != "" {
+			if i := slices.IndexFunc(d.Statements, func(v iamPolicyStatement) bool {
+				return v.sid() == sid
+			}); i != -1 {
+				d.Statements[i] = statement
+				continue
+			}
+		} else {
+			equivalent, err := d.containsEquivalentStatement(statement)
+			if err != nil {
+				return err
+			}
+			if equivalent {
+				continue
+			}
+		}
+
+		d.Statements = append(d.Statements, statement)
+	}
+
+	return nil
+}
+
+// containsEquivalentStatement returns whether the policy document contains a statement
+// that is semantically equivalent to the specified statement.
+func (d *iamPolicyDocument) containsEquivalentStatement(statement iamPolicyStatement) (bool, error) {
+	wrap := func(statement iamPolicyStatement) (string, error) {
+		doc := iamPolicyDocument{
+			Version:    iamPolicyVersionLatest,
+			Statements: iamPolicyStatements{statement},
+		}
+		return doc.marshal()
+	}
+
+	s1, err := wrap(statement)
+	if err != nil {
+		return false, err
+	}
+
+	for _, existing := range d.Statements {
+		s2, err := wrap(existing)
+		if err != nil {
+			return false, err
+		}
+
+		if verify.PolicyStringsEquivalent(s1, s2) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// normalizeIAMPolicyStringOrSlice collapses single element lists to a string and
+// optionally sorts multi-element lists.
+// Values of any other type are returned unchanged.
+func normalizeIAMPolicyStringOrSlice(v any, sorted bool) any {
+	l, ok := v.([]any)
+	if !ok {
+		return v
+	}
+
+	values := make([]string, 0, len(l))
+	for _, v := range l {
+		s, ok := v.(string)
+		if !ok {
+			// Leave lists of non-string values alone.
+			return l
+		}
+		values = append(values, s)
+	}
+
+	if sorted {
+		slices.Sort(values)
+	}
+
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	return values
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Merges a list of IAM policy documents into a single normalized policy document. " +
+			"Statements with a `Sid` replace statements with the same `Sid` from earlier documents. " +
+			"Statements without a `Sid` are appended unless an equivalent statement is already present.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				MarkdownDescription: "List of IAM policy documents in JSON format",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{
+			CustomType: fwtypes.IAMPolicyType,
+		},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var args []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &args))
+	if resp.Error != nil {
+		return
+	}
+
+	var doc iamPolicyDocument
+	if len(args) == 0 {
+		doc.Version = iamPolicyVersionLatest
+	}
+	for i, arg := range args {
+		other, err := parseIAMPolicy(arg)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("policies[%d]: %s", i, err)))
+			return
+		}
+
+		if err := doc.merge(other); err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+			return
+		}
+	}
+
+	result, err := normalizedIAMPolicy(&doc)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, fwtypes.IAMPolicyValue(result)))
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+var _ function.Function = iamPolicyStatementFilterFunction{}
+
+func NewIAMPolicyStatementFilterFunction() function.Function {
+	return &iamPolicyStatementFilterFunction{}
+}
+
+type iamPolicyStatementFilterFunction struct{}
+
+func (f iamPolicyStatementFilterFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_statement_filter"
+}
+
+func (f iamPolicyStatementFilterFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_statement_filter Function",
+		MarkdownDescription: "Filters the statements of an IAM policy document, returning a normalized policy document " +
+			"containing only the statements whose `Sid` is in the specified list.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "policy",
+				MarkdownDescription: "IAM policy document in JSON format",
+				CustomType:          fwtypes.IAMPolicyType,
+			},
+			function.ListParameter{
+				Name:                "sids",
+				MarkdownDescription: "Statement identifiers (`Sid`) of the statements to keep",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{
+			CustomType: fwtypes.IAMPolicyType,
+		},
+	}
+}
+
+func (f iamPolicyStatementFilterFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policy fwtypes.IAMPolicy
+	var sids []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policy, &sids))
+	if resp.Error != nil {
+		return
+	}
+
+	doc, err := parseIAMPolicy(policy.ValueString())
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	doc.Statements = slices.DeleteFunc(doc.Statements, func(v iamPolicyStatement) bool {
+		return !slices.Contains(sids, v.sid())
+	})
+
+	result, err := normalizedIAMPolicy(doc)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, fwtypes.IAMPolicyValue(result)))
+}
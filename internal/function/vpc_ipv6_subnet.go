@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+const (
+	// IPv6 VPC and subnet sizing reference:
+	// https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html
+
+	// ipv6VPCCIDRBlockPrefixLength is the prefix length of an Amazon-provided IPv6 VPC CIDR block
+	ipv6VPCCIDRBlockPrefixLength = 56
+	// ipv6SubnetCIDRBlockPrefixLength is the prefix length of an IPv6 subnet CIDR block
+	ipv6SubnetCIDRBlockPrefixLength = 64
+)
+
+var _ function.Function = vpcIPv6SubnetFunction{}
+
+func NewVPCIPv6SubnetFunction() function.Function {
+	return &vpcIPv6SubnetFunction{}
+}
+
+type vpcIPv6SubnetFunction struct{}
+
+func (f vpcIPv6SubnetFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "vpc_ipv6_subnet"
+}
+
+func (f vpcIPv6SubnetFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "vpc_ipv6_subnet Function",
+		MarkdownDescription: "Calculates the /64 IPv6 subnet CIDR block with the given network number within a /56 IPv6 VPC CIDR block",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "vpc_ipv6_cidr_block",
+				MarkdownDescription: "/56 IPv6 CIDR block of the VPC",
+				Validators: []function.StringParameterValidator{
+					fwvalidators.IPv6CIDRNetworkAddressParameter(),
+				},
+			},
+			function.Int64Parameter{
+				Name:                "netnum",
+				MarkdownDescription: "Network number of the subnet, between 0 and 255",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f vpcIPv6SubnetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vpcCIDRBlock string
+	var netnum int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &vpcCIDRBlock, &netnum))
+	if resp.Error != nil {
+		return
+	}
+
+	vpc, err := netip.ParsePrefix(vpcCIDRBlock)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	if vpc.Bits() != ipv6VPCCIDRBlockPrefixLength {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("VPC IPv6 CIDR block %q must have a prefix length of /%d", vpcCIDRBlock, ipv6VPCCIDRBlockPrefixLength)))
+		return
+	}
+
+	const maxNetnum = 1<<(ipv6SubnetCIDRBlockPrefixLength-ipv6VPCCIDRBlockPrefixLength) - 1
+	if netnum < 0 || netnum > maxNetnum {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("netnum must be between 0 and %d", maxNetnum)))
+		return
+	}
+
+	// The /56 to /64 delegation is the 8th byte of the address.
+	b := vpc.Addr().As16()
+	b[ipv6VPCCIDRBlockPrefixLength/8] = byte(netnum)
+	result := netip.PrefixFrom(netip.AddrFrom16(b), ipv6SubnetCIDRBlockPrefixLength)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result.String()))
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestVPCIPv6SubnetFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testVPCIPv6SubnetFunctionConfig("2600:1f14:abc:de00::/56", 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2600:1f14:abc:de0a::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestVPCIPv6SubnetFunction_invalidPrefixLength(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCIPv6SubnetFunctionConfig("2600:1f14:abc::/48", 1),
+				ExpectError: regexache.MustCompile(`prefix[\s\n]*length[\s\n]*of[\s\n]*/56`),
+			},
+		},
+	})
+}
+
+func TestVPCIPv6SubnetFunction_invalidNetnum(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCIPv6SubnetFunctionConfig("2600:1f14:abc:de00::/56", 256),
+				ExpectError: regexache.MustCompile(`netnum[\s\n]*must[\s\n]*be[\s\n]*between[\s\n]*0[\s\n]*and[\s\n]*255`),
+			},
+		},
+	})
+}
+
+func testVPCIPv6SubnetFunctionConfig(vpcCIDRBlock string, netnum int) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::vpc_ipv6_subnet(%[1]q, %[2]d)
+}`, vpcCIDRBlock, netnum)
+}
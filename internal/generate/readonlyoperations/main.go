@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	_ "embed"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+// readOnlyPrefixes are the operation name prefixes of AWS API operations that don't modify resources.
+var readOnlyPrefixes = []string{
+	"BatchDescribe",
+	"BatchGet",
+	"Describe",
+	"Download",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+	"Simulate",
+	"Validate",
+}
+
+// operationOverrides override read-only prefix matching for individual operations, keyed by service ProviderNameUpper.
+// false excludes an operation that matches a read-only prefix but modifies resources or issues credentials, tokens or signed URLs.
+// true includes a read-only operation that doesn't match any read-only prefix.
+var operationOverrides = map[string]map[string]bool{
+	"CloudFront": {
+		"VerifyDnsConfiguration": true,
+	},
+	"CodeArtifact": {
+		"GetAuthorizationToken": false,
+	},
+	"CodeCatalyst": {
+		"VerifySession": true,
+	},
+	"CognitoIdentity": {
+		"GetCredentialsForIdentity":          false,
+		"GetId":                              false, // Creates an identity if none exists.
+		"GetOpenIdToken":                     false,
+		"GetOpenIdTokenForDeveloperIdentity": false, // Creates or links a developer identity.
+	},
+	"Connect": {
+		"GetFederationToken": false,
+	},
+	"DataZone": {
+		"GetEnvironmentCredentials": false,
+		"GetIamPortalLoginUrl":      false,
+	},
+	"ECR": {
+		"GetAuthorizationToken": false,
+	},
+	"ECRPublic": {
+		"GetAuthorizationToken": false,
+	},
+	"EMRContainers": {
+		"GetManagedEndpointSessionCredentials": false,
+	},
+	"GameLift": {
+		"GetComputeAuthToken": false,
+	},
+	"KMS": {
+		"Verify":    true,
+		"VerifyMac": true,
+	},
+	"LakeFormation": {
+		"GetTemporaryGluePartitionCredentials": false,
+		"GetTemporaryGlueTableCredentials":     false,
+	},
+	"LicenseManager": {
+		"GetAccessToken": false,
+	},
+	"Location": {
+		"VerifyDevicePosition": true,
+	},
+	"QuickSight": {
+		"GetDashboardEmbedUrl": false,
+		"GetSessionEmbedUrl":   false,
+	},
+	"Redshift": {
+		"GetClusterCredentials":        false, // Can create the database user.
+		"GetClusterCredentialsWithIAM": false, // Can create the database user.
+	},
+	"RedshiftServerless": {
+		"GetCredentials": false,
+	},
+	"SSM": {
+		"GetAccessToken": false,
+	},
+	"SSO": {
+		"GetRoleCredentials": false,
+	},
+	"STS": {
+		"GetFederationToken": false,
+		"GetSessionToken":    false,
+	},
+}
+
+type ServiceDatum struct {
+	Operations        []string
+	ProviderNameUpper string
+}
+
+type TemplateData struct {
+	Services []ServiceDatum
+}
+
+func main() {
+	const (
+		filename = `readonly_operations_gen.go`
+	)
+	g := common.NewGenerator()
+
+	g.Infof("Generating internal/conns/%s", filename)
+
+	data, err := data.ReadAllServiceData()
+	if err != nil {
+		g.Fatalf("error reading service data: %s", err)
+	}
+
+	modulePaths := make(map[string]string) // SDK module path -> provider service package name.
+	for _, l := range data {
+		if l.Exclude() {
+			continue
+		}
+
+		if l.NotImplemented() && !l.EndpointOnly() {
+			continue
+		}
+
+		if l.IsClientSDKV1() {
+			continue
+		}
+
+		modulePaths["github.com/aws/aws-sdk-go-v2/service/"+l.GoPackageName()] = l.ProviderNameUpper()
+	}
+
+	// Locate each AWS SDK for Go v2 service module in the module cache.
+	args := append([]string{"list", "-e", "-m", "-f", "{{ .Path }} {{ .Dir }}"}, slices.Sorted(func(yield func(string) bool) {
+		for k := range modulePaths {
+			if !yield(k) {
+				return
+			}
+		}
+	})...)
+	output, err := exec.Command("go", args...).Output()
+	if err != nil {
+		g.Fatalf("listing AWS SDK for Go v2 modules: %s", err)
+	}
+
+	td := TemplateData{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		path, dir, _ := strings.Cut(scanner.Text(), " ")
+		if dir == "" {
+			g.Warnf("module %s not found, skipping", path)
+			continue
+		}
+
+		filenames, err := filepath.Glob(filepath.Join(dir, "api_op_*.go"))
+		if err != nil {
+			g.Fatalf("listing operations in %s: %s", dir, err)
+		}
+
+		s := ServiceDatum{
+			ProviderNameUpper: modulePaths[path],
+		}
+
+		for _, filename := range filenames {
+			operation := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(filename), "api_op_"), ".go")
+
+			if isReadOnly(s.ProviderNameUpper, operation) {
+				s.Operations = append(s.Operations, operation)
+			}
+		}
+
+		if len(s.Operations) == 0 {
+			continue
+		}
+
+		slices.Sort(s.Operations)
+		td.Services = append(td.Services, s)
+	}
+
+	slices.SortStableFunc(td.Services, func(a, b ServiceDatum) int {
+		return cmp.Compare(a.ProviderNameUpper, b.ProviderNameUpper)
+	})
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.BufferTemplate("readonlyoperations", tmpl, td); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+// isReadOnly returns whether the named operation doesn't modify resources.
+// An operation is read-only if it starts with a read-only prefix followed by a word boundary,
+// e.g. "GetBucketPolicy" but not "Getaway", unless overridden in operationOverrides.
+func isReadOnly(providerNameUpper, operation string) bool {
+	if v, ok := operationOverrides[providerNameUpper][operation]; ok {
+		return v
+	}
+
+	return slices.ContainsFunc(readOnlyPrefixes, func(prefix string) bool {
+		rest, ok := strings.CutPrefix(operation, prefix)
+		if !ok {
+			return false
+		}
+
+		return rest == "" || unicode.IsUpper([]rune(rest)[0])
+	})
+}
+
+//go:embed file.gtpl
+var tmpl string
@@ -217,6 +217,7 @@ const (
 type ResourceDatum struct {
 	FactoryName                       string
 	Name                              string // Friendly name (without service name), e.g. "Topic", not "SNS Topic"
+	AssumeRoleOverrideEnabled         bool
 	IsGlobal                          bool
 	regionOverrideEnabled             bool
 	TransparentTagging                bool
@@ -549,6 +550,9 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 
 			case "IdentityFix":
 				d.HasIdentityFix = true
+
+			case "AssumeRoleOverride":
+				d.AssumeRoleOverrideEnabled = true
 			}
 		}
 	}
@@ -619,6 +623,10 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.errs = append(v.errs, fmt.Errorf("V60SDKv2Fix not supported for Ephemeral Resources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				}
 
+				if d.AssumeRoleOverrideEnabled {
+					v.errs = append(v.errs, fmt.Errorf("AssumeRoleOverride not supported for Ephemeral Resources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				}
+
 			case "FrameworkDataSource":
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -647,6 +655,10 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.errs = append(v.errs, fmt.Errorf("V60SDKv2Fix not supported for Data Sources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				}
 
+				if d.AssumeRoleOverrideEnabled {
+					v.errs = append(v.errs, fmt.Errorf("AssumeRoleOverride not supported for Data Sources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				}
+
 			case "FrameworkResource":
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -703,6 +715,10 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.errs = append(v.errs, fmt.Errorf("V60SDKv2Fix not supported for Data Sources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				}
 
+				if d.AssumeRoleOverrideEnabled {
+					v.errs = append(v.errs, fmt.Errorf("AssumeRoleOverride not supported for Data Sources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				}
+
 			case "SDKResource":
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -727,6 +743,10 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.sdkResources[typeName] = d
 				}
 
+				if d.AssumeRoleOverrideEnabled {
+					v.errs = append(v.errs, fmt.Errorf("AssumeRoleOverride not supported for SDK Resources: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				}
+
 			case "FrameworkListResource":
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -769,7 +789,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.sdkListResources[typeName] = d
 				}
 
-			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "CustomImport":
+			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "CustomImport", "AssumeRoleOverride":
 				// Handled above.
 			case "ArnFormat", "IdAttrFormat", "NoImport", "Testing":
 				// Ignored.
@@ -33,6 +33,9 @@ type CommonArgs struct {
 	CheckDestroyNoop bool
 	DestroyTakesT    bool
 
+	// checkName overrides the resource type name in the CheckDestroy and CheckExists function names
+	checkName string
+
 	// CheckExists
 	HasExistsFunc  bool
 	ExistsTypeName string
@@ -75,6 +78,14 @@ func InitCommonArgs() CommonArgs {
 	}
 }
 
+// CheckName returns the name used in the CheckDestroy and CheckExists function names.
+func (c CommonArgs) CheckName() string {
+	if c.checkName != "" {
+		return c.checkName
+	}
+	return c.Name
+}
+
 func (c CommonArgs) HasImportStateIDAttribute() bool {
 	return c.importStateIDAttribute != ""
 }
@@ -173,6 +184,10 @@ func ParseTestingAnnotations(args common.Args, stuff *CommonArgs) error {
 		}
 	}
 
+	if attr, ok := args.Keyword["checkName"]; ok {
+		stuff.checkName = attr
+	}
+
 	// ExistsCheck
 	if attr, ok := args.Keyword["hasExistsFunction"]; ok {
 		if b, err := ParseBoolAttr("hasExistsFunction", attr); err != nil {
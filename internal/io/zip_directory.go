@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package io
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+var (
+	// zipEntryModified is the modification time of every entry, the earliest time representable in a ZIP file.
+	zipEntryModified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+const (
+	// zipEntryMode is the mode of every entry.
+	// Execute bits are set so that executables (e.g. a custom runtime's bootstrap) work when
+	// the archive is built on an OS, such as Windows, that does not record them.
+	zipEntryMode fs.FileMode = 0o755
+)
+
+// ZipDirectory builds a deterministic ZIP archive in memory of the regular files under the specified directory.
+// Entries are named by their slash-separated path relative to the directory and are sorted by name.
+// Every entry has the same modification time and mode, so the same directory contents
+// produce the same archive bytes regardless of OS, checkout time or umask.
+// Symbolic links to files are followed; symbolic links to directories are an error.
+// Usually a call to this function is protected by an exclusive lock (per resource type)
+// to prevent memory exhaustion (e.g. `conns.GlobalMutexKV.Lock`).
+func ZipDirectory(v string) ([]byte, error) {
+	dir, err := homedir.Expand(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		names = append(names, filepath.ToSlash(name))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		return nil, fmt.Errorf("directory (%s) contains no files", v)
+	}
+
+	// WalkDir walks in lexical order of each directory's entries, which is not the same as ordering by full slash-separated name.
+	slices.Sort(names)
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	for _, name := range names {
+		if err := addZipEntry(w, filepath.Join(dir, filepath.FromSlash(name)), name); err != nil {
+			return nil, fmt.Errorf("adding %s: %w", name, err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func addZipEntry(w *zip.Writer, path, name string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	if !fi.Mode().IsRegular() {
+		return errors.New("not a regular file")
+	}
+
+	header := &zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: zipEntryModified,
+	}
+	header.SetMode(zipEntryMode)
+
+	ew, err := w.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(ew, f)
+
+	return err
+}
+
+// Base64SHA256 returns the base64-encoded SHA-256 hash of the specified contents,
+// as calculated by Terraform's `filebase64sha256` function.
+func Base64SHA256(v []byte) string {
+	h := sha256.Sum256(v)
+
+	return base64.StdEncoding.EncodeToString(h[:])
+}
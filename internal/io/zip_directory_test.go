@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package io
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestZipDirectory(t *testing.T) {
+	t.Parallel()
+
+	files := map[string]string{
+		"index.js":        "exports.handler = async () => {};",
+		"lib/util.js":     "module.exports = {};",
+		"lib-a/helper.js": "module.exports = 1;",
+		"bootstrap":       "#!/bin/sh",
+	}
+
+	dir1, dir2 := t.TempDir(), t.TempDir()
+	writeFiles(t, dir1, files, 0o644)
+	writeFiles(t, dir2, files, 0o600)
+
+	// Modification times must not affect the archive.
+	past := time.Date(2001, time.February, 3, 4, 5, 6, 0, time.UTC)
+	if err := os.Chtimes(filepath.Join(dir2, "index.js"), past, past); err != nil {
+		t.Fatal(err)
+	}
+
+	zip1, err := ZipDirectory(dir1)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	zip2, err := ZipDirectory(dir2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !bytes.Equal(zip1, zip2) {
+		t.Fatal("archives of identical contents differ")
+	}
+
+	if got, expected := Base64SHA256(zip1), Base64SHA256(zip2); got != expected {
+		t.Errorf("hash: got %q, expected %q", got, expected)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(zip1), int64(len(zip1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, f := range r.File {
+		names = append(names, f.Name)
+
+		if got, expected := f.Mode(), zipEntryMode; got != expected {
+			t.Errorf("%s mode: got %s, expected %s", f.Name, got, expected)
+		}
+		if got, expected := f.Modified.UTC(), zipEntryModified; !got.Equal(expected) {
+			t.Errorf("%s modified: got %s, expected %s", f.Name, got, expected)
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, expected := string(content), files[f.Name]; got != expected {
+			t.Errorf("%s content: got %q, expected %q", f.Name, got, expected)
+		}
+	}
+
+	expectedNames := []string{"bootstrap", "index.js", "lib-a/helper.js", "lib/util.js"}
+	if len(names) != len(expectedNames) {
+		t.Fatalf("entries: got %v, expected %v", names, expectedNames)
+	}
+	for i := range names {
+		if names[i] != expectedNames[i] {
+			t.Fatalf("entries: got %v, expected %v", names, expectedNames)
+		}
+	}
+}
+
+func TestZipDirectory_empty(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "empty"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ZipDirectory(dir); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestZipDirectory_notFound(t *testing.T) {
+	t.Parallel()
+
+	if _, err := ZipDirectory(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string, perm os.FileMode) {
+	t.Helper()
+
+	for k, v := range files {
+		path := filepath.Join(dir, filepath.FromSlash(k))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(v), perm); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
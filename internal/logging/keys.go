@@ -5,6 +5,7 @@ package logging
 
 import (
 	"context"
+	"slices"
 
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -20,8 +21,15 @@ func ResourceAttributeKey(name string) string {
 	return "tf_aws.resource_attribute." + name
 }
 
+type sensitiveValueKeysContextKeyType int
+
+var sensitiveValueKeysContextKey sensitiveValueKeysContextKeyType
+
 // MaskSensitiveValuesByKey masks sensitive values using tflog
 func MaskSensitiveValuesByKey(ctx context.Context, keys ...string) context.Context {
+	// Record the keys so that other log sinks can apply the same masks.
+	ctx = context.WithValue(ctx, sensitiveValueKeysContextKey, slices.Concat(sensitiveValueKeys(ctx), keys))
+
 	l := baselogging.RetrieveLogger(ctx)
 
 	if _, ok := l.(baselogging.NullLogger); ok {
@@ -34,3 +42,13 @@ func MaskSensitiveValuesByKey(ctx context.Context, keys ...string) context.Conte
 
 	return ctx
 }
+
+// IsSensitiveValueKey returns whether values with the specified key are masked.
+func IsSensitiveValueKey(ctx context.Context, key string) bool {
+	return slices.Contains(sensitiveValueKeys(ctx), key)
+}
+
+func sensitiveValueKeys(ctx context.Context) []string {
+	v, _ := ctx.Value(sensitiveValueKeysContextKey).([]string)
+	return v
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// overrideAssumeRole returns any per-resource assume role override from the top-level `assume_role` block.
+func overrideAssumeRole(ctx context.Context, getAttribute getAttributeFunc) (*conns.AssumeRoleOverride, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var target fwtypes.ListNestedObjectValueOf[framework.AssumeRoleModel]
+	diags.Append(getAttribute(ctx, path.Root(names.AttrAssumeRole), &target)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	data, d := target.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// The role ARN may not be known during planning.
+	if data == nil || data.RoleARN.ValueString() == "" {
+		return nil, diags
+	}
+
+	return &conns.AssumeRoleOverride{
+		Duration:    data.Duration.ValueDuration(),
+		ExternalID:  data.ExternalID.ValueString(),
+		RoleARN:     data.RoleARN.ValueString(),
+		SessionName: data.SessionName.ValueString(),
+	}, diags
+}
+
+type resourceInjectAssumeRoleAttributeInterceptor struct{}
+
+func (r resourceInjectAssumeRoleAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[resource.SchemaRequest, resource.SchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Blocks[names.AttrAssumeRole]; !ok {
+			// Inject a top-level "assume_role" block.
+			if response.Schema.Blocks == nil {
+				response.Schema.Blocks = make(map[string]schema.Block)
+			}
+			response.Schema.Blocks[names.AttrAssumeRole] = schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[framework.AssumeRoleModel](ctx),
+				Description: names.ResourceTopLevelAssumeRoleBlockDescription,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrDuration: schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "Duration of the assume role session, e.g. `1h`.",
+						},
+						names.AttrExternalID: schema.StringAttribute{
+							Optional:    true,
+							Description: "External identifier to use when assuming the role.",
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(2, 1224),
+								stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@:/\-]*$`), ""),
+							},
+						},
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType:  fwtypes.ARNType,
+							Required:    true,
+							Description: "ARN of the IAM role to assume.",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						names.AttrSessionName: schema.StringAttribute{
+							Optional:    true,
+							Description: "Session name to use when assuming the role.",
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(2, 64),
+								stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@\-]*$`), ""),
+							},
+						},
+					},
+				},
+			}
+		}
+	}
+}
+
+// resourceInjectAssumeRoleAttribute injects a top-level "assume_role" block into a resource's schema.
+func resourceInjectAssumeRoleAttribute() resourceSchemaInterceptor {
+	return &resourceInjectAssumeRoleAttributeInterceptor{}
+}
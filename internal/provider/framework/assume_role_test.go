@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type assumeRoleTestResource struct {
+	framework.ResourceWithModel[assumeRoleTestResourceModel]
+
+	// The assume role override and account ID in effect when Create was called.
+	overrideAssumeRole *conns.AssumeRoleOverride
+	accountID          string
+}
+
+type assumeRoleTestResourceModel struct {
+	framework.WithAssumeRoleModel
+	Name types.String `tfsdk:"name"`
+}
+
+func (r *assumeRoleTestResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *assumeRoleTestResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if v, ok := conns.FromContext(ctx); ok {
+		r.overrideAssumeRole = v.OverrideAssumeRole()
+	}
+	r.accountID = r.Meta().AccountID(ctx)
+
+	response.State.Raw = request.Plan.Raw
+}
+
+func (r *assumeRoleTestResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+}
+
+func (r *assumeRoleTestResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+}
+
+func TestWrappedResourceAssumeRoleOverride(t *testing.T) {
+	t.Parallel()
+
+	const roleARN = "arn:aws:iam::111122223333:role/example" //lintignore:AWSAT005
+
+	testCases := map[string]struct {
+		assumeRole        []map[string]tftypes.Value
+		expectedOverride  *conns.AssumeRoleOverride
+		expectedAccountID string
+	}{
+		"no assume_role": {},
+		"assume_role": {
+			assumeRole: []map[string]tftypes.Value{
+				{
+					names.AttrDuration:    tftypes.NewValue(tftypes.String, "1h"),
+					names.AttrExternalID:  tftypes.NewValue(tftypes.String, "example-external-id"),
+					names.AttrRoleARN:     tftypes.NewValue(tftypes.String, roleARN),
+					names.AttrSessionName: tftypes.NewValue(tftypes.String, "example-session"),
+				},
+			},
+			expectedOverride: &conns.AssumeRoleOverride{
+				Duration:    time.Hour,
+				ExternalID:  "example-external-id",
+				RoleARN:     roleARN,
+				SessionName: "example-session",
+			},
+			expectedAccountID: "111122223333",
+		},
+		"assume_role role_arn only": {
+			assumeRole: []map[string]tftypes.Value{
+				{
+					names.AttrDuration:    tftypes.NewValue(tftypes.String, nil),
+					names.AttrExternalID:  tftypes.NewValue(tftypes.String, nil),
+					names.AttrRoleARN:     tftypes.NewValue(tftypes.String, roleARN),
+					names.AttrSessionName: tftypes.NewValue(tftypes.String, nil),
+				},
+			},
+			expectedOverride: &conns.AssumeRoleOverride{
+				RoleARN: roleARN,
+			},
+			expectedAccountID: "111122223333",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			inner := &assumeRoleTestResource{}
+			spec := &inttypes.ServicePackageFrameworkResource{
+				Factory: func(context.Context) (resource.ResourceWithConfigure, error) {
+					return inner, nil
+				},
+				TypeName:                    "aws_test_assume_role",
+				Name:                        "Test Assume Role",
+				IsAssumeRoleOverrideEnabled: true,
+			}
+			w := newWrappedResource(spec, "test")
+
+			var configureResponse resource.ConfigureResponse
+			w.Configure(ctx, resource.ConfigureRequest{ProviderData: &conns.AWSClient{}}, &configureResponse)
+			if configureResponse.Diagnostics.HasError() {
+				t.Fatalf("unexpected Configure diags: %s", configureResponse.Diagnostics)
+			}
+
+			var schemaResponse resource.SchemaResponse
+			w.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+			if schemaResponse.Diagnostics.HasError() {
+				t.Fatalf("unexpected Schema diags: %s", schemaResponse.Diagnostics)
+			}
+
+			s := schemaResponse.Schema
+			if _, ok := s.Blocks[names.AttrAssumeRole]; !ok {
+				t.Fatalf("expected %q block to be injected into schema", names.AttrAssumeRole)
+			}
+
+			objectType := s.Type().TerraformType(ctx).(tftypes.Object)
+			assumeRoleType := objectType.AttributeTypes[names.AttrAssumeRole].(tftypes.List)
+			var assumeRole []tftypes.Value
+			for _, v := range testCase.assumeRole {
+				assumeRole = append(assumeRole, tftypes.NewValue(assumeRoleType.ElementType, v))
+			}
+			raw := tftypes.NewValue(objectType, map[string]tftypes.Value{
+				names.AttrAssumeRole: tftypes.NewValue(assumeRoleType, assumeRole),
+				names.AttrName:       tftypes.NewValue(tftypes.String, "example"),
+			})
+
+			request := resource.CreateRequest{
+				Config: tfsdk.Config{Raw: raw, Schema: s},
+				Plan:   tfsdk.Plan{Raw: raw, Schema: s},
+			}
+			response := resource.CreateResponse{
+				State: tfsdk.State{Raw: tftypes.NewValue(objectType, nil), Schema: s},
+			}
+			w.Create(ctx, request, &response)
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected Create diags: %s", response.Diagnostics)
+			}
+
+			if diff := cmp.Diff(inner.overrideAssumeRole, testCase.expectedOverride); diff != "" {
+				t.Errorf("unexpected assume role override difference: %s", diff)
+			}
+			if got, expected := inner.accountID, testCase.expectedAccountID; got != expected {
+				t.Errorf("AccountID: got %q, expected %q", got, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/drift"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// explainDriftInterceptor reports any attributes changed outside of Terraform on resource Read.
+type explainDriftInterceptor struct {
+	resourceNoOpCRUDInterceptor
+	typeName string
+}
+
+func (r explainDriftInterceptor) read(ctx context.Context, opts interceptorOptions[resource.ReadRequest, resource.ReadResponse]) {
+	c := opts.c
+
+	if !c.ExplainDrift(ctx) {
+		return
+	}
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+		if request.State.Raw.IsNull() || response.State.Raw.IsNull() {
+			return
+		}
+
+		var prior, refreshed map[string]tftypes.Value
+		if err := request.State.Raw.As(&prior); err != nil {
+			return
+		}
+		if err := response.State.Raw.As(&refreshed); err != nil {
+			return
+		}
+
+		if isImportedState(prior) {
+			return
+		}
+
+		e := drift.NewExplanation()
+		for k, v := range refreshed {
+			if !v.Equal(prior[k]) {
+				e.AddChange(k)
+			}
+
+			if drift.IsMetadataAttribute(k) {
+				if s, ok := stringValue(v); ok {
+					e.AddMetadata(k, s)
+				}
+			}
+		}
+
+		if e.HasChanges() {
+			var id string
+			if v, ok := stringValue(refreshed[names.AttrID]); ok {
+				id = v
+			}
+
+			opts.response.Diagnostics.AddWarning(e.Summary(r.typeName), e.Detail(id))
+		}
+	}
+}
+
+// resourceExplainDrift reports any attributes changed outside of Terraform after Read.
+func resourceExplainDrift(typeName string) resourceCRUDInterceptor {
+	return &explainDriftInterceptor{
+		typeName: typeName,
+	}
+}
+
+// isImportedState returns whether the state only contains the attributes set on import,
+// in which case any values set on Read are not drift.
+func isImportedState(s map[string]tftypes.Value) bool {
+	for k, v := range s {
+		if k == names.AttrID || k == names.AttrRegion {
+			continue
+		}
+		if !v.IsNull() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// stringValue returns the value of a known, non-null, string.
+func stringValue(v tftypes.Value) (string, bool) {
+	if v.Type() == nil || !v.Type().Is(tftypes.String) || !v.IsKnown() || v.IsNull() {
+		return "", false
+	}
+
+	var s string
+	if err := v.As(&s); err != nil {
+		return "", false
+	}
+
+	return s, true
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type explainDriftClient struct {
+	mockClient
+	explainDrift bool
+}
+
+func (c explainDriftClient) ExplainDrift(context.Context) bool {
+	return c.explainDrift
+}
+
+func TestExplainDriftInterceptor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	const id = "abc"
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"last_modified_date": schema.StringAttribute{
+				Computed: true,
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+
+	objectType := s.Type().TerraformType(ctx).(tftypes.Object)
+	tagsType := tftypes.Map{ElementType: tftypes.String}
+	newValue := func(description, lastModifiedDate, tags any) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"description":        tftypes.NewValue(tftypes.String, description),
+			"id":                 tftypes.NewValue(tftypes.String, id),
+			"last_modified_date": tftypes.NewValue(tftypes.String, lastModifiedDate),
+			"region":             tftypes.NewValue(tftypes.String, "us-west-2"), //lintignore:AWSAT003
+			"tags":               tftypes.NewValue(tagsType, tags),
+		})
+	}
+	tags := func(kv ...string) any {
+		m := make(map[string]tftypes.Value)
+		for i := 0; i < len(kv); i += 2 {
+			m[kv[i]] = tftypes.NewValue(tftypes.String, kv[i+1])
+		}
+		return m
+	}
+
+	testCases := map[string]struct {
+		explainDrift bool
+		prior        tftypes.Value
+		refreshed    tftypes.Value
+		expected     diag.Diagnostics
+	}{
+		"disabled": {
+			prior:     newValue("a", nil, nil),
+			refreshed: newValue("b", nil, nil),
+		},
+		"no changes": {
+			explainDrift: true,
+			prior:        newValue("a", "2025-01-01T00:00:00Z", tags("Name", "test")),
+			refreshed:    newValue("a", "2025-01-01T00:00:00Z", tags("Name", "test")),
+		},
+		"changes": {
+			explainDrift: true,
+			prior:        newValue("a", "2025-01-01T00:00:00Z", nil),
+			refreshed:    newValue("b", "2025-01-02T00:00:00Z", nil),
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"aws_test changed outside of Terraform",
+					"Refreshing \"abc\" found that the following attributes differ from their values in prior state: description.\n\nModification metadata reported by AWS:\n  last_modified_date = 2025-01-02T00:00:00Z",
+				),
+			},
+		},
+		"map elements": {
+			explainDrift: true,
+			prior:        newValue("a", nil, tags("Name", "test")),
+			refreshed:    newValue("a", nil, tags("Name", "test", "Env", "prod")),
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"aws_test changed outside of Terraform",
+					"Refreshing \"abc\" found that the following attributes differ from their values in prior state: tags.",
+				),
+			},
+		},
+		"import": {
+			explainDrift: true,
+			prior:        newValue(nil, nil, nil),
+			refreshed:    newValue("b", nil, tags("Name", "test")),
+		},
+		"resource removed": {
+			explainDrift: true,
+			prior:        newValue("a", nil, nil),
+			refreshed:    tftypes.NewValue(objectType, nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := explainDriftClient{explainDrift: testCase.explainDrift}
+			interceptor := resourceExplainDrift("aws_test")
+
+			request := resource.ReadRequest{
+				State: tfsdk.State{
+					Raw:    testCase.prior,
+					Schema: s,
+				},
+			}
+			response := resource.ReadResponse{
+				State: tfsdk.State{
+					Raw:    testCase.refreshed,
+					Schema: s,
+				},
+			}
+
+			interceptor.read(ctx, interceptorOptions[resource.ReadRequest, resource.ReadResponse]{
+				c:        client,
+				request:  &request,
+				response: &response,
+				when:     After,
+			})
+
+			if diff := cmp.Diff(response.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
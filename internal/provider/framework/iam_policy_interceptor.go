@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+type resourceValidateIAMPoliciesInterceptor struct{}
+
+func (r resourceValidateIAMPoliciesInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	mode := c.IAMPolicyValidation(ctx)
+	if mode == "" {
+		return
+	}
+
+	switch request, when := opts.request, opts.when; when {
+	case Before:
+		// If the entire plan is null, the resource is planned for destruction.
+		if request.Plan.Raw.IsNull() {
+			return
+		}
+
+		addDiagnostic := opts.response.Diagnostics.AddAttributeWarning
+		if mode == conns.IAMPolicyValidationError {
+			addDiagnostic = opts.response.Diagnostics.AddAttributeError
+		}
+
+		for _, v := range changedIAMPolicies(ctx, request.Plan, request.State) {
+			findings, err := c.ValidateIAMPolicy(ctx, v.attrName, v.document)
+			if err != nil {
+				addDiagnostic(v.path, "Unable to validate IAM policy", err.Error())
+				continue
+			}
+
+			if len(findings) > 0 {
+				addDiagnostic(v.path, "IAM policy has Access Analyzer findings", iamPolicyFindingsString(findings))
+			}
+		}
+	}
+}
+
+// resourceValidateIAMPolicies reports Access Analyzer findings for changed IAM policy attributes at plan time
+// if the provider's iam_policy_validation is set.
+func resourceValidateIAMPolicies() resourceModifyPlanInterceptor {
+	return &resourceValidateIAMPoliciesInterceptor{}
+}
+
+// iamPolicyValue is an IAM policy document in a resource's planned new state.
+type iamPolicyValue struct {
+	path     path.Path
+	attrName string
+	document string
+}
+
+// changedIAMPolicies returns the known IAM policy documents in the planned new state that are not equivalent to those in the prior state.
+// IAM policy attributes are those of type fwtypes.IAMPolicyType.
+func changedIAMPolicies(ctx context.Context, plan tfsdk.Plan, state tfsdk.State) []iamPolicyValue {
+	var values []iamPolicyValue
+
+	_ = tftypes.Walk(plan.Raw, func(p *tftypes.AttributePath, v tftypes.Value) (bool, error) {
+		steps := p.Steps()
+		if len(steps) == 0 {
+			return true, nil
+		}
+
+		attrName, ok := steps[len(steps)-1].(tftypes.AttributeName)
+		if !ok {
+			return true, nil
+		}
+
+		// Blocks are not attributes.
+		a, err := plan.Schema.AttributeAtTerraformPath(ctx, p)
+		if err != nil {
+			return true, nil
+		}
+
+		if !a.GetType().Equal(fwtypes.IAMPolicyType) {
+			return true, nil
+		}
+
+		var document string
+		if !v.IsKnown() || v.IsNull() || v.As(&document) != nil || document == "" {
+			return false, nil
+		}
+
+		if prior, ok := stringAtTerraformPath(state.Raw, p); ok && verify.PolicyStringsEquivalent(prior, document) {
+			return false, nil
+		}
+
+		attrPath, err := frameworkPath(ctx, plan.Schema, p)
+		if err != nil {
+			return false, nil
+		}
+
+		values = append(values, iamPolicyValue{
+			path:     attrPath,
+			attrName: string(attrName),
+			document: document,
+		})
+
+		return false, nil
+	})
+
+	return values
+}
+
+// stringAtTerraformPath returns the known string value at the specified path.
+func stringAtTerraformPath(v tftypes.Value, p *tftypes.AttributePath) (string, bool) {
+	if v.IsNull() {
+		return "", false
+	}
+
+	raw, _, err := tftypes.WalkAttributePath(v, p)
+	if err != nil {
+		return "", false
+	}
+
+	value, ok := raw.(tftypes.Value)
+	if !ok || !value.IsKnown() || value.IsNull() {
+		return "", false
+	}
+
+	var s string
+	if err := value.As(&s); err != nil {
+		return "", false
+	}
+
+	return s, true
+}
+
+// frameworkPath converts the specified Terraform path to a framework path.
+func frameworkPath(ctx context.Context, s interface {
+	TypeAtTerraformPath(context.Context, *tftypes.AttributePath) (attr.Type, error)
+}, p *tftypes.AttributePath) (path.Path, error) {
+	var result path.Path
+	walked := tftypes.NewAttributePath()
+
+	for _, step := range p.Steps() {
+		switch step := step.(type) {
+		case tftypes.AttributeName:
+			walked = walked.WithAttributeName(string(step))
+			result = result.AtName(string(step))
+		case tftypes.ElementKeyInt:
+			walked = walked.WithElementKeyInt(int(step))
+			result = result.AtListIndex(int(step))
+		case tftypes.ElementKeyString:
+			walked = walked.WithElementKeyString(string(step))
+			result = result.AtMapKey(string(step))
+		case tftypes.ElementKeyValue:
+			walked = walked.WithElementKeyValue(tftypes.Value(step))
+
+			t, err := s.TypeAtTerraformPath(ctx, walked)
+			if err != nil {
+				return path.Empty(), err
+			}
+
+			v, err := t.ValueFromTerraform(ctx, tftypes.Value(step))
+			if err != nil {
+				return path.Empty(), err
+			}
+
+			result = result.AtSetValue(v)
+		}
+	}
+
+	return result, nil
+}
+
+func iamPolicyFindingsString(findings []conns.IAMPolicyFinding) string {
+	var lines []string
+	for _, v := range findings {
+		lines = append(lines, "- "+v.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestChangedIAMPolicies(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	const (
+		policy1          = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+		policy1Formatted = `{
+  "Version": "2012-10-17",
+  "Statement": {"Effect": "Allow", "Action": ["s3:GetObject"], "Resource": "*"}
+}`
+		policy2 = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`
+	)
+
+	policyAttributes := map[string]schema.Attribute{
+		"policy": schema.StringAttribute{
+			CustomType: fwtypes.IAMPolicyType,
+			Optional:   true,
+		},
+	}
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"inline_policy": schema.SetNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: policyAttributes,
+				},
+				Optional: true,
+			},
+			"policy": schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Optional:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"statement": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: policyAttributes,
+				},
+			},
+		},
+	}
+
+	objectType := s.Type().TerraformType(ctx).(tftypes.Object)
+	elemType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"policy": tftypes.String,
+		},
+	}
+	elem := func(v any) tftypes.Value {
+		return tftypes.NewValue(elemType, map[string]tftypes.Value{
+			"policy": tftypes.NewValue(tftypes.String, v),
+		})
+	}
+	newValue := func(policy any, inlinePolicies, statements []tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"description":   tftypes.NewValue(tftypes.String, policy1),
+			"inline_policy": tftypes.NewValue(tftypes.Set{ElementType: elemType}, inlinePolicies),
+			"policy":        tftypes.NewValue(tftypes.String, policy),
+			"statement":     tftypes.NewValue(tftypes.List{ElementType: elemType}, statements),
+		})
+	}
+	inlinePolicyPath := func(v string) path.Path {
+		return path.Root("inline_policy").AtSetValue(types.ObjectValueMust(
+			map[string]attr.Type{
+				"policy": fwtypes.IAMPolicyType,
+			},
+			map[string]attr.Value{
+				"policy": fwtypes.IAMPolicyValue(v),
+			},
+		)).AtName("policy")
+	}
+
+	testCases := map[string]struct {
+		plan, state tftypes.Value
+		expected    path.Paths
+	}{
+		"create": {
+			plan:  newValue(policy1, []tftypes.Value{elem(policy1)}, []tftypes.Value{elem(policy2)}),
+			state: tftypes.NewValue(objectType, nil),
+			expected: path.Paths{
+				inlinePolicyPath(policy1),
+				path.Root("policy"),
+				path.Root("statement").AtListIndex(0).AtName("policy"),
+			},
+		},
+		"create unknown": {
+			plan:  newValue(tftypes.UnknownValue, []tftypes.Value{elem(tftypes.UnknownValue)}, []tftypes.Value{elem(nil)}),
+			state: tftypes.NewValue(objectType, nil),
+		},
+		"update equivalent": {
+			plan:  newValue(policy1Formatted, []tftypes.Value{elem(policy1)}, []tftypes.Value{elem(policy1Formatted)}),
+			state: newValue(policy1, []tftypes.Value{elem(policy1)}, []tftypes.Value{elem(policy1)}),
+		},
+		"update changed": {
+			plan:  newValue(policy2, []tftypes.Value{elem(policy1), elem(policy2)}, []tftypes.Value{elem(policy1), elem(policy2)}),
+			state: newValue(policy1, []tftypes.Value{elem(policy1)}, []tftypes.Value{elem(policy1)}),
+			expected: path.Paths{
+				inlinePolicyPath(policy2),
+				path.Root("policy"),
+				path.Root("statement").AtListIndex(1).AtName("policy"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := tfsdk.Plan{
+				Raw:    testCase.plan,
+				Schema: s,
+			}
+			state := tfsdk.State{
+				Raw:    testCase.state,
+				Schema: s,
+			}
+
+			values := changedIAMPolicies(ctx, plan, state)
+
+			if got, expected := len(values), len(testCase.expected); got != expected {
+				t.Fatalf("length of values = %d, want %d", got, expected)
+			}
+
+			for i, v := range values {
+				if !v.path.Equal(testCase.expected[i]) {
+					t.Errorf("path %d = %s, want %s", i, v.path, testCase.expected[i])
+				}
+				if got, expected := v.attrName, "policy"; got != expected {
+					t.Errorf("attribute name %d = %q, want %q", i, got, expected)
+				}
+			}
+		})
+	}
+}
@@ -25,6 +25,10 @@ type awsClient interface {
 	Region(context.Context) string
 	DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig
 	IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig
+	ExplainDrift(ctx context.Context) bool
+	IAMPolicyValidation(ctx context.Context) string
+	ValidateIAMPolicy(ctx context.Context, attrName, document string) ([]conns.IAMPolicyFinding, error)
+	TagPolicyConfig(ctx context.Context) *tftags.PolicyConfig
 	Partition(context.Context) string
 	ServicePackage(_ context.Context, name string) conns.ServicePackage
 	ValidateInContextRegionInPartition(ctx context.Context) error
@@ -30,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/drift"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
@@ -107,6 +108,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"api_call_log_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file to which a JSON line is appended for each AWS API call, with request bodies masked as for debug logging.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -119,6 +124,14 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "Protocol to use with EC2 metadata service endpoint.Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"endpoint_profile": schema.StringAttribute{
+				Optional:    true,
+				Description: "Use a local AWS API stand-in for all services. Valid values are `localstack`, which uses `http://localhost:4566` unless `local_endpoint` is set.",
+			},
+			"explain_drift": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Report attributes of resources that changed outside of Terraform as warnings during refresh. Can also be enabled with the `" + drift.ExplainDriftEnvVar + "` environment variable.",
+			},
 			"forbidden_account_ids": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -131,10 +144,22 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 			},
+			"iam_policy_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file to which an IAM policy document allowing the AWS API operations invoked, with one statement per resource type, is written.",
+			},
+			"iam_policy_validation": schema.StringAttribute{
+				Optional:    true,
+				Description: "Validate IAM policy attributes with IAM Access Analyzer. Valid values are `warning`, which reports ERROR and SECURITY_WARNING findings as warnings, and `error`, which reports them as errors at plan time, blocking apply.",
+			},
 			"insecure": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
 			},
+			"local_endpoint": schema.StringAttribute{
+				Optional:    true,
+				Description: "Base URL of a local AWS API stand-in, e.g. an emulator, used as the endpoint for all services not set in `endpoints`. Enables S3 path-style addressing and skips credentials, region and account ID validation.",
+			},
 			"max_retries": schema.Int64Attribute{
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
@@ -147,6 +172,10 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
 			},
+			"read_only_mode": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Block any AWS API operation that is not on the provider's generated read-only allowlist. Used to verify that plans only make read-only AWS API calls.",
+			},
 			"region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
@@ -339,6 +368,66 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"service_rate_limits": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to limit the rate and concurrency of AWS API requests to individual services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_in_flight": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of concurrent API operations, including retries, made to the service.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The maximum rate of API requests, including retries, made to the service.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service to limit. Valid values are the same as the `endpoints` block's argument names.",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to validate resource tags across all resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"key_case": schema.StringAttribute{
+							Optional:    true,
+							Description: "Case that resource tag keys must use. Valid values are `camel`, `lower`, `pascal` and `upper`.",
+						},
+						"required_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag keys that are required on all resources.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"allowed_values": schema.ListNestedBlock{
+							Description: "Configuration blocks with the allowed values of a resource tag.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrKey: schema.StringAttribute{
+										Required:    true,
+										Description: "Resource tag key.",
+									},
+									"pattern": schema.StringAttribute{
+										Optional:    true,
+										Description: "Regular expression that allowed resource tag values match.",
+									},
+									names.AttrValues: schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Allowed resource tag values.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -397,7 +486,12 @@ func (p *frameworkProvider) Functions(_ context.Context) []func() function.Funct
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRSubnetsAWSFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewIAMPolicyStatementFilterFunction,
 		tffunction.NewTrimIAMRolePathFunction,
+		tffunction.NewVPCIPv6SubnetFunction,
 	}
 }
 
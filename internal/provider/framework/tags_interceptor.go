@@ -243,6 +243,24 @@ func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, opts intercepto
 		if planTags.IsWhollyKnown() {
 			allTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, planTags)).IgnoreConfig(c.IgnoreTagsConfig(ctx))
 			opts.response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), fwflex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
+
+			// Report any violations of the provider configured tag_policy.
+			// Only new resources and resources whose tags change are validated, so that existing non-compliant resources can still be updated.
+			if tagPolicyConfig := c.TagPolicyConfig(ctx); tagPolicyConfig != nil {
+				var stateTagsAll tftags.Map
+				if !request.State.Raw.IsNull() {
+					opts.response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &stateTagsAll)...)
+					if opts.response.Diagnostics.HasError() {
+						return
+					}
+				}
+
+				if request.State.Raw.IsNull() || !tftags.New(ctx, stateTagsAll).DeepEqual(allTags) {
+					if err := allTags.ValidatePolicy(tagPolicyConfig); err != nil {
+						opts.response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), "Tags do not comply with the provider tag_policy", err.Error())
+					}
+				}
+			}
 		} else {
 			opts.response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), tftags.Unknown)...)
 		}
@@ -95,6 +95,7 @@ func (w *wrappedDataSource) context(ctx context.Context, getAttribute getAttribu
 	}
 
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, overrideRegion)
+	ctx = conns.WithTypeName(ctx, w.spec.TypeName)
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
@@ -245,6 +246,7 @@ func (w *wrappedEphemeralResource) context(ctx context.Context, getAttribute get
 	}
 
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, overrideRegion)
+	ctx = conns.WithTypeName(ctx, w.spec.TypeName)
 	if c != nil {
 		ctx = c.RegisterLogger(ctx)
 		ctx = fwflex.RegisterLogger(ctx)
@@ -415,6 +417,7 @@ func (w *wrappedAction) context(ctx context.Context, getAttribute getAttributeFu
 	}
 
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, overrideRegion)
+	ctx = conns.WithTypeName(ctx, w.spec.TypeName)
 	if c != nil {
 		ctx = c.RegisterLogger(ctx)
 		ctx = fwflex.RegisterLogger(ctx)
@@ -527,6 +530,11 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 
 	var interceptors interceptorInvocations
 
+	// Drift is explained once all other Read interceptors have run.
+	interceptors = append(interceptors, resourceExplainDrift(spec.TypeName))
+
+	interceptors = append(interceptors, resourceValidateIAMPolicies())
+
 	if isRegionOverrideEnabled {
 		v := spec.Region.Value()
 
@@ -544,6 +552,10 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 		}
 	}
 
+	if spec.IsAssumeRoleOverrideEnabled {
+		interceptors = append(interceptors, resourceInjectAssumeRoleAttribute())
+	}
+
 	if !tfunique.IsHandleNil(spec.Tags) {
 		interceptors = append(interceptors, resourceTransparentTagging(spec.Tags))
 	}
@@ -603,6 +615,20 @@ func (w *wrappedResource) context(ctx context.Context, getAttribute getAttribute
 	}
 
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, overrideRegion)
+	ctx = conns.WithTypeName(ctx, w.spec.TypeName)
+
+	if w.spec.IsAssumeRoleOverrideEnabled && getAttribute != nil {
+		v, d := overrideAssumeRole(ctx, getAttribute)
+		diags.Append(d...)
+		if diags.HasError() {
+			return ctx, diags
+		}
+
+		if v != nil {
+			ctx = conns.WithOverrideAssumeRole(ctx, v)
+		}
+	}
+
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
@@ -882,6 +908,7 @@ func (w *wrappedListResourceFramework) context(ctx context.Context, getAttribute
 	}
 
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, overrideRegion)
+	ctx = conns.WithTypeName(ctx, w.spec.TypeName)
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
@@ -1009,6 +1036,7 @@ func (w *wrappedListResourceSDK) context(ctx context.Context, getAttribute getAt
 	}
 
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, overrideRegion)
+	ctx = conns.WithTypeName(ctx, w.spec.TypeName)
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/drift"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// explainDriftInterceptor reports any attributes changed outside of Terraform on resource Read.
+type explainDriftInterceptor struct {
+	typeName string
+	// Prior state, keyed by the in-flight Read request's resource data.
+	priorStates sync.Map
+}
+
+func resourceExplainDrift(typeName string) interceptorInvocation {
+	return interceptorInvocation{
+		when: Before | After | Finally,
+		why:  Read,
+		interceptor: &explainDriftInterceptor{
+			typeName: typeName,
+		},
+	}
+}
+
+func (r *explainDriftInterceptor) run(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	c, d := opts.c, opts.d
+	if !c.ExplainDrift(ctx) {
+		return diags
+	}
+
+	v, ok := d.(interface {
+		State() *terraform.InstanceState
+	})
+	if !ok {
+		return diags
+	}
+
+	switch opts.when {
+	case Before:
+		if prior := v.State(); prior != nil && !isImportedState(prior) {
+			r.priorStates.Store(d, prior)
+		}
+	case After:
+		prior, ok := r.priorStates.Load(d)
+		if !ok {
+			break
+		}
+
+		// Will occur on a refresh when the resource does not exist in AWS.
+		refreshed := v.State()
+		if d.Id() == "" || refreshed == nil {
+			break
+		}
+
+		if e := drift.ExplainFlatmap(prior.(*terraform.InstanceState).Attributes, refreshed.Attributes); e.HasChanges() {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  e.Summary(r.typeName),
+				Detail:   e.Detail(d.Id()),
+			})
+		}
+	case Finally:
+		r.priorStates.Delete(d)
+	}
+
+	return diags
+}
+
+// isImportedState returns whether the state only contains the attributes set on import,
+// in which case any values set on Read are not drift.
+func isImportedState(s *terraform.InstanceState) bool {
+	for k := range s.Attributes {
+		if k != names.AttrID && k != names.AttrRegion {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+type explainDriftClient struct {
+	mockClient
+	explainDrift bool
+}
+
+func (c explainDriftClient) ExplainDrift(context.Context) bool {
+	return c.explainDrift
+}
+
+type explainDriftResourceData struct {
+	resourceData
+	id    string
+	state *terraform.InstanceState
+}
+
+func (d *explainDriftResourceData) Id() string {
+	return d.id
+}
+
+func (d *explainDriftResourceData) State() *terraform.InstanceState {
+	return d.state
+}
+
+func TestExplainDriftInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const id = "abc"
+
+	testCases := map[string]struct {
+		explainDrift bool
+		prior        map[string]string
+		refreshed    map[string]string
+		expected     diag.Diagnostics
+	}{
+		"disabled": {
+			prior: map[string]string{
+				"id":          id,
+				"description": "a",
+			},
+			refreshed: map[string]string{
+				"id":          id,
+				"description": "b",
+			},
+		},
+		"no changes": {
+			explainDrift: true,
+			prior: map[string]string{
+				"id":          id,
+				"description": "a",
+				"tags.%":      "0",
+			},
+			refreshed: map[string]string{
+				"id":          id,
+				"description": "a",
+				"tags.%":      "0",
+			},
+		},
+		"changes": {
+			explainDrift: true,
+			prior: map[string]string{
+				"id":                 id,
+				"description":        "a",
+				"last_modified_date": "2025-01-01T00:00:00Z",
+			},
+			refreshed: map[string]string{
+				"id":                 id,
+				"description":        "b",
+				"last_modified_date": "2025-01-02T00:00:00Z",
+			},
+			expected: diag.Diagnostics{
+				{
+					Severity: diag.Warning,
+					Summary:  "aws_test changed outside of Terraform",
+					Detail:   "Refreshing \"abc\" found that the following attributes differ from their values in prior state: description.\n\nModification metadata reported by AWS:\n  last_modified_date = 2025-01-02T00:00:00Z",
+				},
+			},
+		},
+		"flatmap keys": {
+			explainDrift: true,
+			prior: map[string]string{
+				"id":        id,
+				"tags.%":    "1",
+				"tags.Name": "test",
+				"rule.#":    "1",
+				"rule.0.to": "80",
+			},
+			refreshed: map[string]string{
+				"id":        id,
+				"tags.%":    "2",
+				"tags.Name": "test",
+				"tags.Env":  "prod",
+				"rule.#":    "1",
+				"rule.0.to": "80",
+			},
+			expected: diag.Diagnostics{
+				{
+					Severity: diag.Warning,
+					Summary:  "aws_test changed outside of Terraform",
+					Detail:   "Refreshing \"abc\" found that the following attributes differ from their values in prior state: tags.",
+				},
+			},
+		},
+		"import": {
+			explainDrift: true,
+			prior: map[string]string{
+				"id":     id,
+				"region": "us-west-2", //lintignore:AWSAT003
+			},
+			refreshed: map[string]string{
+				"id":          id,
+				"region":      "us-west-2", //lintignore:AWSAT003
+				"description": "b",
+			},
+		},
+		"resource removed": {
+			explainDrift: true,
+			prior: map[string]string{
+				"id":          id,
+				"description": "a",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			client := explainDriftClient{explainDrift: testCase.explainDrift}
+			invocation := resourceExplainDrift("aws_test")
+			interceptor := invocation.interceptor.(*explainDriftInterceptor)
+
+			d := &explainDriftResourceData{
+				id: id,
+				state: &terraform.InstanceState{
+					ID:         id,
+					Attributes: testCase.prior,
+				},
+			}
+
+			var diags diag.Diagnostics
+			for _, v := range []when{Before, After, Finally} {
+				if v == After {
+					// Simulate Read, which clears the ID if the resource no longer exists.
+					if testCase.refreshed == nil {
+						d.id = ""
+						d.state = nil
+					} else {
+						d.state = &terraform.InstanceState{
+							ID:         id,
+							Attributes: testCase.refreshed,
+						}
+					}
+				}
+
+				opts := crudInterceptorOptions{
+					c:    client,
+					d:    d,
+					when: v,
+					why:  Read,
+				}
+				diags = append(diags, interceptor.run(ctx, opts)...)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			interceptor.priorStates.Range(func(k, _ any) bool {
+				t.Errorf("prior state not deleted for %v", k)
+				return true
+			})
+		})
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// validateIAMPoliciesOnPlan reports Access Analyzer findings for changed IAM policy attributes as errors at plan time
+// if the provider's iam_policy_validation is `error`.
+// Only errors can be returned from CustomizeDiff, so findings are reported as warnings by validateIAMPoliciesOnApply.
+func validateIAMPoliciesOnPlan(attrPaths []string) customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		if c.IAMPolicyValidation(ctx) != conns.IAMPolicyValidationError {
+			return nil
+		}
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				var errs []error
+				for _, v := range changedIAMPolicies(attrPaths, d) {
+					findings, err := c.ValidateIAMPolicy(ctx, v.attrName, v.document)
+					if err != nil {
+						errs = append(errs, fmt.Errorf("%s: %w", iamPolicyPathString(v.path), err))
+						continue
+					}
+
+					if len(findings) > 0 {
+						errs = append(errs, fmt.Errorf("%s: IAM policy has Access Analyzer findings:\n%s", iamPolicyPathString(v.path), iamPolicyFindingsString(findings)))
+					}
+				}
+
+				return errors.Join(errs...)
+			}
+		}
+
+		return nil
+	})
+}
+
+// validateIAMPoliciesOnApply reports Access Analyzer findings for changed IAM policy attributes as warnings before Create and Update
+// if the provider's iam_policy_validation is `warning`.
+func validateIAMPoliciesOnApply(attrPaths []string) crudInterceptor {
+	return interceptorFunc1[schemaResourceData, diag.Diagnostics](func(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+		var diags diag.Diagnostics
+		c := opts.c
+
+		if c.IAMPolicyValidation(ctx) != conns.IAMPolicyValidationWarning {
+			return diags
+		}
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case Create, Update:
+				for _, v := range changedIAMPolicies(attrPaths, d) {
+					findings, err := c.ValidateIAMPolicy(ctx, v.attrName, v.document)
+					if err != nil {
+						diags = append(diags, errs.NewAttributeWarningDiagnostic(v.path, "Unable to validate IAM policy", err.Error()))
+						continue
+					}
+
+					if len(findings) > 0 {
+						diags = append(diags, errs.NewAttributeWarningDiagnostic(v.path, "IAM policy has Access Analyzer findings", iamPolicyFindingsString(findings)))
+					}
+				}
+			}
+		}
+
+		return diags
+	})
+}
+
+// iamPolicyValue is an IAM policy document in a resource's planned new state.
+type iamPolicyValue struct {
+	path     cty.Path
+	attrName string
+	document string
+}
+
+// changedIAMPolicies returns the known IAM policy documents in the planned new state that are not equivalent to those in the prior state.
+// IAM policy attributes are those at the specified paths, as listed in iamPolicyAttributes.
+func changedIAMPolicies(attrPaths []string, d sdkv2.ResourceDiffer) []iamPolicyValue {
+	var values []iamPolicyValue
+
+	plan, state := d.GetRawPlan(), d.GetRawState()
+	if plan.IsNull() || !plan.IsKnown() {
+		return values
+	}
+
+	_ = cty.Walk(plan, func(path cty.Path, v cty.Value) (bool, error) {
+		if len(path) == 0 {
+			return true, nil
+		}
+
+		step, ok := path[len(path)-1].(cty.GetAttrStep)
+		if !ok {
+			return true, nil
+		}
+
+		if !slices.Contains(attrPaths, iamPolicyAttributePath(path)) {
+			return true, nil
+		}
+
+		if !v.IsKnown() || v.IsNull() || !v.Type().Equals(cty.String) || v.AsString() == "" {
+			return false, nil
+		}
+
+		document := v.AsString()
+		if prior, ok := stringAtPath(state, path); ok && verify.PolicyStringsEquivalent(prior, document) {
+			return false, nil
+		}
+
+		values = append(values, iamPolicyValue{
+			path:     path.Copy(),
+			attrName: step.Name,
+			document: document,
+		})
+
+		return false, nil
+	})
+
+	return values
+}
+
+// iamPolicyAttributePath returns the dot-separated attribute names in the specified path.
+func iamPolicyAttributePath(path cty.Path) string {
+	var attrNames []string
+
+	for _, step := range path {
+		if step, ok := step.(cty.GetAttrStep); ok {
+			attrNames = append(attrNames, step.Name)
+		}
+	}
+
+	return strings.Join(attrNames, ".")
+}
+
+// stringAtPath returns the known string value at the specified path.
+// Unlike cty.Path.Apply, set elements are found by value.
+func stringAtPath(v cty.Value, path cty.Path) (string, bool) {
+	for _, step := range path {
+		if v.IsNull() || !v.IsKnown() {
+			return "", false
+		}
+
+		switch step := step.(type) {
+		case cty.GetAttrStep:
+			if !v.Type().IsObjectType() || !v.Type().HasAttribute(step.Name) {
+				return "", false
+			}
+			v = v.GetAttr(step.Name)
+		case cty.IndexStep:
+			if v.Type().IsSetType() {
+				if has := v.HasElement(step.Key); !has.IsKnown() || has.False() {
+					return "", false
+				}
+				v = step.Key
+				continue
+			}
+
+			if !v.Type().IsListType() && !v.Type().IsMapType() {
+				return "", false
+			}
+			if has := v.HasIndex(step.Key); !has.IsKnown() || has.False() {
+				return "", false
+			}
+			v = v.Index(step.Key)
+		}
+	}
+
+	if v.IsNull() || !v.IsKnown() || !v.Type().Equals(cty.String) {
+		return "", false
+	}
+
+	return v.AsString(), true
+}
+
+// iamPolicyPathString returns a human-readable form of the specified attribute path.
+// Set elements are not addressable and are shown as `[*]`.
+func iamPolicyPathString(path cty.Path) string {
+	var sb strings.Builder
+
+	for i, step := range path {
+		switch step := step.(type) {
+		case cty.GetAttrStep:
+			if i > 0 {
+				sb.WriteString(".")
+			}
+			sb.WriteString(step.Name)
+		case cty.IndexStep:
+			switch step.Key.Type() {
+			case cty.Number:
+				fmt.Fprintf(&sb, "[%s]", step.Key.AsBigFloat().String())
+			case cty.String:
+				fmt.Fprintf(&sb, "[%q]", step.Key.AsString())
+			default:
+				sb.WriteString("[*]")
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+func iamPolicyFindingsString(findings []conns.IAMPolicyFinding) string {
+	var lines []string
+	for _, v := range findings {
+		lines = append(lines, "- "+v.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
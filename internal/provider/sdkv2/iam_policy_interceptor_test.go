@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+)
+
+type iamPolicyResourceData struct {
+	resourceData
+	plan, state cty.Value
+}
+
+func (d *iamPolicyResourceData) GetRawPlan() cty.Value {
+	return d.plan
+}
+
+func (d *iamPolicyResourceData) GetRawState() cty.Value { // nosemgrep:ci.aws-in-func-name
+	return d.state
+}
+
+func TestChangedIAMPolicies(t *testing.T) {
+	t.Parallel()
+
+	const (
+		policy1          = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+		policy1Formatted = `{
+  "Version": "2012-10-17",
+  "Statement": {"Effect": "Allow", "Action": ["s3:GetObject"], "Resource": "*"}
+}`
+		policy2 = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`
+	)
+
+	attrPaths := []string{
+		"assume_role_policy",
+		"inline_policy.policy",
+		"statement.policy",
+	}
+
+	elemType := cty.Object(map[string]cty.Type{
+		"policy": cty.String,
+	})
+	newValue := func(assumeRolePolicy cty.Value, inlinePolicies, statements []cty.Value) cty.Value {
+		inlinePolicy := cty.SetValEmpty(elemType)
+		if len(inlinePolicies) > 0 {
+			inlinePolicy = cty.SetVal(inlinePolicies)
+		}
+		statement := cty.ListValEmpty(elemType)
+		if len(statements) > 0 {
+			statement = cty.ListVal(statements)
+		}
+
+		return cty.ObjectVal(map[string]cty.Value{
+			"assume_role_policy": assumeRolePolicy,
+			"description":        cty.StringVal(policy1),
+			"inline_policy":      inlinePolicy,
+			"statement":          statement,
+		})
+	}
+	elem := func(v cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"policy": v,
+		})
+	}
+
+	testCases := map[string]struct {
+		plan, state cty.Value
+		expected    []string
+	}{
+		"create": {
+			plan: newValue(cty.StringVal(policy1), []cty.Value{elem(cty.StringVal(policy1))}, []cty.Value{elem(cty.StringVal(policy2))}),
+			expected: []string{
+				"assume_role_policy",
+				"inline_policy[*].policy",
+				"statement[0].policy",
+			},
+		},
+		"create unknown": {
+			plan: newValue(cty.UnknownVal(cty.String), []cty.Value{elem(cty.UnknownVal(cty.String))}, []cty.Value{elem(cty.NullVal(cty.String))}),
+		},
+		"update equivalent": {
+			plan:  newValue(cty.StringVal(policy1Formatted), []cty.Value{elem(cty.StringVal(policy1))}, []cty.Value{elem(cty.StringVal(policy1Formatted))}),
+			state: newValue(cty.StringVal(policy1), []cty.Value{elem(cty.StringVal(policy1))}, []cty.Value{elem(cty.StringVal(policy1))}),
+		},
+		"update changed": {
+			plan:  newValue(cty.StringVal(policy2), []cty.Value{elem(cty.StringVal(policy1)), elem(cty.StringVal(policy2))}, []cty.Value{elem(cty.StringVal(policy1)), elem(cty.StringVal(policy2))}),
+			state: newValue(cty.StringVal(policy1), []cty.Value{elem(cty.StringVal(policy1))}, []cty.Value{elem(cty.StringVal(policy1))}),
+			expected: []string{
+				"assume_role_policy",
+				"inline_policy[*].policy",
+				"statement[1].policy",
+			},
+		},
+		"destroy": {
+			plan:  cty.NullVal(cty.DynamicPseudoType),
+			state: newValue(cty.StringVal(policy1), nil, nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := testCase.state
+			if state == cty.NilVal {
+				state = cty.NullVal(testCase.plan.Type())
+			}
+			d := &iamPolicyResourceData{
+				plan:  testCase.plan,
+				state: state,
+			}
+
+			var got []string
+			for _, v := range changedIAMPolicies(attrPaths, d) {
+				got = append(got, iamPolicyPathString(v.path))
+
+				if v.attrName != v.path[len(v.path)-1].(cty.GetAttrStep).Name {
+					t.Errorf("attribute name: got %q for %s", v.attrName, iamPolicyPathString(v.path))
+				}
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
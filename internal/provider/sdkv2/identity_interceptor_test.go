@@ -307,6 +307,22 @@ func (c mockClient) IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig {
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) ExplainDrift(context.Context) bool {
+	panic("not implemented") //lintignore:R009
+}
+
+func (c mockClient) IAMPolicyValidation(context.Context) string {
+	panic("not implemented") //lintignore:R009
+}
+
+func (c mockClient) ValidateIAMPolicy(context.Context, string, string) ([]conns.IAMPolicyFinding, error) {
+	panic("not implemented") //lintignore:R009
+}
+
+func (c mockClient) TagPolicyConfig(ctx context.Context) *tftags.PolicyConfig {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) Partition(context.Context) string {
 	panic("not implemented") //lintignore:R009
 }
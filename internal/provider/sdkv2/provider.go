@@ -12,6 +12,7 @@ import (
 	"maps"
 	"os"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -25,6 +26,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/drift"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -67,6 +70,12 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Optional:      true,
 					ConflictsWith: []string{"forbidden_account_ids"},
 				},
+				"api_call_log_file": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "Path of a file to which a JSON line is appended for each AWS API call, " +
+						"with request bodies masked as for debug logging.",
+				},
 				"assume_role":                   assumeRoleSchema(),
 				"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 				"custom_ca_bundle": {
@@ -105,7 +114,19 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Protocol to use with EC2 metadata service endpoint." +
 						"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 				},
+				"endpoint_profile": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "Use a local AWS API stand-in for all services. " +
+						"Valid values are `localstack`, which uses `http://localhost:4566` unless `local_endpoint` is set.",
+				},
 				"endpoints": endpointsSchema(),
+				"explain_drift": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Report attributes of resources that changed outside of Terraform as warnings during refresh. " +
+						"Can also be enabled with the `" + drift.ExplainDriftEnvVar + "` environment variable.",
+				},
 				"forbidden_account_ids": {
 					Type:          schema.TypeSet,
 					Elem:          &schema.Schema{Type: schema.TypeString},
@@ -124,6 +145,20 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. " +
 						"Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 				},
+				"iam_policy_file": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "Path of a file to which an IAM policy document allowing the AWS API operations invoked, " +
+						"with one statement per resource type, is written.",
+				},
+				"iam_policy_validation": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(conns.IAMPolicyValidations(), false),
+					Description: "Validate IAM policy attributes with IAM Access Analyzer. " +
+						"Valid values are `warning`, which reports ERROR and SECURITY_WARNING findings as warnings, " +
+						"and `error`, which reports them as errors at plan time, blocking apply.",
+				},
 				"ignore_tags": {
 					Type:        schema.TypeList,
 					Optional:    true,
@@ -154,6 +189,12 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
 						"default value is `false`",
 				},
+				"local_endpoint": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "Base URL of a local AWS API stand-in, e.g. an emulator, used as the endpoint for all services not set in `endpoints`. " +
+						"Enables S3 path-style addressing and skips credentials, region and account ID validation.",
+				},
 				"max_retries": {
 					Type:     schema.TypeInt,
 					Optional: true,
@@ -173,6 +214,12 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The profile for API operations. If not set, the default profile\n" +
 						"created with `aws configure` will be used.",
 				},
+				"read_only_mode": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Block any AWS API operation that is not on the provider's generated read-only allowlist. " +
+						"Used to verify that plans only make read-only AWS API calls.",
+				},
 				"region": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -206,6 +253,32 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The secret key for API operations. You can retrieve this\n" +
 						"from the 'Security & Credentials' section of the AWS console.",
 				},
+				"service_rate_limits": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration blocks with settings to limit the rate and concurrency of AWS API requests to individual services.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max_in_flight": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(0),
+								Description:  "The maximum number of concurrent API operations, including retries, made to the service.",
+							},
+							"requests_per_second": {
+								Type:         schema.TypeFloat,
+								Optional:     true,
+								ValidateFunc: validation.FloatAtLeast(0),
+								Description:  "The maximum rate of API requests, including retries, made to the service.",
+							},
+							"service": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The service to limit. Valid values are the same as the `endpoints` block's argument names.",
+							},
+						},
+					},
+				},
 				"shared_config_files": {
 					Type:        schema.TypeList,
 					Optional:    true,
@@ -249,6 +322,54 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The region where AWS STS operations will take place. Examples\n" +
 						"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 				},
+				"tag_policy": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Configuration block with settings to validate resource tags across all resources.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"allowed_values": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Configuration blocks with the allowed values of a resource tag.",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										names.AttrKey: {
+											Type:        schema.TypeString,
+											Required:    true,
+											Description: "Resource tag key.",
+										},
+										"pattern": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringIsValidRegExp,
+											Description:  "Regular expression that allowed resource tag values match.",
+										},
+										names.AttrValues: {
+											Type:        schema.TypeSet,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Allowed resource tag values.",
+										},
+									},
+								},
+							},
+							"key_case": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ValidateDiagFunc: enum.Validate[tftags.PolicyKeyCase](),
+								Description:      "Case that resource tag keys must use. Valid values are `camel`, `lower`, `pascal` and `upper`.",
+							},
+							"required_keys": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Resource tag keys that are required on all resources.",
+							},
+						},
+					},
+				},
 				"token": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -335,13 +456,20 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		APICallLogFile:                 d.Get("api_call_log_file").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
+		EndpointProfile:                d.Get("endpoint_profile").(string),
 		Endpoints:                      make(map[string]string),
+		ExplainDrift:                   d.Get("explain_drift").(bool),
+		IAMPolicyFile:                  d.Get("iam_policy_file").(string),
+		IAMPolicyValidation:            d.Get("iam_policy_validation").(string),
 		Insecure:                       d.Get("insecure").(bool),
+		LocalEndpoint:                  d.Get("local_endpoint").(string),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
 		Profile:                        d.Get("profile").(string),
+		ReadOnlyMode:                   d.Get("read_only_mode").(bool),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
@@ -364,6 +492,12 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.RetryMode = mode
 	}
 
+	if v := os.Getenv(drift.ExplainDriftEnvVar); v != "" {
+		if enabled, err := strconv.ParseBool(v); err == nil && enabled {
+			config.ExplainDrift = true
+		}
+	}
+
 	if v, ok := d.Get("s3_us_east_1_regional_endpoint").(string); ok && v != "" {
 		endpoint := conns.NormalizeS3USEast1RegionalEndpoint(v)
 		if endpoint == "legacy" {
@@ -465,10 +599,23 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		config.TagPolicyConfig = expandTagPolicy(ctx, v.([]any)[0].(map[string]any))
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("service_rate_limits"); ok && len(v.([]any)) > 0 {
+		limits, dx := expandServiceRateLimits(ctx, v.([]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ServiceRateLimits = limits
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]any)) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]any))
 	}
@@ -587,6 +734,7 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					}
 
 					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, overrideRegion)
+					ctx = conns.WithTypeName(ctx, typeName)
 					if c, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -636,6 +784,22 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 
 			var interceptors interceptorInvocations
 
+			// Drift is explained once all other Read interceptors have run.
+			interceptors = append(interceptors, resourceExplainDrift(typeName))
+
+			if v, ok := iamPolicyAttributes[typeName]; ok {
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: validateIAMPoliciesOnPlan(v),
+				})
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         Create | Update,
+					interceptor: validateIAMPoliciesOnApply(v),
+				})
+			}
+
 			if isRegionOverrideEnabled {
 				v := resource.Region.Value()
 				s := r.SchemaMap()
@@ -703,6 +867,11 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					why:         CustomizeDiff,
 					interceptor: setTagsAll(),
 				})
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: validateTagPolicy(),
+				})
 			}
 
 			if len(resource.Identity.Attributes) > 0 {
@@ -750,6 +919,7 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 					}
 
 					ctx = conns.NewResourceContext(ctx, servicePackageName, resource.Name, overrideRegion)
+					ctx = conns.WithTypeName(ctx, typeName)
 					if c, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -1098,6 +1268,77 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 	return nil
 }
 
+func expandServiceRateLimits(_ context.Context, tfList []any) (map[string]conns.ServiceRateLimit, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	path := cty.GetAttrPath("service_rate_limits")
+	limits := make(map[string]conns.ServiceRateLimit)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		elementPath := path.IndexInt(i)
+
+		service, err := names.ProviderPackageForAlias(tfMap["service"].(string))
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr("service"), "Invalid Attribute Value", err.Error()))
+			continue
+		}
+
+		if _, ok := limits[service]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr("service"), "Invalid Attribute Value", fmt.Sprintf("duplicate rate limits for service %s", service)))
+			continue
+		}
+
+		limits[service] = conns.ServiceRateLimit{
+			MaxInFlight:       tfMap["max_in_flight"].(int),
+			RequestsPerSecond: tfMap["requests_per_second"].(float64),
+		}
+	}
+
+	return limits, diags
+}
+
+func expandTagPolicy(_ context.Context, tfMap map[string]any) *tftags.PolicyConfig {
+	tagPolicyConfig := &tftags.PolicyConfig{
+		AllowedValues: make(map[string]tftags.PolicyAllowedValues),
+	}
+
+	if v, ok := tfMap["allowed_values"].([]any); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			var allowedValues tftags.PolicyAllowedValues
+
+			if v, ok := tfMap["pattern"].(string); ok && v != "" {
+				// Validated in schema.
+				allowedValues.Pattern = regexache.MustCompile(v)
+			}
+			if v, ok := tfMap[names.AttrValues].(*schema.Set); ok && v.Len() > 0 {
+				allowedValues.Values = flex.ExpandStringValueSet(v)
+			}
+
+			tagPolicyConfig.AllowedValues[tfMap[names.AttrKey].(string)] = allowedValues
+		}
+	}
+
+	if v, ok := tfMap["key_case"].(string); ok && v != "" {
+		tagPolicyConfig.KeyCase = tftags.PolicyKeyCase(v)
+	}
+
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok && v.Len() > 0 {
+		tagPolicyConfig.RequiredKeys = flex.ExpandStringValueSet(v)
+	}
+
+	return tagPolicyConfig
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []any
 
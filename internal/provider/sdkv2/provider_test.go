@@ -5,6 +5,8 @@ package sdkv2
 
 import (
 	"os"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
 
@@ -12,8 +14,11 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -45,6 +50,110 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestIAMPolicyAttributes(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	p, err := NewProvider(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for typeName, attrPaths := range iamPolicyAttributes {
+		r, ok := p.ResourcesMap[typeName]
+		if !ok {
+			t.Errorf("%s: resource not found", typeName)
+			continue
+		}
+
+		for _, attrPath := range attrPaths {
+			s := r.SchemaMap()
+			var attr *schema.Schema
+			for attrName := range strings.SplitSeq(attrPath, ".") {
+				if attr != nil {
+					if r, ok := attr.Elem.(*schema.Resource); ok {
+						s = r.SchemaMap()
+					} else {
+						s = nil
+					}
+				}
+				attr = s[attrName]
+				if attr == nil {
+					break
+				}
+			}
+
+			if attr == nil || attr.Type != schema.TypeString {
+				t.Errorf("%s: %s is not a string attribute", typeName, attrPath)
+			}
+		}
+	}
+}
+
+func TestIAMPolicyAttributesComplete(t *testing.T) {
+	t.Parallel()
+
+	// Attributes diff-suppressed as policy documents that are not IAM policies.
+	notIAMPolicyAttributes := map[string][]string{
+		"aws_sns_topic_data_protection_policy": {names.AttrPolicy},
+	}
+
+	iamPolicyFuncs := []uintptr{
+		reflect.ValueOf(sdkv2.SuppressEquivalentIAMPolicyDocuments).Pointer(),
+		reflect.ValueOf(verify.SuppressEquivalentPolicyDiffs).Pointer(),
+		reflect.ValueOf(verify.ValidIAMPolicyJSON).Pointer(),
+	}
+	isIAMPolicyAttribute := func(attr *schema.Schema) bool {
+		if attr.Type != schema.TypeString {
+			return false
+		}
+		if attr.DiffSuppressFunc != nil && slices.Contains(iamPolicyFuncs, reflect.ValueOf(attr.DiffSuppressFunc).Pointer()) {
+			return true
+		}
+		if attr.ValidateFunc != nil && slices.Contains(iamPolicyFuncs, reflect.ValueOf(attr.ValidateFunc).Pointer()) {
+			return true
+		}
+		return false
+	}
+
+	ctx := t.Context()
+	p, err := NewProvider(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for typeName, r := range p.ResourcesMap {
+		for _, attrPath := range schemaAttributePaths(r.SchemaMap(), "", isIAMPolicyAttribute) {
+			if slices.Contains(notIAMPolicyAttributes[typeName], attrPath) {
+				continue
+			}
+
+			if !slices.Contains(iamPolicyAttributes[typeName], attrPath) {
+				t.Errorf("%s: IAM policy attribute %s is not listed in iamPolicyAttributes", typeName, attrPath)
+			}
+		}
+	}
+}
+
+// schemaAttributePaths returns the dot-separated paths of the attributes, including nested attributes, for which f returns true.
+func schemaAttributePaths(s map[string]*schema.Schema, prefix string, f func(*schema.Schema) bool) []string {
+	var attrPaths []string
+
+	for attrName, attr := range s {
+		attrPath := prefix + attrName
+
+		if f(attr) {
+			attrPaths = append(attrPaths, attrPath)
+		}
+
+		if r, ok := attr.Elem.(*schema.Resource); ok {
+			attrPaths = append(attrPaths, schemaAttributePaths(r.SchemaMap(), attrPath+".", f)...)
+		}
+	}
+
+	return attrPaths
+}
+
 func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	oldEnv := stashEnv()
 	defer popEnv(oldEnv)
@@ -439,3 +548,112 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandServiceRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	testcases := map[string]struct {
+		tfList        []any
+		expected      map[string]conns.ServiceRateLimit
+		expectedError bool
+	}{
+		"empty": {
+			tfList:   []any{},
+			expected: map[string]conns.ServiceRateLimit{},
+		},
+		"services": {
+			tfList: []any{
+				map[string]any{"service": "route53", "max_in_flight": 2, "requests_per_second": 5.0},
+				map[string]any{"service": "organizations", "max_in_flight": 0, "requests_per_second": 1.5},
+			},
+			expected: map[string]conns.ServiceRateLimit{
+				names.Route53:       {MaxInFlight: 2, RequestsPerSecond: 5},
+				names.Organizations: {RequestsPerSecond: 1.5},
+			},
+		},
+		"alias": {
+			tfList: []any{
+				map[string]any{"service": "cloudwatchevents", "max_in_flight": 1, "requests_per_second": 0.0},
+			},
+			expected: map[string]conns.ServiceRateLimit{
+				names.Events: {MaxInFlight: 1},
+			},
+		},
+		"unknown service": {
+			tfList: []any{
+				map[string]any{"service": "notaservice", "max_in_flight": 1, "requests_per_second": 0.0},
+			},
+			expectedError: true,
+		},
+		"duplicate service": {
+			tfList: []any{
+				map[string]any{"service": "iam", "max_in_flight": 1, "requests_per_second": 0.0},
+				map[string]any{"service": "iam", "max_in_flight": 2, "requests_per_second": 0.0},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandServiceRateLimits(ctx, testcase.tfList)
+
+			if got, expected := diags.HasError(), testcase.expectedError; got != expected {
+				t.Fatalf("expected error: %t, got diagnostics: %v", expected, diags)
+			}
+
+			if testcase.expectedError {
+				return
+			}
+
+			if diff := cmp.Diff(results, testcase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	got := expandTagPolicy(ctx, map[string]any{
+		"allowed_values": []any{
+			map[string]any{
+				names.AttrKey:    "Environment",
+				"pattern":        "",
+				names.AttrValues: schema.NewSet(schema.HashString, []any{"dev", "prod"}),
+			},
+			map[string]any{
+				names.AttrKey:    "Owner",
+				"pattern":        "^team-",
+				names.AttrValues: schema.NewSet(schema.HashString, []any{}),
+			},
+		},
+		"key_case":      "pascal",
+		"required_keys": schema.NewSet(schema.HashString, []any{"Owner"}),
+	})
+
+	if got, want := got.KeyCase, tftags.PolicyKeyCasePascal; got != want {
+		t.Errorf("KeyCase = %q, want %q", got, want)
+	}
+	if diff := cmp.Diff(got.RequiredKeys, []string{"Owner"}); diff != "" {
+		t.Errorf("unexpected RequiredKeys difference: %s", diff)
+	}
+	if got, want := len(got.AllowedValues), 2; got != want {
+		t.Fatalf("length of AllowedValues = %d, want %d", got, want)
+	}
+	if v := got.AllowedValues["Environment"]; v.Pattern != nil || len(v.Values) != 2 {
+		t.Errorf("unexpected Environment allowed values: %v", v)
+	}
+	if v := got.AllowedValues["Owner"]; v.Pattern == nil || v.Pattern.String() != "^team-" || len(v.Values) != 0 {
+		t.Errorf("unexpected Owner allowed values: %v", v)
+	}
+
+	if err := tftags.New(ctx, map[string]string{"Owner": "team-a", "Environment": "dev"}).ValidatePolicy(got); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
@@ -297,3 +297,42 @@ func setTagsAll() customizeDiffInterceptor {
 		return nil
 	})
 }
+
+// validateTagPolicy reports any violations of the provider configured tag_policy at plan time.
+// Only new resources and resources whose tags change are validated, so that existing non-compliant resources can still be updated.
+func validateTagPolicy() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		tagPolicyConfig := c.TagPolicyConfig(ctx)
+		if tagPolicyConfig == nil {
+			return nil
+		}
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				// Tags can only be validated once known.
+				if !d.GetRawPlan().GetAttr(names.AttrTags).IsWhollyKnown() {
+					return nil
+				}
+
+				allTags := c.DefaultTagsConfig(ctx).MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]any))).IgnoreConfig(c.IgnoreTagsConfig(ctx))
+
+				if d.Id() != "" {
+					o, _ := d.GetChange(names.AttrTagsAll)
+					if tftags.New(ctx, o).DeepEqual(allTags) {
+						return nil
+					}
+				}
+
+				if err := allTags.ValidatePolicy(tagPolicyConfig); err != nil {
+					return fmt.Errorf("tags do not comply with the provider tag_policy:\n%w", err)
+				}
+			}
+		}
+
+		return nil
+	})
+}
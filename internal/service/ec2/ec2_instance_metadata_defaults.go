@@ -31,6 +31,7 @@ const (
 )
 
 // @FrameworkResource("aws_ec2_instance_metadata_defaults", name="Instance Metadata Defaults")
+// @AssumeRoleOverride
 func newInstanceMetadataDefaultsResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &instanceMetadataDefaultsResource{}
 
@@ -217,6 +218,7 @@ func (r *instanceMetadataDefaultsResource) Delete(ctx context.Context, request r
 }
 
 type instanceMetadataDefaultsResourceModel struct {
+	framework.WithAssumeRoleModel
 	framework.WithRegionModel
 	HttpEndpoint            fwtypes.StringEnum[awstypes.DefaultInstanceMetadataEndpointState] `tfsdk:"http_endpoint"`
 	HttpPutResponseHopLimit types.Int64                                                       `tfsdk:"http_put_response_hop_limit"`
@@ -13,6 +13,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -21,18 +24,26 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKResource("aws_launch_template", name="Launch Template")
 // @Tags(identifierAttribute="id")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.LaunchTemplate")
 // @Testing(tagsTest=false)
+// @Testing(preIdentityVersion="v6.17.0")
 func resourceLaunchTemplate() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceLaunchTemplateCreate,
@@ -40,10 +51,6 @@ func resourceLaunchTemplate() *schema.Resource {
 		UpdateWithoutTimeout: resourceLaunchTemplateUpdate,
 		DeleteWithoutTimeout: resourceLaunchTemplateDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -1035,6 +1042,14 @@ func resourceLaunchTemplate() *schema.Resource {
 	}
 }
 
+// @SDKListResource("aws_launch_template")
+func launchTemplateResourceAsListResource() inttypes.ListResourceForSDK {
+	l := launchTemplateListResource{}
+	l.SetResourceSchema(resourceLaunchTemplate())
+
+	return &l
+}
+
 func resourceLaunchTemplateCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
@@ -1087,25 +1102,10 @@ func resourceLaunchTemplateRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Launch Template (%s): %s", d.Id(), err)
 	}
 
-	version := flex.Int64ToStringValue(lt.LatestVersionNumber)
-	ltv, err := findLaunchTemplateVersionByTwoPartKey(ctx, conn, d.Id(), version)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading EC2 Launch Template (%s) Version (%s): %s", d.Id(), version, err)
-	}
-
-	d.Set(names.AttrARN, launchTemplateARN(ctx, c, d.Id()))
-	d.Set("default_version", lt.DefaultVersionNumber)
-	d.Set(names.AttrDescription, ltv.VersionDescription)
-	d.Set("latest_version", lt.LatestVersionNumber)
-	d.Set(names.AttrName, lt.LaunchTemplateName)
-	d.Set(names.AttrNamePrefix, create.NamePrefixFromName(aws.ToString(lt.LaunchTemplateName)))
-
-	if err := flattenResponseLaunchTemplateData(ctx, conn, d, ltv.LaunchTemplateData); err != nil {
+	if err := resourceLaunchTemplateFlatten(ctx, c, lt, d); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	setTagsOut(ctx, lt.Tags)
-
 	return diags
 }
 
@@ -3207,6 +3207,123 @@ func flattenLaunchTemplateEnaSrdUdpSpecification(apiObject *awstypes.LaunchTempl
 
 	return tfMap
 }
+
+func resourceLaunchTemplateFlatten(ctx context.Context, c *conns.AWSClient, lt *awstypes.LaunchTemplate, d *schema.ResourceData) error {
+	conn := c.EC2Client(ctx)
+
+	version := flex.Int64ToStringValue(lt.LatestVersionNumber)
+	ltv, err := findLaunchTemplateVersionByTwoPartKey(ctx, conn, d.Id(), version)
+	if err != nil {
+		return fmt.Errorf("reading EC2 Launch Template (%s) Version (%s): %w", d.Id(), version, err)
+	}
+
+	d.Set(names.AttrARN, launchTemplateARN(ctx, c, d.Id()))
+	d.Set("default_version", lt.DefaultVersionNumber)
+	d.Set(names.AttrDescription, ltv.VersionDescription)
+	d.Set("latest_version", lt.LatestVersionNumber)
+	d.Set(names.AttrName, lt.LaunchTemplateName)
+	d.Set(names.AttrNamePrefix, create.NamePrefixFromName(aws.ToString(lt.LaunchTemplateName)))
+
+	if err := flattenResponseLaunchTemplateData(ctx, conn, d, ltv.LaunchTemplateData); err != nil {
+		return err
+	}
+
+	setTagsOut(ctx, lt.Tags)
+
+	return nil
+}
+
 func launchTemplateARN(ctx context.Context, c *conns.AWSClient, templateID string) string {
 	return c.RegionalARN(ctx, names.EC2, "launch-template/"+templateID)
 }
+
+var _ list.ListResourceWithRawV5Schemas = &launchTemplateListResource{}
+
+type launchTemplateListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+	framework.ListResourceWithSDKv2Tags
+}
+
+type launchTemplateListResourceModel struct {
+	framework.WithRegionModel
+	Filters customListFilters `tfsdk:"filter"`
+}
+
+func (l *launchTemplateListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: customListFiltersBlock(ctx),
+		},
+	}
+}
+
+func (l *launchTemplateListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	var query launchTemplateListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input ec2.DescribeLaunchTemplatesInput
+	if diags := fwflex.Expand(ctx, query, &input); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for lt, err := range listLaunchTemplates(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), aws.ToString(lt.LaunchTemplateId))
+
+			result := request.NewListResult(ctx)
+
+			rd := l.ResourceData()
+			rd.SetId(aws.ToString(lt.LaunchTemplateId))
+
+			tflog.Info(ctx, "Reading resource")
+			err := resourceLaunchTemplateFlatten(ctx, awsClient, &lt, rd)
+			if tfresource.NotFound(err) {
+				tflog.Warn(ctx, "Resource disappeared during listing, skipping")
+				continue
+			}
+			if err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// set tags
+			err = l.SetTags(ctx, awsClient, rd)
+			if err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			result.DisplayName = fmt.Sprintf("%s (%s)", aws.ToString(lt.LaunchTemplateName), aws.ToString(lt.LaunchTemplateId))
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
@@ -758,27 +758,35 @@ func findLaunchTemplate(ctx context.Context, conn *ec2.Client, input *ec2.Descri
 }
 
 func findLaunchTemplates(ctx context.Context, conn *ec2.Client, input *ec2.DescribeLaunchTemplatesInput) ([]awstypes.LaunchTemplate, error) {
-	var output []awstypes.LaunchTemplate
+	return tfslices.CollectWithError(listLaunchTemplates(ctx, conn, input))
+}
 
-	pages := ec2.NewDescribeLaunchTemplatesPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+func listLaunchTemplates(ctx context.Context, conn *ec2.Client, input *ec2.DescribeLaunchTemplatesInput) iter.Seq2[awstypes.LaunchTemplate, error] {
+	return func(yield func(awstypes.LaunchTemplate, error) bool) {
+		pages := ec2.NewDescribeLaunchTemplatesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
 
-		if tfawserr.ErrCodeEquals(err, errCodeInvalidLaunchTemplateIdMalformed, errCodeInvalidLaunchTemplateIdNotFound, errCodeInvalidLaunchTemplateNameNotFoundException) {
-			return nil, &retry.NotFoundError{
-				LastError:   err,
-				LastRequest: &input,
+			if tfawserr.ErrCodeEquals(err, errCodeInvalidLaunchTemplateIdMalformed, errCodeInvalidLaunchTemplateIdNotFound, errCodeInvalidLaunchTemplateNameNotFoundException) {
+				yield(awstypes.LaunchTemplate{}, &retry.NotFoundError{
+					LastError:   err,
+					LastRequest: &input,
+				})
+				return
 			}
-		}
 
-		if err != nil {
-			return nil, err
-		}
+			if err != nil {
+				yield(awstypes.LaunchTemplate{}, err)
+				return
+			}
 
-		output = append(output, page.LaunchTemplates...)
+			for _, v := range page.LaunchTemplates {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
 	}
-
-	return output, nil
 }
 
 func findLaunchTemplateByName(ctx context.Context, conn *ec2.Client, name string) (*awstypes.LaunchTemplate, error) {
@@ -2050,27 +2058,35 @@ func findSecurityGroup(ctx context.Context, conn *ec2.Client, input *ec2.Describ
 }
 
 func findSecurityGroups(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSecurityGroupsInput) ([]awstypes.SecurityGroup, error) {
-	var output []awstypes.SecurityGroup
+	return tfslices.CollectWithError(listSecurityGroups(ctx, conn, input))
+}
 
-	pages := ec2.NewDescribeSecurityGroupsPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+func listSecurityGroups(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSecurityGroupsInput) iter.Seq2[awstypes.SecurityGroup, error] {
+	return func(yield func(awstypes.SecurityGroup, error) bool) {
+		pages := ec2.NewDescribeSecurityGroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
 
-		if tfawserr.ErrCodeEquals(err, errCodeInvalidGroupNotFound, errCodeInvalidSecurityGroupIDNotFound) {
-			return nil, &retry.NotFoundError{
-				LastError:   err,
-				LastRequest: &input,
+			if tfawserr.ErrCodeEquals(err, errCodeInvalidGroupNotFound, errCodeInvalidSecurityGroupIDNotFound) {
+				yield(awstypes.SecurityGroup{}, &retry.NotFoundError{
+					LastError:   err,
+					LastRequest: &input,
+				})
+				return
 			}
-		}
 
-		if err != nil {
-			return nil, err
-		}
+			if err != nil {
+				yield(awstypes.SecurityGroup{}, err)
+				return
+			}
 
-		output = append(output, page.SecurityGroups...)
+			for _, v := range page.SecurityGroups {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
 	}
-
-	return output, nil
 }
 
 // findSecurityGroupByNameAndVPCID looks up a security group by name, VPC ID. Returns a retry.NotFoundError if not found.
@@ -2146,27 +2162,35 @@ func findSecurityGroupRule(ctx context.Context, conn *ec2.Client, input *ec2.Des
 }
 
 func findSecurityGroupRules(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSecurityGroupRulesInput) ([]awstypes.SecurityGroupRule, error) {
-	var output []awstypes.SecurityGroupRule
+	return tfslices.CollectWithError(listSecurityGroupRules(ctx, conn, input))
+}
 
-	pages := ec2.NewDescribeSecurityGroupRulesPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+func listSecurityGroupRules(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSecurityGroupRulesInput) iter.Seq2[awstypes.SecurityGroupRule, error] {
+	return func(yield func(awstypes.SecurityGroupRule, error) bool) {
+		pages := ec2.NewDescribeSecurityGroupRulesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
 
-		if tfawserr.ErrCodeEquals(err, errCodeInvalidSecurityGroupRuleIdNotFound) {
-			return nil, &retry.NotFoundError{
-				LastError:   err,
-				LastRequest: &input,
+			if tfawserr.ErrCodeEquals(err, errCodeInvalidSecurityGroupRuleIdNotFound) {
+				yield(awstypes.SecurityGroupRule{}, &retry.NotFoundError{
+					LastError:   err,
+					LastRequest: &input,
+				})
+				return
 			}
-		}
 
-		if err != nil {
-			return nil, err
-		}
+			if err != nil {
+				yield(awstypes.SecurityGroupRule{}, err)
+				return
+			}
 
-		output = append(output, page.SecurityGroupRules...)
+			for _, v := range page.SecurityGroupRules {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
 	}
-
-	return output, nil
 }
 
 func findSecurityGroupRuleByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.SecurityGroupRule, error) {
@@ -2326,27 +2350,35 @@ func findNetworkInterfacePermissionByID(ctx context.Context, conn *ec2.Client, i
 }
 
 func findNetworkInterfaces(ctx context.Context, conn *ec2.Client, input *ec2.DescribeNetworkInterfacesInput) ([]awstypes.NetworkInterface, error) {
-	var output []awstypes.NetworkInterface
+	return tfslices.CollectWithError(listNetworkInterfaces(ctx, conn, input))
+}
 
-	pages := ec2.NewDescribeNetworkInterfacesPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+func listNetworkInterfaces(ctx context.Context, conn *ec2.Client, input *ec2.DescribeNetworkInterfacesInput) iter.Seq2[awstypes.NetworkInterface, error] {
+	return func(yield func(awstypes.NetworkInterface, error) bool) {
+		pages := ec2.NewDescribeNetworkInterfacesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
 
-		if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInterfaceIDNotFound) {
-			return nil, &retry.NotFoundError{
-				LastError:   err,
-				LastRequest: &input,
+			if tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInterfaceIDNotFound) {
+				yield(awstypes.NetworkInterface{}, &retry.NotFoundError{
+					LastError:   err,
+					LastRequest: &input,
+				})
+				return
 			}
-		}
 
-		if err != nil {
-			return nil, err
-		}
+			if err != nil {
+				yield(awstypes.NetworkInterface{}, err)
+				return
+			}
 
-		output = append(output, page.NetworkInterfaces...)
+			for _, v := range page.NetworkInterfaces {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
 	}
-
-	return output, nil
 }
 
 func findNetworkInterface(ctx context.Context, conn *ec2.Client, input *ec2.DescribeNetworkInterfacesInput) (*awstypes.NetworkInterface, error) {
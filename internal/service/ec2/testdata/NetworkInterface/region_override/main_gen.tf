@@ -0,0 +1,33 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_network_interface" "test" {
+  region = var.region
+
+  subnet_id = aws_subnet.test.id
+}
+
+resource "aws_vpc" "test" {
+  region = var.region
+
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_subnet" "test" {
+  region = var.region
+
+  vpc_id     = aws_vpc.test.id
+  cidr_block = "10.1.1.0/24"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,10 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_security_group" "test" {
+  provider = aws
+
+  config {
+    region = var.region
+  }
+}
@@ -0,0 +1,6 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_vpc_security_group_ingress_rule" "test" {
+  provider = aws
+}
@@ -15,6 +15,9 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -23,17 +26,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKResource("aws_network_interface", name="Network Interface")
 // @Tags(identifierAttribute="id")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.NetworkInterface")
 // @Testing(tagsTest=false)
+// @Testing(checkName="ENI")
+// @Testing(preIdentityVersion="v6.17.0")
 func resourceNetworkInterface() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceNetworkInterfaceCreate,
@@ -41,10 +53,6 @@ func resourceNetworkInterface() *schema.Resource {
 		UpdateWithoutTimeout: resourceNetworkInterfaceUpdate,
 		DeleteWithoutTimeout: resourceNetworkInterfaceDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -347,6 +355,14 @@ func resourceNetworkInterface() *schema.Resource {
 	}
 }
 
+// @SDKListResource("aws_network_interface")
+func networkInterfaceResourceAsListResource() inttypes.ListResourceForSDK {
+	l := networkInterfaceListResource{}
+	l.SetResourceSchema(resourceNetworkInterface())
+
+	return &l
+}
+
 func resourceNetworkInterfaceCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
@@ -534,56 +550,9 @@ func resourceNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, m
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Network Interface (%s): %s", d.Id(), err)
 	}
 
-	ownerID := aws.ToString(eni.OwnerId)
-	d.Set(names.AttrARN, networkInterfaceARN(ctx, c, ownerID, d.Id()))
-	if eni.Attachment != nil {
-		if err := d.Set("attachment", []any{flattenNetworkInterfaceAttachment(eni.Attachment)}); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting attachment: %s", err)
-		}
-	} else {
-		d.Set("attachment", nil)
-	}
-	d.Set(names.AttrDescription, eni.Description)
-	d.Set("interface_type", eni.InterfaceType)
-	if err := d.Set("ipv4_prefixes", flattenIPv4PrefixSpecifications(eni.Ipv4Prefixes)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting ipv4_prefixes: %s", err)
-	}
-	d.Set("ipv4_prefix_count", len(eni.Ipv4Prefixes))
-	d.Set("ipv6_address_count", len(eni.Ipv6Addresses))
-	if len(eni.Ipv6Addresses) > 0 {
-		if err := d.Set("enable_primary_ipv6", eni.Ipv6Addresses[0].IsPrimaryIpv6); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting enable_primary_ipv6: %s", err)
-		}
-	}
-	if err := d.Set("ipv6_address_list", flattenNetworkInterfaceIPv6Addresses(eni.Ipv6Addresses)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting ipv6 address list: %s", err)
-	}
-	if err := d.Set("ipv6_addresses", flattenNetworkInterfaceIPv6Addresses(eni.Ipv6Addresses)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting ipv6_addresses: %s", err)
-	}
-	if err := d.Set("ipv6_prefixes", flattenIPv6PrefixSpecifications(eni.Ipv6Prefixes)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting ipv6_prefixes: %s", err)
-	}
-	d.Set("ipv6_prefix_count", len(eni.Ipv6Prefixes))
-	d.Set("mac_address", eni.MacAddress)
-	d.Set("outpost_arn", eni.OutpostArn)
-	d.Set(names.AttrOwnerID, ownerID)
-	d.Set("private_dns_name", eni.PrivateDnsName)
-	d.Set("private_ip", eni.PrivateIpAddress)
-	if err := d.Set("private_ips", flattenNetworkInterfacePrivateIPAddresses(eni.PrivateIpAddresses)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting private_ips: %s", err)
-	}
-	d.Set("private_ips_count", len(eni.PrivateIpAddresses)-1)
-	if err := d.Set("private_ip_list", flattenNetworkInterfacePrivateIPAddresses(eni.PrivateIpAddresses)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting private_ip_list: %s", err)
-	}
-	if err := d.Set(names.AttrSecurityGroups, flattenGroupIdentifiers(eni.Groups)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting security_groups: %s", err)
+	if err := resourceNetworkInterfaceFlatten(ctx, c, eni, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
 	}
-	d.Set("source_dest_check", eni.SourceDestCheck)
-	d.Set(names.AttrSubnetID, eni.SubnetId)
-
-	setTagsOut(ctx, eni.TagSet)
 
 	return diags
 }
@@ -1700,6 +1669,166 @@ func flattenGroupIdentifiers(dtos []awstypes.GroupIdentifier) []string {
 	return ids
 }
 
+func resourceNetworkInterfaceFlatten(ctx context.Context, c *conns.AWSClient, eni *awstypes.NetworkInterface, d *schema.ResourceData) error {
+	ownerID := aws.ToString(eni.OwnerId)
+	d.Set(names.AttrARN, networkInterfaceARN(ctx, c, ownerID, d.Id()))
+	if eni.Attachment != nil {
+		if err := d.Set("attachment", []any{flattenNetworkInterfaceAttachment(eni.Attachment)}); err != nil {
+			return fmt.Errorf("setting attachment: %w", err)
+		}
+	} else {
+		d.Set("attachment", nil)
+	}
+	d.Set(names.AttrDescription, eni.Description)
+	d.Set("interface_type", eni.InterfaceType)
+	if err := d.Set("ipv4_prefixes", flattenIPv4PrefixSpecifications(eni.Ipv4Prefixes)); err != nil {
+		return fmt.Errorf("setting ipv4_prefixes: %w", err)
+	}
+	d.Set("ipv4_prefix_count", len(eni.Ipv4Prefixes))
+	d.Set("ipv6_address_count", len(eni.Ipv6Addresses))
+	if len(eni.Ipv6Addresses) > 0 {
+		if err := d.Set("enable_primary_ipv6", eni.Ipv6Addresses[0].IsPrimaryIpv6); err != nil {
+			return fmt.Errorf("setting enable_primary_ipv6: %w", err)
+		}
+	}
+	if err := d.Set("ipv6_address_list", flattenNetworkInterfaceIPv6Addresses(eni.Ipv6Addresses)); err != nil {
+		return fmt.Errorf("setting ipv6 address list: %w", err)
+	}
+	if err := d.Set("ipv6_addresses", flattenNetworkInterfaceIPv6Addresses(eni.Ipv6Addresses)); err != nil {
+		return fmt.Errorf("setting ipv6_addresses: %w", err)
+	}
+	if err := d.Set("ipv6_prefixes", flattenIPv6PrefixSpecifications(eni.Ipv6Prefixes)); err != nil {
+		return fmt.Errorf("setting ipv6_prefixes: %w", err)
+	}
+	d.Set("ipv6_prefix_count", len(eni.Ipv6Prefixes))
+	d.Set("mac_address", eni.MacAddress)
+	d.Set("outpost_arn", eni.OutpostArn)
+	d.Set(names.AttrOwnerID, ownerID)
+	d.Set("private_dns_name", eni.PrivateDnsName)
+	d.Set("private_ip", eni.PrivateIpAddress)
+	if err := d.Set("private_ips", flattenNetworkInterfacePrivateIPAddresses(eni.PrivateIpAddresses)); err != nil {
+		return fmt.Errorf("setting private_ips: %w", err)
+	}
+	d.Set("private_ips_count", len(eni.PrivateIpAddresses)-1)
+	if err := d.Set("private_ip_list", flattenNetworkInterfacePrivateIPAddresses(eni.PrivateIpAddresses)); err != nil {
+		return fmt.Errorf("setting private_ip_list: %w", err)
+	}
+	if err := d.Set(names.AttrSecurityGroups, flattenGroupIdentifiers(eni.Groups)); err != nil {
+		return fmt.Errorf("setting security_groups: %w", err)
+	}
+	d.Set("source_dest_check", eni.SourceDestCheck)
+	d.Set(names.AttrSubnetID, eni.SubnetId)
+
+	setTagsOut(ctx, eni.TagSet)
+
+	return nil
+}
+
 func networkInterfaceARN(ctx context.Context, c *conns.AWSClient, accountID, networkInterfaceID string) string {
 	return c.RegionalARNWithAccount(ctx, names.EC2, accountID, "network-interface/"+networkInterfaceID)
 }
+
+var _ list.ListResourceWithRawV5Schemas = &networkInterfaceListResource{}
+
+type networkInterfaceListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+	framework.ListResourceWithSDKv2Tags
+}
+
+type networkInterfaceListResourceModel struct {
+	framework.WithRegionModel
+	Filters                 customListFilters `tfsdk:"filter"`
+	IncludeRequesterManaged types.Bool        `tfsdk:"include_requester_managed"`
+}
+
+func (l *networkInterfaceListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"include_requester_managed": listschema.BoolAttribute{
+				Description: "Whether to include network interfaces that are managed by an AWS service. Requester-managed network interfaces are excluded by default.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: customListFiltersBlock(ctx),
+		},
+	}
+}
+
+func (l *networkInterfaceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	var query networkInterfaceListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input ec2.DescribeNetworkInterfacesInput
+	if diags := fwflex.Expand(ctx, query, &input); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	includeRequesterManaged := query.IncludeRequesterManaged.ValueBool()
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for eni, err := range listNetworkInterfaces(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if !includeRequesterManaged && aws.ToBool(eni.RequesterManaged) {
+				continue
+			}
+
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), aws.ToString(eni.NetworkInterfaceId))
+
+			result := request.NewListResult(ctx)
+
+			tags := keyValueTags(ctx, eni.TagSet)
+
+			rd := l.ResourceData()
+			rd.SetId(aws.ToString(eni.NetworkInterfaceId))
+
+			tflog.Info(ctx, "Reading resource")
+			if err := resourceNetworkInterfaceFlatten(ctx, awsClient, &eni, rd); err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// set tags
+			err = l.SetTags(ctx, awsClient, rd)
+			if err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if v, ok := tags["Name"]; ok {
+				result.DisplayName = fmt.Sprintf("%s (%s)", v.ValueString(), aws.ToString(eni.NetworkInterfaceId))
+			} else {
+				result.DisplayName = aws.ToString(eni.NetworkInterfaceId)
+			}
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
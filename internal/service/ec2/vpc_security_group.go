@@ -18,6 +18,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -25,10 +27,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -183,6 +189,14 @@ var (
 	}
 )
 
+// @SDKListResource("aws_security_group")
+func securityGroupResourceAsListResource() inttypes.ListResourceForSDK {
+	l := securityGroupListResource{}
+	l.SetResourceSchema(resourceSecurityGroup())
+
+	return &l
+}
+
 func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
@@ -279,35 +293,10 @@ func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "reading Security Group (%s): %s", d.Id(), err)
 	}
 
-	remoteIngressRules := securityGroupIPPermGather(d.Id(), sg.IpPermissions, sg.OwnerId)
-	remoteEgressRules := securityGroupIPPermGather(d.Id(), sg.IpPermissionsEgress, sg.OwnerId)
-
-	localIngressRules := d.Get("ingress").(*schema.Set).List()
-	localEgressRules := d.Get("egress").(*schema.Set).List()
-
-	// Loop through the local state of rules, doing a match against the remote
-	// ruleSet we built above.
-	ingressRules := matchRules("ingress", localIngressRules, remoteIngressRules)
-	egressRules := matchRules("egress", localEgressRules, remoteEgressRules)
-
-	ownerID := aws.ToString(sg.OwnerId)
-	d.Set(names.AttrARN, securityGroupARN(ctx, c, ownerID, d.Id()))
-	d.Set(names.AttrDescription, sg.Description)
-	d.Set(names.AttrName, sg.GroupName)
-	d.Set(names.AttrNamePrefix, create.NamePrefixFromName(aws.ToString(sg.GroupName)))
-	d.Set(names.AttrOwnerID, ownerID)
-	d.Set(names.AttrVPCID, sg.VpcId)
-
-	if err := d.Set("ingress", ingressRules); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting ingress: %s", err)
-	}
-
-	if err := d.Set("egress", egressRules); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting egress: %s", err)
+	if err := resourceSecurityGroupFlatten(ctx, c, sg, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	setTagsOut(ctx, sg.Tags)
-
 	return diags
 }
 
@@ -415,6 +404,39 @@ func resourceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, me
 	return diags
 }
 
+func resourceSecurityGroupFlatten(ctx context.Context, c *conns.AWSClient, sg *awstypes.SecurityGroup, d *schema.ResourceData) error {
+	remoteIngressRules := securityGroupIPPermGather(d.Id(), sg.IpPermissions, sg.OwnerId)
+	remoteEgressRules := securityGroupIPPermGather(d.Id(), sg.IpPermissionsEgress, sg.OwnerId)
+
+	localIngressRules := d.Get("ingress").(*schema.Set).List()
+	localEgressRules := d.Get("egress").(*schema.Set).List()
+
+	// Loop through the local state of rules, doing a match against the remote
+	// ruleSet we built above.
+	ingressRules := matchRules("ingress", localIngressRules, remoteIngressRules)
+	egressRules := matchRules("egress", localEgressRules, remoteEgressRules)
+
+	ownerID := aws.ToString(sg.OwnerId)
+	d.Set(names.AttrARN, securityGroupARN(ctx, c, ownerID, d.Id()))
+	d.Set(names.AttrDescription, sg.Description)
+	d.Set(names.AttrName, sg.GroupName)
+	d.Set(names.AttrNamePrefix, create.NamePrefixFromName(aws.ToString(sg.GroupName)))
+	d.Set(names.AttrOwnerID, ownerID)
+	d.Set(names.AttrVPCID, sg.VpcId)
+
+	if err := d.Set("ingress", ingressRules); err != nil {
+		return fmt.Errorf("setting ingress: %w", err)
+	}
+
+	if err := d.Set("egress", egressRules); err != nil {
+		return fmt.Errorf("setting egress: %w", err)
+	}
+
+	setTagsOut(ctx, sg.Tags)
+
+	return nil
+}
+
 func securityGroupARN(ctx context.Context, c *conns.AWSClient, accountID, sgID string) string {
 	return c.RegionalARNWithAccount(ctx, names.EC2, accountID, "security-group/"+sgID)
 }
@@ -1531,3 +1553,100 @@ func initSecurityGroupRule(ruleMap map[string]map[string]any, perm awstypes.IpPe
 
 	return rule
 }
+
+var _ list.ListResourceWithRawV5Schemas = &securityGroupListResource{}
+
+type securityGroupListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+	framework.ListResourceWithSDKv2Tags
+}
+
+type securityGroupListResourceModel struct {
+	framework.WithRegionModel
+	Filters customListFilters `tfsdk:"filter"`
+}
+
+func (l *securityGroupListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: customListFiltersBlock(ctx),
+		},
+	}
+}
+
+func (l *securityGroupListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	var query securityGroupListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input ec2.DescribeSecurityGroupsInput
+	if diags := fwflex.Expand(ctx, query, &input); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for sg, err := range listSecurityGroups(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// Default security groups are managed by aws_default_security_group.
+			if aws.ToString(sg.GroupName) == defaultSecurityGroupName {
+				continue
+			}
+
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), aws.ToString(sg.GroupId))
+
+			result := request.NewListResult(ctx)
+
+			tags := keyValueTags(ctx, sg.Tags)
+
+			rd := l.ResourceData()
+			rd.SetId(aws.ToString(sg.GroupId))
+
+			tflog.Info(ctx, "Reading resource")
+			if err := resourceSecurityGroupFlatten(ctx, awsClient, &sg, rd); err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// set tags
+			err = l.SetTags(ctx, awsClient, rd)
+			if err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if v, ok := tags["Name"]; ok {
+				result.DisplayName = fmt.Sprintf("%s (%s)", v.ValueString(), aws.ToString(sg.GroupId))
+			} else {
+				result.DisplayName = fmt.Sprintf("%s (%s)", aws.ToString(sg.GroupName), aws.ToString(sg.GroupId))
+			}
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
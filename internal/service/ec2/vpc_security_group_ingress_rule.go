@@ -6,6 +6,7 @@ package ec2
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -36,6 +39,8 @@ import (
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresource"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -54,8 +59,19 @@ func newSecurityGroupIngressRuleResource(context.Context) (resource.ResourceWith
 	return r, nil
 }
 
+// @FrameworkListResource("aws_vpc_security_group_ingress_rule")
+func securityGroupIngressRuleResourceAsListResource() list.ListResourceWithConfigure {
+	r := &securityGroupIngressRuleResource{}
+	r.securityGroupRule = r
+
+	return r
+}
+
+var _ list.ListResource = &securityGroupIngressRuleResource{}
+
 type securityGroupIngressRuleResource struct {
 	securityGroupRuleResource
+	framework.WithList
 }
 
 func (r *securityGroupIngressRuleResource) MoveState(ctx context.Context) []resource.StateMover {
@@ -160,6 +176,111 @@ func (r *securityGroupIngressRuleResource) moveStateResourceSecurityGroupRule(ct
 	// response.Diagnostics.Append(response.TargetState.Set(ctx, target)...)
 }
 
+func (r *securityGroupIngressRuleResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Blocks: map[string]listschema.Block{
+			names.AttrFilter: customListFiltersBlock(ctx),
+		},
+	}
+}
+
+func (r *securityGroupIngressRuleResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query securityGroupIngressRuleListModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var input ec2.DescribeSecurityGroupRulesInput
+	if diags := fwflex.Expand(ctx, query, &input); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	awsClient := r.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	resultInterceptors := r.ResultInterceptors()
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for rule, err := range listSecurityGroupRules(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			// Egress rules are managed by aws_vpc_security_group_egress_rule.
+			if aws.ToBool(rule.IsEgress) {
+				continue
+			}
+
+			ctx := tftags.NewContext(ctx, awsClient.DefaultTagsConfig(ctx), awsClient.IgnoreTagsConfig(ctx))
+
+			result := request.NewListResult(ctx)
+
+			var data securityGroupRuleResourceModel
+			data.ID = fwflex.StringToFramework(ctx, rule.SecurityGroupRuleId)
+
+			typ, _ := result.Resource.Schema.TypeAtPath(ctx, path.Root(names.AttrTags))
+			tagsType := typ.(attr.TypeWithElementType)
+			data.Tags.MapValue = basetypes.NewMapNull(tagsType.ElementType())
+			data.TagsAll.MapValue = basetypes.NewMapNull(tagsType.ElementType())
+
+			params := listresource.InterceptorParams{
+				C:      awsClient,
+				Result: &result,
+			}
+
+			params.When = listresource.Before
+			for interceptor := range slices.Values(resultInterceptors) {
+				d := interceptor.Read(ctx, params) // nosemgrep:ci.semgrep.migrate.direct-CRUD-calls
+				result.Diagnostics.Append(d...)
+				if d.HasError() {
+					result = list.ListResult{Diagnostics: result.Diagnostics}
+					yield(result)
+					return
+				}
+			}
+
+			r.flatten(ctx, &rule, &data)
+
+			if diags := result.Resource.Set(ctx, &data); diags.HasError() {
+				result.Diagnostics.Append(diags...)
+				result = list.ListResult{Diagnostics: result.Diagnostics}
+				yield(result)
+				return
+			}
+
+			result.DisplayName = fmt.Sprintf("%s (%s)", aws.ToString(rule.SecurityGroupRuleId), aws.ToString(rule.GroupId))
+
+			params.When = listresource.After
+			for interceptor := range tfslices.BackwardValues(resultInterceptors) {
+				d := interceptor.Read(ctx, params) // nosemgrep:ci.semgrep.migrate.direct-CRUD-calls
+				result.Diagnostics.Append(d...)
+				if d.HasError() {
+					result = list.ListResult{Diagnostics: result.Diagnostics}
+					yield(result)
+					return
+				}
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+type securityGroupIngressRuleListModel struct {
+	framework.WithRegionModel
+	Filters customListFilters `tfsdk:"filter"`
+}
+
 // Base structure and methods for VPC security group rules.
 
 type securityGroupRule interface {
@@ -290,6 +411,12 @@ func (r *securityGroupRuleResource) Read(ctx context.Context, request resource.R
 		return
 	}
 
+	r.flatten(ctx, output, &data)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRuleResource) flatten(ctx context.Context, output *awstypes.SecurityGroupRule, data *securityGroupRuleResourceModel) {
 	data.ARN = r.securityGroupRuleARN(ctx, data.ID.ValueString())
 	data.CIDRIPv4 = fwflex.StringToFramework(ctx, output.CidrIpv4)
 	data.CIDRIPv6 = fwflex.StringToFramework(ctx, output.CidrIpv6)
@@ -313,8 +440,6 @@ func (r *securityGroupRuleResource) Read(ctx context.Context, request resource.R
 	}
 
 	setTagsOut(ctx, output.Tags)
-
-	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
 func (r *securityGroupRuleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_vpc_security_group_rules_exclusive", name="Security Group Rules Exclusive")
+func newSecurityGroupRulesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &securityGroupRulesExclusiveResource{}, nil
+}
+
+const (
+	ResNameSecurityGroupRulesExclusive = "Security Group Rules Exclusive"
+)
+
+type securityGroupRulesExclusiveResource struct {
+	framework.ResourceWithModel[securityGroupRulesExclusiveResourceModel]
+	framework.WithNoOpDelete
+}
+
+func (r *securityGroupRulesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"egress_rule_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"ingress_rule_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *securityGroupRulesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := fwflex.StringValueFromFramework(ctx, data.SecurityGroupID)
+	if err := r.syncRules(ctx, groupID, fwflex.ExpandFrameworkStringValueSet(ctx, data.IngressRuleIDs), fwflex.ExpandFrameworkStringValueSet(ctx, data.EgressRuleIDs)); err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, ResNameSecurityGroupRulesExclusive, groupID, err),
+			err.Error(),
+		)
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	groupID := fwflex.StringValueFromFramework(ctx, data.SecurityGroupID)
+	ingress, egress, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, groupID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, ResNameSecurityGroupRulesExclusive, groupID, err),
+			err.Error(),
+		)
+
+		return
+	}
+
+	data.EgressRuleIDs = fwflex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, egress)
+	data.IngressRuleIDs = fwflex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, ingress)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old securityGroupRulesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.IngressRuleIDs.Equal(old.IngressRuleIDs) || !new.EgressRuleIDs.Equal(old.EgressRuleIDs) {
+		groupID := fwflex.StringValueFromFramework(ctx, new.SecurityGroupID)
+		if err := r.syncRules(ctx, groupID, fwflex.ExpandFrameworkStringValueSet(ctx, new.IngressRuleIDs), fwflex.ExpandFrameworkStringValueSet(ctx, new.EgressRuleIDs)); err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.EC2, create.ErrActionUpdating, ResNameSecurityGroupRulesExclusive, groupID, err),
+				err.Error(),
+			)
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("security_group_id"), request, response)
+}
+
+// syncRules handles keeping the configured security group rules in sync with
+// the remote resource.
+//
+// Rules on the security group but not configured on this resource are revoked.
+// Rules cannot be created from their IDs alone, so configured rules that do not
+// exist on the security group are an error.
+func (r *securityGroupRulesExclusiveResource) syncRules(ctx context.Context, groupID string, wantIngress, wantEgress []string) error {
+	conn := r.Meta().EC2Client(ctx)
+
+	haveIngress, haveEgress, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, groupID)
+	if err != nil {
+		return err
+	}
+
+	missingIngress, removeIngress, _ := intflex.DiffSlices(haveIngress, wantIngress, func(s1, s2 string) bool { return s1 == s2 })
+	missingEgress, removeEgress, _ := intflex.DiffSlices(haveEgress, wantEgress, func(s1, s2 string) bool { return s1 == s2 })
+
+	if missing := slices.Concat(missingIngress, missingEgress); len(missing) > 0 {
+		return fmt.Errorf("security group rules not found: %s", strings.Join(missing, ", "))
+	}
+
+	if len(removeIngress) > 0 {
+		input := ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: removeIngress,
+		}
+
+		if _, err := conn.RevokeSecurityGroupIngress(ctx, &input); err != nil {
+			return fmt.Errorf("revoking ingress rules: %w", err)
+		}
+	}
+
+	if len(removeEgress) > 0 {
+		input := ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: removeEgress,
+		}
+
+		if _, err := conn.RevokeSecurityGroupEgress(ctx, &input); err != nil {
+			return fmt.Errorf("revoking egress rules: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// findSecurityGroupRuleIDsBySecurityGroupID returns the IDs of the ingress and egress rules on the specified security group.
+// A NotFound error is returned if the security group does not exist.
+func findSecurityGroupRuleIDsBySecurityGroupID(ctx context.Context, conn *ec2.Client, id string) ([]string, []string, error) {
+	if _, err := findSecurityGroupByID(ctx, conn, id); err != nil {
+		return nil, nil, err
+	}
+
+	rules, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, id)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ingress, egress := []string{}, []string{}
+	for _, v := range rules {
+		if aws.ToBool(v.IsEgress) {
+			egress = append(egress, aws.ToString(v.SecurityGroupRuleId))
+		} else {
+			ingress = append(ingress, aws.ToString(v.SecurityGroupRuleId))
+		}
+	}
+
+	return ingress, egress, nil
+}
+
+type securityGroupRulesExclusiveResourceModel struct {
+	framework.WithRegionModel
+	EgressRuleIDs   fwtypes.SetOfString `tfsdk:"egress_rule_ids"`
+	IngressRuleIDs  fwtypes.SetOfString `tfsdk:"ingress_rule_ids"`
+	SecurityGroupID types.String        `tfsdk:"security_group_id"`
+}
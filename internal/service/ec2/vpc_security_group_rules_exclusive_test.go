@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCSecurityGroupRulesExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules_exclusive.test"
+	ingressRuleResourceName := "aws_vpc_security_group_ingress_rule.test"
+	egressRuleResourceName := "aws_vpc_security_group_egress_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", "aws_security_group.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "ingress_rule_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "ingress_rule_ids.*", ingressRuleResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "egress_rule_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "egress_rule_ids.*", egressRuleResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "security_group_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "security_group_id",
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRulesExclusive_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_empty(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ingress_rule_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "egress_rule_ids.#", "0"),
+				),
+				// The empty rule ID arguments in the exclusive lock will revoke the
+				// rules defined in this configuration, so a diff is expected.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// A rule added out of band should be revoked.
+func TestAccVPCSecurityGroupRulesExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SecurityGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, "aws_security_group.test", &v),
+					testAccCheckSecurityGroupRulesExclusiveExists(ctx, resourceName),
+					testAccCheckSecurityGroupRulesExclusiveAuthorizeIngress(ctx, &v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ingress_rule_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "egress_rule_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityGroupRulesExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameSecurityGroupRulesExclusive, n, errors.New("not found"))
+		}
+
+		groupID := rs.Primary.Attributes["security_group_id"]
+		if groupID == "" {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameSecurityGroupRulesExclusive, n, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		ingress, egress, err := tfec2.FindSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, groupID)
+
+		if err != nil {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameSecurityGroupRulesExclusive, groupID, err)
+		}
+
+		if rs.Primary.Attributes["ingress_rule_ids.#"] != strconv.Itoa(len(ingress)) {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameSecurityGroupRulesExclusive, groupID, errors.New("unexpected ingress_rule_ids count"))
+		}
+
+		if rs.Primary.Attributes["egress_rule_ids.#"] != strconv.Itoa(len(egress)) {
+			return create.Error(names.EC2, create.ErrActionCheckingExistence, tfec2.ResNameSecurityGroupRulesExclusive, groupID, errors.New("unexpected egress_rule_ids count"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupRulesExclusiveAuthorizeIngress(ctx context.Context, v *awstypes.SecurityGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		input := ec2.AuthorizeSecurityGroupIngressInput{
+			CidrIp:     aws.String("10.1.0.0/16"),
+			FromPort:   aws.Int32(22),
+			GroupId:    v.GroupId,
+			IpProtocol: aws.String("tcp"),
+			ToPort:     aws.Int32(22),
+		}
+
+		_, err := conn.AuthorizeSecurityGroupIngress(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccVPCSecurityGroupRulesExclusiveConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 443
+  ip_protocol = "tcp"
+  to_port     = 443
+}
+
+resource "aws_vpc_security_group_egress_rule" "test" {
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "0.0.0.0/0"
+  ip_protocol = "-1"
+}
+`)
+}
+
+func testAccVPCSecurityGroupRulesExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRulesExclusiveConfig_base(rName), `
+resource "aws_vpc_security_group_rules_exclusive" "test" {
+  security_group_id = aws_security_group.test.id
+  ingress_rule_ids  = [aws_vpc_security_group_ingress_rule.test.id]
+  egress_rule_ids   = [aws_vpc_security_group_egress_rule.test.id]
+}
+`)
+}
+
+func testAccVPCSecurityGroupRulesExclusiveConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRulesExclusiveConfig_base(rName), `
+resource "aws_vpc_security_group_rules_exclusive" "test" {
+  # Wait until the rules are created, then provision the exclusive
+  # lock which will revoke them. This creates a diff on the next plan
+  # (to re-create the rules) which the test can check for.
+  depends_on = [
+    aws_vpc_security_group_ingress_rule.test,
+    aws_vpc_security_group_egress_rule.test,
+  ]
+
+  security_group_id = aws_security_group.test.id
+  ingress_rule_ids  = []
+  egress_rule_ids   = []
+}
+`)
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// updateServiceDeploymentPollInterval defines polling cadence for update service deployment action.
+const updateServiceDeploymentPollInterval = 15 * time.Second
+
+const (
+	// Non-standard deployment rollout state values.
+	deploymentRolloutStateReplaced = "tfREPLACED"
+)
+
+// @Action(aws_ecs_update_service_deployment, name="Update Service Deployment")
+func newUpdateServiceDeploymentAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &updateServiceDeploymentAction{}, nil
+}
+
+var (
+	_ action.Action = (*updateServiceDeploymentAction)(nil)
+)
+
+type updateServiceDeploymentAction struct {
+	framework.ActionWithModel[updateServiceDeploymentModel]
+}
+
+type updateServiceDeploymentModel struct {
+	framework.WithRegionModel
+	Cluster types.String `tfsdk:"cluster"`
+	Service types.String `tfsdk:"service"`
+	Timeout types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *updateServiceDeploymentAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forces a new deployment of an ECS service and waits for the deployment rollout to complete.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "The name or ARN of the cluster that hosts the service (default: the default cluster)",
+				Optional:    true,
+			},
+			"service": schema.StringAttribute{
+				Description: "The name or ARN of the service to redeploy",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the deployment to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(7200),
+				},
+			},
+		},
+	}
+}
+
+func (a *updateServiceDeploymentAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config updateServiceDeploymentModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ECSClient(ctx)
+
+	cluster := config.Cluster.ValueString()
+	service := config.Service.ValueString()
+
+	timeout := 1800 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting ECS update service deployment action", map[string]any{
+		"cluster":         cluster,
+		"service":         service,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Forcing new deployment of ECS service %s...", service),
+	})
+
+	input := ecs.UpdateServiceInput{
+		ForceNewDeployment: true,
+		Service:            aws.String(service),
+	}
+	if cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+
+	output, err := conn.UpdateService(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Update Service",
+			fmt.Sprintf("Could not force new deployment of ECS service %s: %s", service, err),
+		)
+		return
+	}
+
+	deployment := findPrimaryTaskSet(output.Service.Deployments)
+	if deployment == nil {
+		resp.Diagnostics.AddError(
+			"Deployment Not Found",
+			fmt.Sprintf("UpdateService response for ECS service %s did not include a primary deployment", service),
+		)
+		return
+	}
+	deploymentID := aws.ToString(deployment.Id)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Deployment %s of ECS service %s started, waiting for rollout to complete...", deploymentID, service),
+	})
+
+	// Each poll reports the task counts of the new deployment, so progress is
+	// sent on every poll rather than being throttled to a longer interval.
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Deployment], error) {
+		output, derr := findServiceNoTagsByTwoPartKey(ctx, conn, service, cluster)
+		if derr != nil {
+			return actionwait.FetchResult[*awstypes.Deployment]{}, fmt.Errorf("describing service: %w", derr)
+		}
+
+		deployment, status := serviceDeploymentRolloutState(output, deploymentID)
+		return actionwait.FetchResult[*awstypes.Deployment]{Status: actionwait.Status(status), Value: deployment}, nil
+	}, actionwait.Options[*awstypes.Deployment]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(updateServiceDeploymentPollInterval),
+		ProgressInterval: updateServiceDeploymentPollInterval,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.DeploymentRolloutStateCompleted)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.DeploymentRolloutStateInProgress),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.DeploymentRolloutStateFailed),
+			deploymentRolloutStateReplaced,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			message := fmt.Sprintf("Deployment %s of ECS service %s is currently in state '%s'", deploymentID, service, fr.Status)
+			if deployment, ok := fr.Value.(*awstypes.Deployment); ok && deployment != nil {
+				message = fmt.Sprintf("Deployment %s of ECS service %s is currently in state '%s' (running: %d, pending: %d, desired: %d)", deploymentID, service, fr.Status, deployment.RunningCount, deployment.PendingCount, deployment.DesiredCount)
+			}
+			resp.SendProgress(action.InvokeProgressEvent{Message: message})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Deployment",
+				fmt.Sprintf("Deployment %s of ECS service %s did not complete within %s: %s", deploymentID, service, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			reason := string(failureErr.Status)
+			if failureErr.Status == deploymentRolloutStateReplaced {
+				reason = "the deployment was replaced by another deployment, such as a deployment circuit breaker rollback"
+			} else if fr.Value != nil && fr.Value.RolloutStateReason != nil {
+				reason = aws.ToString(fr.Value.RolloutStateReason)
+			}
+			resp.Diagnostics.AddError(
+				"Deployment Failed",
+				fmt.Sprintf("Deployment %s of ECS service %s failed: %s", deploymentID, service, reason),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Deployment State",
+				fmt.Sprintf("Deployment %s of ECS service %s entered unexpected state: %s", deploymentID, service, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Deployment",
+				fmt.Sprintf("Error while waiting for deployment %s of ECS service %s: %s", deploymentID, service, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Deployment %s of ECS service %s completed successfully", deploymentID, service),
+	})
+
+	tflog.Info(ctx, "ECS update service deployment action completed successfully", map[string]any{
+		"service":       service,
+		"deployment_id": deploymentID,
+	})
+}
+
+// serviceDeploymentRolloutState returns the specified deployment of a service and its rollout state.
+// A deployment that is no longer the primary deployment, for example after a deployment circuit breaker
+// rollback, is reported as replaced.
+func serviceDeploymentRolloutState(service *awstypes.Service, deploymentID string) (*awstypes.Deployment, string) {
+	for _, v := range service.Deployments {
+		if aws.ToString(v.Id) != deploymentID {
+			continue
+		}
+
+		if aws.ToString(v.Status) != taskSetStatusPrimary {
+			if v.RolloutState == awstypes.DeploymentRolloutStateFailed {
+				return &v, string(v.RolloutState)
+			}
+			return &v, deploymentRolloutStateReplaced
+		}
+
+		if v.RolloutState != "" {
+			return &v, string(v.RolloutState)
+		}
+
+		// The rollout state is only reported for services using the rolling update (ECS) deployment type.
+		if len(service.Deployments) == 1 && v.RunningCount == v.DesiredCount {
+			return &v, string(awstypes.DeploymentRolloutStateCompleted)
+		}
+
+		return &v, string(awstypes.DeploymentRolloutStateInProgress)
+	}
+
+	return nil, deploymentRolloutStateReplaced
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSUpdateServiceDeploymentAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service awstypes.Service
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUpdateServiceDeploymentActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					testAccCheckServiceRedeployed(&service),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckServiceRedeployed checks that the service's only deployment was created after the service
+// and that its rollout has completed.
+func testAccCheckServiceRedeployed(service *awstypes.Service) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if n := len(service.Deployments); n != 1 {
+			return fmt.Errorf("ECS Service (%s) has %d deployments, want 1", aws.ToString(service.ServiceName), n)
+		}
+
+		deployment := service.Deployments[0]
+
+		if !aws.ToTime(deployment.CreatedAt).After(aws.ToTime(service.CreatedAt)) {
+			return fmt.Errorf("ECS Service (%s) deployment (%s) was not created by a new deployment", aws.ToString(service.ServiceName), aws.ToString(deployment.Id))
+		}
+
+		if got, want := deployment.RolloutState, awstypes.DeploymentRolloutStateCompleted; got != want {
+			return fmt.Errorf("ECS Service (%s) deployment (%s) rollout state = %q, want %q", aws.ToString(service.ServiceName), aws.ToString(deployment.Id), got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccUpdateServiceDeploymentActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 128,
+    "name": "mongodb"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = 0
+}
+
+action "aws_ecs_update_service_deployment" "test" {
+  config {
+    cluster = aws_ecs_cluster.test.name
+    service = aws_ecs_service.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_ecs_service.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ecs_update_service_deployment.test]
+    }
+  }
+}
+`, rName)
+}
@@ -61,6 +61,7 @@ func resourcePolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"assertions": policyAssertionsSchema(),
 			"attachment_count": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -132,6 +133,11 @@ func resourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 
 	name := create.Name(d.Get(names.AttrName).(string), d.Get(names.AttrNamePrefix).(string))
+
+	if err := checkPolicyAssertions(ctx, customPolicySimulator(conn, []string{policy}, nil), expandPolicyAssertions(d.Get("assertions").([]any))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "IAM Policy (%s) assertions: %s", name, err)
+	}
+
 	input := iam.CreatePolicyInput{
 		Description:    aws.String(d.Get(names.AttrDescription).(string)),
 		Path:           aws.String(d.Get(names.AttrPath).(string)),
@@ -224,7 +230,13 @@ func resourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChanges("assertions", names.AttrPolicy) {
+		if err := checkPolicyAssertions(ctx, customPolicySimulator(conn, []string{d.Get(names.AttrPolicy).(string)}, nil), expandPolicyAssertions(d.Get("assertions").([]any))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "IAM Policy (%s) assertions: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChangesExcept("assertions", names.AttrTags, names.AttrTagsAll) {
 		if err := policyPruneVersions(ctx, conn, d.Id()); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
@@ -469,6 +481,23 @@ func findPolicyVersion(ctx context.Context, conn *iam.Client, input *iam.GetPoli
 	return output.PolicyVersion, nil
 }
 
+// findPolicyDocumentByARN returns the decoded document of the specified managed policy's default version.
+func findPolicyDocumentByARN(ctx context.Context, conn *iam.Client, arn string) (string, error) {
+	policy, err := findPolicyByARN(ctx, conn, arn)
+
+	if err != nil {
+		return "", err
+	}
+
+	policyVersion, err := findPolicyVersionByTwoPartKey(ctx, conn, arn, aws.ToString(policy.DefaultVersionId))
+
+	if err != nil {
+		return "", err
+	}
+
+	return url.QueryUnescape(aws.ToString(policyVersion.Document))
+}
+
 func findPolicyVersionsByARN(ctx context.Context, conn *iam.Client, arn string) ([]awstypes.PolicyVersion, error) {
 	input := iam.ListPolicyVersionsInput{
 		PolicyArn: aws.String(arn),
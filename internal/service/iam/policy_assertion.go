@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	policyAssertionResourceAll = "*"
+)
+
+func policyAssertionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrAction: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"decision": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.PolicyEvaluationDecisionType](),
+				},
+				"resource": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  policyAssertionResourceAll,
+				},
+			},
+		},
+	}
+}
+
+type policyAssertion struct {
+	action   string
+	decision awstypes.PolicyEvaluationDecisionType
+	resource string
+}
+
+func expandPolicyAssertions(tfList []any) []policyAssertion {
+	var apiObjects []policyAssertion
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, policyAssertion{
+			action:   tfMap[names.AttrAction].(string),
+			decision: awstypes.PolicyEvaluationDecisionType(tfMap["decision"].(string)),
+			resource: tfMap["resource"].(string),
+		})
+	}
+
+	return apiObjects
+}
+
+// policySimulator returns the IAM policy simulator's evaluation results for the specified actions.
+// All resources are simulated if resourceARNs is empty.
+type policySimulator func(ctx context.Context, actions, resourceARNs []string) ([]awstypes.EvaluationResult, error)
+
+// customPolicySimulator simulates the specified identity-based policy documents,
+// limited by the specified permissions boundary policy documents if any.
+func customPolicySimulator(conn iam.SimulateCustomPolicyAPIClient, policies, boundaryPolicies []string) policySimulator {
+	return func(ctx context.Context, actions, resourceARNs []string) ([]awstypes.EvaluationResult, error) {
+		var results []awstypes.EvaluationResult
+
+		// Nothing is allowed without any policies.
+		if len(policies) == 0 {
+			for _, v := range actions {
+				results = append(results, awstypes.EvaluationResult{
+					EvalActionName: aws.String(v),
+					EvalDecision:   awstypes.PolicyEvaluationDecisionTypeImplicitDeny,
+				})
+			}
+
+			return results, nil
+		}
+
+		input := iam.SimulateCustomPolicyInput{
+			ActionNames:     actions,
+			PolicyInputList: policies,
+			ResourceArns:    resourceARNs,
+		}
+		if len(boundaryPolicies) > 0 {
+			input.PermissionsBoundaryPolicyInputList = boundaryPolicies
+		}
+
+		pages := iam.NewSimulateCustomPolicyPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			results = append(results, page.EvaluationResults...)
+		}
+
+		return results, nil
+	}
+}
+
+// checkPolicyAssertions simulates policies with the specified simulator and returns an error
+// listing every assertion whose decision differs from the simulated decision.
+func checkPolicyAssertions(ctx context.Context, simulate policySimulator, assertions []policyAssertion) error {
+	if len(assertions) == 0 {
+		return nil
+	}
+
+	decisions, err := simulatePolicyDecisions(ctx, simulate, assertions)
+	if err != nil {
+		return fmt.Errorf("simulating IAM policy: %w", err)
+	}
+
+	var errs []error
+	for i, v := range assertions {
+		decision, ok := decisions[newPolicyAssertionKey(v.action, v.resource)]
+		if !ok {
+			errs = append(errs, fmt.Errorf("assertion %d (%s on %s): no simulation result", i, v.action, v.resource))
+			continue
+		}
+
+		if decision != v.decision {
+			errs = append(errs, fmt.Errorf("assertion %d (%s on %s): expected %s, simulated %s", i, v.action, v.resource, v.decision, decision))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+type policyAssertionKey struct {
+	action   string
+	resource string
+}
+
+// Action names are not case sensitive.
+func newPolicyAssertionKey(action, resource string) policyAssertionKey {
+	return policyAssertionKey{
+		action:   strings.ToLower(action),
+		resource: resource,
+	}
+}
+
+// simulatePolicyDecisions returns the simulated decision for each assertion's action and resource.
+// Each distinct resource is simulated in a single request for all of its actions.
+func simulatePolicyDecisions(ctx context.Context, simulate policySimulator, assertions []policyAssertion) (map[policyAssertionKey]awstypes.PolicyEvaluationDecisionType, error) {
+	decisions := make(map[policyAssertionKey]awstypes.PolicyEvaluationDecisionType)
+
+	var resources []string
+	actionsByResource := make(map[string][]string)
+	for _, v := range assertions {
+		if _, ok := actionsByResource[v.resource]; !ok {
+			resources = append(resources, v.resource)
+		}
+		actionsByResource[v.resource] = append(actionsByResource[v.resource], v.action)
+	}
+
+	for _, resource := range resources {
+		var resourceARNs []string
+		// All resources are simulated if none are specified.
+		if resource != policyAssertionResourceAll {
+			resourceARNs = []string{resource}
+		}
+
+		results, err := simulate(ctx, actionsByResource[resource], resourceARNs)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range results {
+			decisions[newPolicyAssertionKey(aws.ToString(v.EvalActionName), resource)] = v.EvalDecision
+		}
+	}
+
+	return decisions, nil
+}
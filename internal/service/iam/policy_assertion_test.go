@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// mockSimulatePolicyClient allows actions starting with "s3:Get", on any resource.
+type mockSimulatePolicyClient struct {
+	inputs []*iam.SimulateCustomPolicyInput
+	err    error
+}
+
+func (m *mockSimulatePolicyClient) SimulateCustomPolicy(_ context.Context, input *iam.SimulateCustomPolicyInput, _ ...func(*iam.Options)) (*iam.SimulateCustomPolicyOutput, error) {
+	m.inputs = append(m.inputs, input)
+
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	return &iam.SimulateCustomPolicyOutput{
+		EvaluationResults: mockEvaluationResults(input.ActionNames),
+	}, nil
+}
+
+func mockEvaluationResults(actions []string) []awstypes.EvaluationResult {
+	var results []awstypes.EvaluationResult
+	for _, v := range actions {
+		decision := awstypes.PolicyEvaluationDecisionTypeImplicitDeny
+		if strings.HasPrefix(strings.ToLower(v), "s3:get") {
+			decision = awstypes.PolicyEvaluationDecisionTypeAllowed
+		}
+		results = append(results, awstypes.EvaluationResult{
+			EvalActionName: aws.String(v),
+			EvalDecision:   decision,
+		})
+	}
+
+	return results
+}
+
+func TestCheckPolicyAssertions(t *testing.T) {
+	t.Parallel()
+
+	const (
+		policy         = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:Get*","Resource":"*"}]}`
+		boundaryPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`
+	)
+
+	testCases := map[string]struct {
+		policies         []string
+		boundaryPolicies []string
+		assertions       []policyAssertion
+		clientErr        error
+		wantErr          string
+		wantSimulated    int
+	}{
+		"no assertions": {
+			policies: []string{policy},
+		},
+		"all hold": {
+			policies: []string{policy},
+			assertions: []policyAssertion{
+				{action: "s3:GetObject", decision: awstypes.PolicyEvaluationDecisionTypeAllowed, resource: policyAssertionResourceAll},
+				{action: "s3:PutObject", decision: awstypes.PolicyEvaluationDecisionTypeImplicitDeny, resource: policyAssertionResourceAll},
+				{action: "s3:GetObject", decision: awstypes.PolicyEvaluationDecisionTypeAllowed, resource: "arn:aws:s3:::example/*"},
+			},
+			wantSimulated: 2,
+		},
+		"one fails": {
+			policies: []string{policy},
+			assertions: []policyAssertion{
+				{action: "s3:GetObject", decision: awstypes.PolicyEvaluationDecisionTypeAllowed, resource: policyAssertionResourceAll},
+				{action: "s3:DeleteBucket", decision: awstypes.PolicyEvaluationDecisionTypeAllowed, resource: policyAssertionResourceAll},
+			},
+			wantErr:       "assertion 1 (s3:DeleteBucket on *): expected allowed, simulated implicitDeny",
+			wantSimulated: 1,
+		},
+		"no policies": {
+			assertions: []policyAssertion{
+				{action: "s3:GetObject", decision: awstypes.PolicyEvaluationDecisionTypeImplicitDeny, resource: policyAssertionResourceAll},
+				{action: "s3:ListBucket", decision: awstypes.PolicyEvaluationDecisionTypeAllowed, resource: policyAssertionResourceAll},
+			},
+			wantErr: "assertion 1 (s3:ListBucket on *): expected allowed, simulated implicitDeny",
+		},
+		"client error": {
+			policies: []string{policy},
+			assertions: []policyAssertion{
+				{action: "s3:GetObject", decision: awstypes.PolicyEvaluationDecisionTypeAllowed, resource: policyAssertionResourceAll},
+			},
+			clientErr:     errors.New("boom"),
+			wantErr:       "simulating IAM policy: boom",
+			wantSimulated: 1,
+		},
+		"permissions boundary": {
+			policies:         []string{policy},
+			boundaryPolicies: []string{boundaryPolicy},
+			assertions: []policyAssertion{
+				{action: "s3:GetObject", decision: awstypes.PolicyEvaluationDecisionTypeAllowed, resource: "arn:aws:s3:::example/*"},
+				{action: "s3:PutObject", decision: awstypes.PolicyEvaluationDecisionTypeImplicitDeny, resource: "arn:aws:s3:::example/*"},
+			},
+			wantSimulated: 1,
+		},
+		"permissions boundary no policies": {
+			boundaryPolicies: []string{boundaryPolicy},
+			assertions: []policyAssertion{
+				{action: "s3:GetObject", decision: awstypes.PolicyEvaluationDecisionTypeImplicitDeny, resource: policyAssertionResourceAll},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := &mockSimulatePolicyClient{err: testCase.clientErr}
+
+			simulate := customPolicySimulator(conn, testCase.policies, testCase.boundaryPolicies)
+
+			err := checkPolicyAssertions(context.Background(), simulate, testCase.assertions)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Fatalf("got error %v, expected %q", err, testCase.wantErr)
+			}
+
+			if got, expected := len(conn.inputs), testCase.wantSimulated; got != expected {
+				t.Fatalf("simulations: got %d, expected %d", got, expected)
+			}
+
+			for _, input := range conn.inputs {
+				if !slices.Equal(input.PolicyInputList, testCase.policies) {
+					t.Errorf("PolicyInputList: got %v, expected %v", input.PolicyInputList, testCase.policies)
+				}
+				if !slices.Equal(input.PermissionsBoundaryPolicyInputList, testCase.boundaryPolicies) {
+					t.Errorf("PermissionsBoundaryPolicyInputList: got %v, expected %v", input.PermissionsBoundaryPolicyInputList, testCase.boundaryPolicies)
+				}
+				if slices.Contains(input.ResourceArns, policyAssertionResourceAll) {
+					t.Errorf("ResourceArns: unexpected %q", policyAssertionResourceAll)
+				}
+			}
+		})
+	}
+}
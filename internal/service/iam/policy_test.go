@@ -85,6 +85,39 @@ func TestAccIAMPolicy_description(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicy_assertions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var out awstypes.Policy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfig_assertions(rName, "ec2:TerminateInstances", "allowed"),
+				ExpectError: regexache.MustCompile(`assertion 1 \(ec2:TerminateInstances on \*\): expected allowed, simulated implicitDeny`),
+			},
+			{
+				Config: testAccPolicyConfig_assertions(rName, "ec2:TerminateInstances", "implicitDeny"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &out),
+					resource.TestCheckResourceAttr(resourceName, "assertions.#", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"assertions"},
+			},
+		},
+	})
+}
+
 func TestAccIAMPolicy_whitespace(t *testing.T) {
 	ctx := acctest.Context(t)
 	var out awstypes.Policy
@@ -491,6 +524,33 @@ EOF
 `, description, rName)
 }
 
+func testAccPolicyConfig_assertions(rName, action, decision string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  name = %[1]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["ec2:Describe*"]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+
+  assertions {
+    action   = "ec2:DescribeInstances"
+    decision = "allowed"
+  }
+
+  assertions {
+    action   = %[2]q
+    decision = %[3]q
+  }
+}
+`, rName, action, decision)
+}
+
 func testAccPolicyConfig_name(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_policy" "test" {
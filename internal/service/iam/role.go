@@ -85,6 +85,7 @@ func resourceRole() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"assertions": policyAssertionsSchema(),
 			"assume_role_policy": {
 				Type:                  schema.TypeString,
 				Required:              true,
@@ -230,6 +231,13 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 	}
 
 	name := create.Name(d.Get(names.AttrName).(string), d.Get(names.AttrNamePrefix).(string))
+
+	if simulate, err := rolePolicySimulator(ctx, conn, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "IAM Role (%s) assertions: %s", name, err)
+	} else if err := checkPolicyAssertions(ctx, simulate, expandPolicyAssertions(d.Get("assertions").([]any))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "IAM Role (%s) assertions: %s", name, err)
+	}
+
 	input := iam.CreateRoleInput{
 		AssumeRolePolicyDocument: aws.String(assumeRolePolicy),
 		Path:                     aws.String(d.Get(names.AttrPath).(string)),
@@ -362,6 +370,14 @@ func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 
+	if d.HasChanges("assertions", "inline_policy", "managed_policy_arns", "permissions_boundary") {
+		if simulate, err := rolePolicySimulator(ctx, conn, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "IAM Role (%s) assertions: %s", d.Id(), err)
+		} else if err := checkPolicyAssertions(ctx, simulate, expandPolicyAssertions(d.Get("assertions").([]any))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "IAM Role (%s) assertions: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("assume_role_policy") {
 		assumeRolePolicy, err := structure.NormalizeJsonString(d.Get("assume_role_policy").(string))
 		if err != nil {
@@ -932,6 +948,54 @@ func expandRoleInlinePolicy(roleName string, tfMap map[string]any) *iam.PutRoleP
 	return apiObject
 }
 
+// rolePolicySimulator returns a simulator for the role's planned policies: its inline policies and managed policies,
+// limited by its permissions boundary.
+// `inline_policy` and `managed_policy_arns` are computed, so if they are not configured they hold the policies read from an existing role.
+func rolePolicySimulator(ctx context.Context, conn *iam.Client, d *schema.ResourceData) (policySimulator, error) {
+	// Policies are only read if there are assertions to check.
+	if len(d.Get("assertions").([]any)) == 0 {
+		return nil, nil
+	}
+
+	policies := roleInlinePolicyDocuments(d.Get("inline_policy").(*schema.Set).List())
+
+	for _, arn := range flex.ExpandStringValueSet(d.Get("managed_policy_arns").(*schema.Set)) {
+		policy, err := findPolicyDocumentByARN(ctx, conn, arn)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading IAM Policy (%s): %w", arn, err)
+		}
+
+		policies = append(policies, policy)
+	}
+
+	var boundaryPolicies []string
+	if arn := d.Get("permissions_boundary").(string); arn != "" {
+		policy, err := findPolicyDocumentByARN(ctx, conn, arn)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading IAM Policy (%s): %w", arn, err)
+		}
+
+		boundaryPolicies = append(boundaryPolicies, policy)
+	}
+
+	return customPolicySimulator(conn, policies, boundaryPolicies), nil
+}
+
+// roleInlinePolicyDocuments returns the policy documents of the configured inline policies.
+func roleInlinePolicyDocuments(tfList []any) []string {
+	var policies []string
+
+	for _, apiObject := range expandRoleInlinePolicies("", tfList) {
+		if v := aws.ToString(apiObject.PolicyDocument); v != "" {
+			policies = append(policies, v)
+		}
+	}
+
+	return policies
+}
+
 func expandRoleInlinePolicies(roleName string, tfList []any) []*iam.PutRolePolicyInput {
 	if len(tfList) == 0 {
 		return nil
@@ -100,6 +100,38 @@ func TestAccIAMRole_description(t *testing.T) {
 	})
 }
 
+func TestAccIAMRole_assertions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRoleConfig_assertions(rName, "s3:PutObject", "allowed"),
+				ExpectError: regexache.MustCompile(`assertion 1 \(s3:PutObject on arn:aws:s3:::example/\*\): expected allowed, simulated implicitDeny`),
+			},
+			{
+				Config: testAccRoleConfig_assertions(rName, "s3:PutObject", "implicitDeny"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRoleExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "assertions.#", "2"),
+				),
+			},
+			{
+				// Assertions are checked when only they change.
+				Config:      testAccRoleConfig_assertions(rName, "s3:GetObject", "explicitDeny"),
+				ExpectError: regexache.MustCompile(`assertion 1 \(s3:GetObject on arn:aws:s3:::example/\*\): expected explicitDeny, simulated allowed`),
+			},
+		},
+	})
+}
+
 func TestAccIAMRole_nameGenerated(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf awstypes.Role
@@ -1329,6 +1361,54 @@ resource "aws_iam_role" "test" {
 `, rName)
 }
 
+func testAccRoleConfig_assertions(rName, action, decision string) string {
+	return fmt.Sprintf(`
+data "aws_service_principal" "ec2" {
+  service_name = "ec2"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole",
+      Principal = {
+        Service = data.aws_service_principal.ec2.name,
+      }
+      Effect = "Allow"
+    }]
+  })
+
+  inline_policy {
+    name = %[1]q
+
+    policy = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Action   = ["s3:GetObject"]
+        Effect   = "Allow"
+        Resource = "arn:aws:s3:::example/*"
+      }]
+    })
+  }
+
+  assertions {
+    action   = "s3:GetObject"
+    resource = "arn:aws:s3:::example/*"
+    decision = "allowed"
+  }
+
+  assertions {
+    action   = %[2]q
+    resource = "arn:aws:s3:::example/*"
+    decision = %[3]q
+  }
+}
+`, rName, action, decision)
+}
+
 func testAccRoleConfig_invalidAssumeRolePolicy(rName string) string {
 	return fmt.Sprintf(`
 data "aws_service_principal" "ec2" {
@@ -13,6 +13,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -22,11 +24,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -130,6 +135,14 @@ func resourceKey() *schema.Resource {
 	}
 }
 
+// @SDKListResource("aws_kms_key")
+func keyResourceAsListResource() inttypes.ListResourceForSDK {
+	l := keyListResource{}
+	l.SetResourceSchema(resourceKey())
+
+	return &l
+}
+
 func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
@@ -772,3 +785,119 @@ func waitKeyStatePropagated(ctx context.Context, conn *kms.Client, keyID string,
 
 	return tfresource.WaitUntil(ctx, timeout, checkFunc, opts)
 }
+
+var _ list.ListResourceWithRawV5Schemas = &keyListResource{}
+
+type keyListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+	framework.ListResourceWithSDKv2Tags
+}
+
+type keyListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *keyListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{},
+	}
+}
+
+func (l *keyListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.KMSClient(ctx)
+
+	var query keyListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input kms.ListKeysInput
+		pages := kms.NewListKeysPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			for _, v := range page.Keys {
+				keyID := aws.ToString(v.KeyId)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), keyID)
+
+				key, err := findKeyByID(ctx, conn, keyID)
+				if tfresource.NotFound(err) {
+					tflog.Warn(ctx, "Resource disappeared during listing, skipping")
+					continue
+				}
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(err)
+					yield(result)
+					return
+				}
+
+				// AWS managed keys cannot be managed by Terraform.
+				if key.KeyManager == awstypes.KeyManagerTypeAws {
+					continue
+				}
+
+				// Multi-Region replica keys are managed by aws_kms_replica_key.
+				if aws.ToBool(key.MultiRegion) && key.MultiRegionConfiguration != nil && key.MultiRegionConfiguration.MultiRegionKeyType != awstypes.MultiRegionKeyTypePrimary {
+					continue
+				}
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(keyID)
+				rd.Set(names.AttrARN, key.Arn)
+				rd.Set(names.AttrKeyID, keyID)
+
+				if v := aws.ToString(key.Description); v != "" {
+					result.DisplayName = fmt.Sprintf("%s (%s)", v, keyID)
+				} else {
+					result.DisplayName = keyID
+				}
+
+				if request.IncludeResource {
+					tflog.Info(ctx, "Reading resource")
+					if err := sdkdiag.DiagnosticsError(resourceKeyRead(ctx, rd, awsClient)); err != nil {
+						result = fwdiag.NewListResultErrorDiagnostic(err)
+						yield(result)
+						return
+					}
+					if rd.Id() == "" {
+						tflog.Warn(ctx, "Resource disappeared during listing, skipping")
+						continue
+					}
+
+					// set tags
+					err = l.SetTags(ctx, awsClient, rd)
+					if err != nil {
+						result = fwdiag.NewListResultErrorDiagnostic(err)
+						yield(result)
+						return
+					}
+				}
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
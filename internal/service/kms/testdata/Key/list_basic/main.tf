@@ -0,0 +1,18 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "aws" {}
+
+resource "aws_kms_key" "test" {
+  count = 3
+
+  description             = "${var.rName}-${count.index}"
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
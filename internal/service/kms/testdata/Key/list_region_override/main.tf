@@ -0,0 +1,26 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+provider "aws" {}
+
+resource "aws_kms_key" "test" {
+  count = 3
+
+  region = var.region
+
+  description             = "${var.rName}-${count.index}"
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
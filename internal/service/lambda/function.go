@@ -15,6 +15,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -23,14 +26,18 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -183,7 +190,7 @@ func resourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -222,7 +229,7 @@ func resourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -336,7 +343,7 @@ func resourceFunction() *schema.Resource {
 			names.AttrS3Bucket: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -347,7 +354,7 @@ func resourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -390,6 +397,12 @@ func resourceFunction() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ExactlyOneOf:  []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
+				ConflictsWith: []string{"source_code_hash"},
+			},
 			"source_kms_key_arn": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -474,11 +487,20 @@ func resourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			setSourceCodeHashForSourceDir,
 			updateComputedAttributesOnPublish,
 		),
 	}
 }
 
+// @SDKListResource("aws_lambda_function")
+func functionResourceAsListResource() inttypes.ListResourceForSDK {
+	l := functionListResource{}
+	l.SetResourceSchema(resourceFunction())
+
+	return &l
+}
+
 func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
@@ -509,6 +531,17 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta an
 			return sdkdiag.AppendErrorf(diags, "reading ZIP file (%s): %s", v, err)
 		}
 
+		input.Code.ZipFile = zipFile
+	} else if v, ok := d.GetOk("source_dir"); ok {
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		zipFile, err := tfio.ZipDirectory(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "building ZIP file from source directory (%s): %s", v, err)
+		}
+
 		input.Code.ZipFile = zipFile
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
@@ -1010,6 +1043,17 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta an
 				return sdkdiag.AppendErrorf(diags, "reading ZIP file (%s): %s", v, err)
 			}
 
+			input.ZipFile = zipFile
+		} else if v, ok := d.GetOk("source_dir"); ok {
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			zipFile, err := tfio.ZipDirectory(v.(string))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "building ZIP file from source directory (%s): %s", v, err)
+			}
+
 			input.ZipFile = zipFile
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
@@ -1485,6 +1529,34 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// setSourceCodeHashForSourceDir sets source_code_hash to the hash of the ZIP file built from source_dir
+// so that any change to the directory's contents results in a code update.
+func setSourceCodeHashForSourceDir(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("source_dir") {
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	v, ok := d.GetOk("source_dir")
+	if !ok {
+		return nil
+	}
+
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	zipFile, err := tfio.ZipDirectory(v.(string))
+
+	if err != nil {
+		return fmt.Errorf("building ZIP file from source directory (%s): %w", v, err)
+	}
+
+	if hash := tfio.Base64SHA256(zipFile); d.Get("source_code_hash").(string) != hash {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta any) error {
 	configChanged := needsFunctionConfigUpdate(d)
 	codeChanged := needsFunctionCodeUpdate(d)
@@ -1505,6 +1577,7 @@ func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff
 func needsFunctionCodeUpdate(d sdkv2.ResourceDiffer) bool {
 	return d.HasChange("filename") ||
 		d.HasChange("source_code_hash") ||
+		d.HasChange("source_dir") ||
 		d.HasChange(names.AttrS3Bucket) ||
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
@@ -1768,10 +1841,101 @@ func flattenSnapStart(apiObject *awstypes.SnapStartResponse) []any {
 // Therefore, reset them to the previous value when the update fails.
 // https://developer.hashicorp.com/terraform/plugin/framework/diagnostics#how-errors-affect-state
 func resetNonRefreshableAttributes(d *schema.ResourceData) {
-	for _, key := range []string{names.AttrS3Bucket, "s3_key", "s3_object_version", "source_code_hash", "filename"} {
+	for _, key := range []string{names.AttrS3Bucket, "s3_key", "s3_object_version", "source_code_hash", "filename", "source_dir"} {
 		if d.HasChange(key) {
 			old, _ := d.GetChange(key)
 			d.Set(key, old)
 		}
 	}
 }
+
+var _ list.ListResourceWithRawV5Schemas = &functionListResource{}
+
+type functionListResource struct {
+	framework.ResourceWithConfigure
+	framework.ListResourceWithSDKv2Resource
+	framework.ListResourceWithSDKv2Tags
+}
+
+type functionListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *functionListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{},
+	}
+}
+
+func (l *functionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.LambdaClient(ctx)
+
+	var query functionListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Listing resources")
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input lambda.ListFunctionsInput
+		pages := lambda.NewListFunctionsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			for _, function := range page.Functions {
+				functionName := aws.ToString(function.FunctionName)
+				ctx := tflog.SetField(ctx, logging.ResourceAttributeKey("function_name"), functionName)
+
+				result := request.NewListResult(ctx)
+
+				rd := l.ResourceData()
+				rd.SetId(functionName)
+				rd.Set(names.AttrARN, function.FunctionArn)
+				rd.Set("function_name", functionName)
+
+				result.DisplayName = functionName
+
+				if request.IncludeResource {
+					tflog.Info(ctx, "Reading resource")
+					if err := sdkdiag.DiagnosticsError(resourceFunctionRead(ctx, rd, awsClient)); err != nil {
+						result = fwdiag.NewListResultErrorDiagnostic(err)
+						yield(result)
+						return
+					}
+					if rd.Id() == "" {
+						tflog.Warn(ctx, "Resource disappeared during listing, skipping")
+						continue
+					}
+
+					// set tags
+					err = l.SetTags(ctx, awsClient, rd)
+					if err != nil {
+						result = fwdiag.NewListResultErrorDiagnostic(err)
+						yield(result)
+						return
+					}
+				}
+
+				l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+				if result.Diagnostics.HasError() {
+					yield(result)
+					return
+				}
+
+				if !yield(result) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -1887,6 +1887,60 @@ func TestAccLambdaFunction_s3(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+	sourceDir := t.TempDir()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccCopySourceDirFile(t, "test-fixtures/lambda_func.js", sourceDir, "lambda.js")
+				},
+				Config: testAccFunctionConfig_sourceDir(sourceDir, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckSourceCodeHash(&conf, "YJXW64m44i9QWhuep/KB9N9hGw0G5bLQ+/30R9am2bk="),
+					resource.TestCheckResourceAttr(resourceName, "source_code_hash", "YJXW64m44i9QWhuep/KB9N9hGw0G5bLQ+/30R9am2bk="),
+				),
+			},
+			{
+				// Only the contents of the source directory affect the ZIP file.
+				PreConfig: func() {
+					now := time.Now()
+					if err := os.Chtimes(filepath.Join(sourceDir, "lambda.js"), now, now); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccFunctionConfig_sourceDir(sourceDir, rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				PreConfig: func() {
+					testAccCopySourceDirFile(t, "test-fixtures/lambda_func_modified.js", sourceDir, "lambda.js")
+				},
+				Config: testAccFunctionConfig_sourceDir(sourceDir, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckSourceCodeHash(&conf, "oaO6YOEocciCQiZUqINhMAyKL0C+f0vBBThVPRge5H0="),
+					resource.TestCheckResourceAttr(resourceName, "source_code_hash", "oaO6YOEocciCQiZUqINhMAyKL0C+f0vBBThVPRge5H0="),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_localUpdate(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2609,6 +2663,19 @@ func testAccCheckFunctionVersion(function *lambda.GetFunctionOutput, expectedVer
 	}
 }
 
+func testAccCopySourceDirFile(t *testing.T, src, dir, name string) {
+	t.Helper()
+
+	content, err := os.ReadFile(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, name), content, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func testAccCheckSourceCodeHash(function *lambda.GetFunctionOutput, expectedHash string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		c := function.Configuration
@@ -4051,6 +4118,38 @@ resource "aws_lambda_function" "test" {
 `, filePath, rName)
 }
 
+func testAccFunctionConfig_sourceDir(sourceDir, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "iam_for_lambda" {
+  name = %[2]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  source_dir    = %[1]q
+  function_name = %[2]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+}
+`, sourceDir, rName)
+}
+
 func testAccFunctionConfig_localNameOnly(filePath, rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "iam_for_lambda" {
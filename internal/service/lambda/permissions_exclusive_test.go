@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaPermissionsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_permissions_exclusive.test"
+	functionResourceName := "aws_lambda_function.test"
+	permissionResourceName := "aws_lambda_permission.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", functionResourceName, "function_name"),
+					resource.TestCheckResourceAttr(resourceName, "statement_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "statement_ids.*", permissionResourceName, "statement_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "function_name"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "function_name",
+			},
+		},
+	})
+}
+
+func TestAccLambdaPermissionsExclusive_qualifier(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_permissions_exclusive.test"
+	aliasResourceName := "aws_lambda_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsExclusiveConfig_qualifier(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "qualifier", aliasResourceName, names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "statement_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrsImportStateIdFunc(resourceName, ",", "function_name", "qualifier"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "function_name",
+			},
+		},
+	})
+}
+
+func TestAccLambdaPermissionsExclusive_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_permissions_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsExclusiveConfig_empty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "statement_ids.#", "0"),
+				),
+				// The empty `statement_ids` argument in the exclusive lock will remove the
+				// permission defined in this configuration, so a diff is expected.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// A permission added out of band should be removed.
+func TestAccLambdaPermissionsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_permissions_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExclusiveExists(ctx, resourceName),
+					testAccCheckPermissionsExclusiveAddPermission(ctx, rName, "OutOfBand"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccPermissionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "statement_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPermissionsExclusiveExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return create.Error(names.Lambda, create.ErrActionCheckingExistence, tflambda.ResNamePermissionsExclusive, n, errors.New("not found"))
+		}
+
+		functionName := rs.Primary.Attributes["function_name"]
+		if functionName == "" {
+			return create.Error(names.Lambda, create.ErrActionCheckingExistence, tflambda.ResNamePermissionsExclusive, n, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+		out, err := tflambda.FindPolicyStatementIDsByTwoPartKey(ctx, conn, functionName, rs.Primary.Attributes["qualifier"])
+		if err != nil {
+			return create.Error(names.Lambda, create.ErrActionCheckingExistence, tflambda.ResNamePermissionsExclusive, functionName, err)
+		}
+
+		if rs.Primary.Attributes["statement_ids.#"] != strconv.Itoa(len(out)) {
+			return create.Error(names.Lambda, create.ErrActionCheckingExistence, tflambda.ResNamePermissionsExclusive, functionName, errors.New("unexpected statement_ids count"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPermissionsExclusiveAddPermission(ctx context.Context, functionName, statementID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		input := lambda.AddPermissionInput{
+			Action:       aws.String("lambda:InvokeFunction"),
+			FunctionName: aws.String(functionName),
+			Principal:    aws.String("events.amazonaws.com"),
+			StatementId:  aws.String(statementID),
+		}
+
+		_, err := conn.AddPermission(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccPermissionsExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_base(rName), `
+resource "aws_lambda_permission" "test" {
+  statement_id  = "AllowExecutionFromCloudWatch"
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test.function_name
+  principal     = "events.amazonaws.com"
+}
+
+resource "aws_lambda_permissions_exclusive" "test" {
+  function_name = aws_lambda_function.test.function_name
+  statement_ids = [aws_lambda_permission.test.statement_id]
+}
+`)
+}
+
+func testAccPermissionsExclusiveConfig_qualifier(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_base(rName), fmt.Sprintf(`
+resource "aws_lambda_alias" "test" {
+  name             = %[1]q
+  function_name    = aws_lambda_function.test.function_name
+  function_version = "$LATEST"
+}
+
+resource "aws_lambda_permission" "test" {
+  statement_id  = "AllowExecutionWithQualifier"
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test.function_name
+  principal     = "events.amazonaws.com"
+  qualifier     = aws_lambda_alias.test.name
+}
+
+resource "aws_lambda_permissions_exclusive" "test" {
+  function_name = aws_lambda_function.test.function_name
+  qualifier     = aws_lambda_permission.test.qualifier
+  statement_ids = [aws_lambda_permission.test.statement_id]
+}
+`, rName))
+}
+
+func testAccPermissionsExclusiveConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_base(rName), `
+resource "aws_lambda_permission" "test" {
+  statement_id  = "AllowExecutionFromCloudWatch"
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test.function_name
+  principal     = "events.amazonaws.com"
+}
+
+resource "aws_lambda_permissions_exclusive" "test" {
+  # Wait until the permission is created, then provision the exclusive
+  # lock which will remove it. This creates a diff on the next plan
+  # (to re-create aws_lambda_permission.test) which the test can check for.
+  depends_on = [aws_lambda_permission.test]
+
+  function_name = aws_lambda_function.test.function_name
+  statement_ids = []
+}
+`)
+}
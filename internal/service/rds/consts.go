@@ -22,6 +22,7 @@ const (
 	clusterStatusConfiguringIAMDatabaseAuth    = "configuring-iam-database-auth"
 	clusterStatusCreating                      = "creating"
 	clusterStatusDeleting                      = "deleting"
+	clusterStatusFailingOver                   = "failing-over"
 	clusterStatusMigrating                     = "migrating"
 	clusterStatusModifying                     = "modifying"
 	clusterStatusPreparingDataMigration        = "preparing-data-migration"
@@ -35,6 +36,7 @@ const (
 
 	// Non-standard status values.
 	clusterStatusAvailableWithPendingModifiedValues = "tf-available-with-pending-modified-values"
+	clusterStatusAvailableWithPendingFailover       = "tf-available-with-pending-failover"
 )
 
 const (
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// createDBSnapshotPollInterval defines polling cadence for create DB snapshot action.
+const createDBSnapshotPollInterval = 15 * time.Second
+
+// @Action(aws_rds_create_db_snapshot, name="Create DB Snapshot")
+func newCreateDBSnapshotAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &createDBSnapshotAction{}, nil
+}
+
+var (
+	_ action.Action = (*createDBSnapshotAction)(nil)
+)
+
+type createDBSnapshotAction struct {
+	framework.ActionWithModel[createDBSnapshotModel]
+}
+
+type createDBSnapshotModel struct {
+	framework.WithRegionModel
+	DBInstanceIdentifier types.String `tfsdk:"db_instance_identifier"`
+	DBSnapshotIdentifier types.String `tfsdk:"db_snapshot_identifier"`
+	Timeout              types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *createDBSnapshotAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a snapshot of an RDS DB instance and waits for the snapshot to become available.",
+		Attributes: map[string]schema.Attribute{
+			"db_instance_identifier": schema.StringAttribute{
+				Description: "The identifier of the DB instance to snapshot",
+				Required:    true,
+			},
+			"db_snapshot_identifier": schema.StringAttribute{
+				Description: "The identifier of the DB snapshot to create",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the snapshot to become available (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *createDBSnapshotAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config createDBSnapshotModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	instanceID := config.DBInstanceIdentifier.ValueString()
+	snapshotID := config.DBSnapshotIdentifier.ValueString()
+
+	timeout := 3600 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting RDS create DB snapshot action", map[string]any{
+		"db_instance_identifier": instanceID,
+		"db_snapshot_identifier": snapshotID,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Creating snapshot %s of RDS DB instance %s...", snapshotID, instanceID),
+	})
+
+	input := rds.CreateDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(instanceID),
+		DBSnapshotIdentifier: aws.String(snapshotID),
+	}
+
+	_, err := conn.CreateDBSnapshot(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create DB Snapshot",
+			fmt.Sprintf("Could not create snapshot %s of RDS DB instance %s: %s", snapshotID, instanceID, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Snapshot %s creation started, waiting for it to become available...", snapshotID),
+	})
+
+	// Snapshot creation time scales with the amount of allocated storage,
+	// so poll at a fixed interval and report the percentage progress periodically.
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.DBSnapshot], error) {
+		snapshot, derr := findDBSnapshotByID(ctx, conn, snapshotID)
+		if tfresource.NotFound(derr) {
+			// The new snapshot may not yet be visible to DescribeDBSnapshots.
+			return actionwait.FetchResult[*awstypes.DBSnapshot]{Status: dbSnapshotCreating}, nil
+		}
+		if derr != nil {
+			return actionwait.FetchResult[*awstypes.DBSnapshot]{}, fmt.Errorf("describing DB snapshot: %w", derr)
+		}
+		return actionwait.FetchResult[*awstypes.DBSnapshot]{Status: actionwait.Status(aws.ToString(snapshot.Status)), Value: snapshot}, nil
+	}, actionwait.Options[*awstypes.DBSnapshot]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(createDBSnapshotPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{dbSnapshotAvailable},
+		TransitionalStates: []actionwait.Status{
+			dbSnapshotCreating,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			message := fmt.Sprintf("RDS DB snapshot %s is currently in state '%s', continuing to wait for '%s'...", snapshotID, fr.Status, dbSnapshotAvailable)
+			if snapshot, ok := fr.Value.(*awstypes.DBSnapshot); ok && snapshot != nil {
+				message = fmt.Sprintf("RDS DB snapshot %s is currently in state '%s' (%d%% complete), continuing to wait for '%s'...", snapshotID, fr.Status, aws.ToInt32(snapshot.PercentProgress), dbSnapshotAvailable)
+			}
+			resp.SendProgress(action.InvokeProgressEvent{Message: message})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for DB Snapshot",
+				fmt.Sprintf("RDS DB snapshot %s did not become available within %s: %s", snapshotID, timeout, err),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected DB Snapshot State",
+				fmt.Sprintf("RDS DB snapshot %s entered unexpected state while being created: %s", snapshotID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for DB Snapshot",
+				fmt.Sprintf("Error while waiting for RDS DB snapshot %s to become available: %s", snapshotID, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("RDS DB snapshot %s is available", snapshotID),
+	})
+
+	tflog.Info(ctx, "RDS create DB snapshot action completed successfully", map[string]any{
+		"db_snapshot_identifier": snapshotID,
+	})
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// failoverDBClusterPollInterval defines polling cadence for failover DB cluster action.
+const failoverDBClusterPollInterval = 10 * time.Second
+
+// @Action(aws_rds_failover_db_cluster, name="Failover DB Cluster")
+func newFailoverDBClusterAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &failoverDBClusterAction{}, nil
+}
+
+var (
+	_ action.Action = (*failoverDBClusterAction)(nil)
+)
+
+type failoverDBClusterAction struct {
+	framework.ActionWithModel[failoverDBClusterModel]
+}
+
+type failoverDBClusterModel struct {
+	framework.WithRegionModel
+	DBClusterIdentifier        types.String `tfsdk:"db_cluster_identifier"`
+	TargetDBInstanceIdentifier types.String `tfsdk:"target_db_instance_identifier"`
+	Timeout                    types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *failoverDBClusterAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forces a failover of an RDS DB cluster and waits for a new writer instance to be promoted.",
+		Attributes: map[string]schema.Attribute{
+			"db_cluster_identifier": schema.StringAttribute{
+				Description: "The identifier of the DB cluster to fail over",
+				Required:    true,
+			},
+			"target_db_instance_identifier": schema.StringAttribute{
+				Description: "The identifier of the DB instance to promote to the writer instance. If not specified, RDS chooses a reader instance.",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the failover to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(7200),
+				},
+			},
+		},
+	}
+}
+
+func (a *failoverDBClusterAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config failoverDBClusterModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	clusterID := config.DBClusterIdentifier.ValueString()
+	targetInstanceID := config.TargetDBInstanceIdentifier.ValueString()
+
+	timeout := 1800 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting RDS failover DB cluster action", map[string]any{
+		"db_cluster_identifier":         clusterID,
+		"target_db_instance_identifier": targetInstanceID,
+		names.AttrTimeout:               timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting failover of RDS DB cluster %s...", clusterID),
+	})
+
+	cluster, err := findDBClusterByID(ctx, conn, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Describe DB Cluster",
+			fmt.Sprintf("Could not describe RDS DB cluster %s: %s", clusterID, err),
+		)
+		return
+	}
+
+	writerInstanceID := clusterWriterInstanceID(cluster)
+	if targetInstanceID != "" && targetInstanceID == writerInstanceID {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("DB instance %s is already the writer instance of RDS DB cluster %s", targetInstanceID, clusterID),
+		})
+		tflog.Info(ctx, "Target DB instance is already the writer instance", map[string]any{
+			"db_cluster_identifier":         clusterID,
+			"target_db_instance_identifier": targetInstanceID,
+		})
+		return
+	}
+
+	input := rds.FailoverDBClusterInput{
+		DBClusterIdentifier: aws.String(clusterID),
+	}
+	if targetInstanceID != "" {
+		input.TargetDBInstanceIdentifier = aws.String(targetInstanceID)
+	}
+
+	_, err = conn.FailoverDBCluster(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Fail Over DB Cluster",
+			fmt.Sprintf("Could not fail over RDS DB cluster %s: %s", clusterID, err),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Failover of RDS DB cluster %s started, waiting for a new writer instance...", clusterID),
+	})
+
+	// The cluster can report 'available' before the failover has begun, so the
+	// failover is only complete once the writer instance has changed.
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.DBCluster], error) {
+		cluster, derr := findDBClusterByID(ctx, conn, clusterID)
+		if derr != nil {
+			return actionwait.FetchResult[*awstypes.DBCluster]{}, fmt.Errorf("describing DB cluster: %w", derr)
+		}
+
+		status := aws.ToString(cluster.Status)
+		if status == clusterStatusAvailable {
+			writer := clusterWriterInstanceID(cluster)
+			if writer == "" || writer == writerInstanceID || (targetInstanceID != "" && writer != targetInstanceID) {
+				status = clusterStatusAvailableWithPendingFailover
+			}
+		}
+
+		return actionwait.FetchResult[*awstypes.DBCluster]{Status: actionwait.Status(status), Value: cluster}, nil
+	}, actionwait.Options[*awstypes.DBCluster]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(failoverDBClusterPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{clusterStatusAvailable},
+		TransitionalStates: []actionwait.Status{
+			clusterStatusAvailableWithPendingFailover,
+			clusterStatusFailingOver,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("RDS DB cluster %s is currently in state '%s', continuing to wait for failover to complete...", clusterID, fr.Status)})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for DB Cluster Failover",
+				fmt.Sprintf("RDS DB cluster %s did not complete failover within %s: %s", clusterID, timeout, err),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected DB Cluster State",
+				fmt.Sprintf("RDS DB cluster %s entered unexpected state while failing over: %s", clusterID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for DB Cluster Failover",
+				fmt.Sprintf("Error while waiting for RDS DB cluster %s to fail over: %s", clusterID, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("RDS DB cluster %s has been successfully failed over", clusterID),
+	})
+
+	tflog.Info(ctx, "RDS failover DB cluster action completed successfully", map[string]any{
+		"db_cluster_identifier": clusterID,
+	})
+}
+
+// clusterWriterInstanceID returns the identifier of the cluster's writer instance, if any.
+func clusterWriterInstanceID(cluster *awstypes.DBCluster) string {
+	for _, v := range cluster.DBClusterMembers {
+		if aws.ToBool(v.IsClusterWriter) {
+			return aws.ToString(v.DBInstanceIdentifier)
+		}
+	}
+
+	return ""
+}
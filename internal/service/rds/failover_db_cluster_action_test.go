@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSFailoverDBClusterAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFailoverDBClusterActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterWriterInstance(ctx, rName, rName+"-reader"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckClusterWriterInstance(ctx context.Context, clusterID, instanceID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		output, err := tfrds.FindDBClusterByID(ctx, conn, clusterID)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.DBClusterMembers {
+			if aws.ToBool(v.IsClusterWriter) {
+				if got := aws.ToString(v.DBInstanceIdentifier); got != instanceID {
+					return fmt.Errorf("RDS Cluster (%s) writer instance = %q, want %q", clusterID, got, instanceID)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("RDS Cluster (%s) has no writer instance", clusterID)
+	}
+}
+
+func testAccFailoverDBClusterActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterInstanceConfig_base(rName, tfrds.ClusterEngineAuroraMySQL), fmt.Sprintf(`
+resource "aws_rds_cluster_instance" "writer" {
+  identifier         = "%[1]s-writer"
+  cluster_identifier = aws_rds_cluster.test.id
+  engine             = aws_rds_cluster.test.engine
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+
+resource "aws_rds_cluster_instance" "reader" {
+  identifier         = "%[1]s-reader"
+  cluster_identifier = aws_rds_cluster.test.id
+  engine             = aws_rds_cluster.test.engine
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+
+  depends_on = [aws_rds_cluster_instance.writer]
+}
+
+action "aws_rds_failover_db_cluster" "test" {
+  config {
+    db_cluster_identifier         = aws_rds_cluster.test.cluster_identifier
+    target_db_instance_identifier = aws_rds_cluster_instance.reader.identifier
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_rds_cluster_instance.reader.identifier
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rds_failover_db_cluster.test]
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameIAMAuthToken = "Ephemeral Resource IAM Auth Token"
+)
+
+const (
+	// iamAuthTokenExpiresIn is the lifetime of an RDS IAM authentication token.
+	iamAuthTokenExpiresIn = 15 * time.Minute
+	// iamAuthTokenSigningName is the SigV4 signing name used for RDS IAM database authentication.
+	iamAuthTokenSigningName = "rds-db"
+	// emptyPayloadHash is the SHA-256 hash of an empty request payload.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// @EphemeralResource(aws_rds_iam_auth_token, name="IAM Auth Token")
+func newIAMAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &iamAuthTokenEphemeralResource{}, nil
+}
+
+type iamAuthTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[iamAuthTokenEphemeralResourceModel]
+}
+
+func (e *iamAuthTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrPort: schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrUsername: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *iamAuthTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	data := iamAuthTokenEphemeralResourceModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := net.JoinHostPort(data.Hostname.ValueString(), strconv.FormatInt(data.Port.ValueInt64(), 10))
+
+	token, err := buildIAMAuthToken(ctx, endpoint, e.Meta().Region(ctx), data.Username.ValueString(), e.Meta().CredentialsProvider(ctx))
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionReading, ERNameIAMAuthToken, endpoint, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Token = types.StringValue(token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type iamAuthTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Hostname types.String `tfsdk:"hostname"`
+	Port     types.Int64  `tfsdk:"port"`
+	Token    types.String `tfsdk:"token"`
+	Username types.String `tfsdk:"username"`
+}
+
+// buildIAMAuthToken returns an authentication token for connecting to the specified database endpoint ("host:port") as the specified user.
+// The token is a SigV4 presigned "connect" request, generated the same way as by the AWS SDK's feature/rds/auth package.
+func buildIAMAuthToken(ctx context.Context, endpoint, region, dbUser string, credentialsProvider aws.CredentialsProvider) (string, error) {
+	if credentialsProvider == nil {
+		return "", errors.New("no AWS credentials configured")
+	}
+
+	credentials, err := credentialsProvider.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("retrieving AWS credentials: %w", err)
+	}
+
+	// The scheme is only needed to build a valid request and is removed from the token.
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+
+	values := url.Values{}
+	values.Set("Action", "connect")
+	values.Set("DBUser", dbUser)
+	values.Set("X-Amz-Expires", strconv.FormatInt(int64(iamAuthTokenExpiresIn/time.Second), 10))
+	request.URL.RawQuery = values.Encode()
+
+	signedURI, _, err := v4.NewSigner().PresignHTTP(ctx, credentials, request, emptyPayloadHash, iamAuthTokenSigningName, region, time.Now().UTC())
+	if err != nil {
+		return "", fmt.Errorf("presigning request: %w", err)
+	}
+
+	return strings.TrimPrefix(signedURI, "https://"), nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSRebootDBInstanceAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.DBInstance
+	resourceName := "aws_db_instance.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDBInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRebootDBInstanceActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v),
+					testAccCheckDBInstanceAvailable(&v),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDBInstanceAvailable(v *types.DBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got, want := aws.ToString(v.DBInstanceStatus), "available"; got != want {
+			return fmt.Errorf("RDS DB Instance (%s) status = %q, want %q", aws.ToString(v.DBInstanceIdentifier), got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccRebootDBInstanceActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_basic(rName), `
+action "aws_rds_reboot_db_instance" "test" {
+  config {
+    db_instance_identifier = aws_db_instance.test.identifier
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_db_instance.test.identifier
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rds_reboot_db_instance.test]
+    }
+  }
+}
+`)
+}
@@ -17,6 +17,39 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
+	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newCreateDBSnapshotAction,
+			TypeName: "aws_rds_create_db_snapshot",
+			Name:     "Create DB Snapshot",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newFailoverDBClusterAction,
+			TypeName: "aws_rds_failover_db_cluster",
+			Name:     "Failover DB Cluster",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newRebootDBInstanceAction,
+			TypeName: "aws_rds_reboot_db_instance",
+			Name:     "Reboot DB Instance",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
+}
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
+	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newIAMAuthTokenEphemeralResource,
+			TypeName: "aws_rds_iam_auth_token",
+			Name:     "IAM Auth Token",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{
 		{
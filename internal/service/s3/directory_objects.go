@@ -0,0 +1,592 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	// directoryObjectsPartSize is the part size used for multipart uploads.
+	directoryObjectsPartSize = manager.DefaultUploadPartSize
+	// Maximum number of keys in a single DeleteObjects request.
+	deleteObjectsMaxKeys = 1000
+)
+
+// directoryObjectContentTypes maps lower-case file extensions to the Content-Type of uploaded objects.
+// A fixed table is used rather than the host's MIME database so that the result doesn't depend on where Terraform runs.
+var directoryObjectContentTypes = map[string]string{
+	".avif":  "image/avif",
+	".css":   "text/css; charset=utf-8",
+	".csv":   "text/csv; charset=utf-8",
+	".gif":   "image/gif",
+	".htm":   "text/html; charset=utf-8",
+	".html":  "text/html; charset=utf-8",
+	".ico":   "image/x-icon",
+	".jpeg":  "image/jpeg",
+	".jpg":   "image/jpeg",
+	".js":    "text/javascript; charset=utf-8",
+	".json":  "application/json",
+	".map":   "application/json",
+	".mjs":   "text/javascript; charset=utf-8",
+	".mp4":   "video/mp4",
+	".otf":   "font/otf",
+	".pdf":   "application/pdf",
+	".png":   "image/png",
+	".svg":   "image/svg+xml",
+	".ttf":   "font/ttf",
+	".txt":   "text/plain; charset=utf-8",
+	".wasm":  "application/wasm",
+	".webm":  "video/webm",
+	".webp":  "image/webp",
+	".woff":  "font/woff",
+	".woff2": "font/woff2",
+	".xml":   "text/xml; charset=utf-8",
+}
+
+// @FrameworkResource("aws_s3_directory_objects", name="Directory Objects")
+func newDirectoryObjectsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &directoryObjectsResource{}
+
+	return r, nil
+}
+
+type directoryObjectsResource struct {
+	framework.ResourceWithModel[directoryObjectsResourceModel]
+}
+
+func (r *directoryObjectsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"cache_control": schema.StringAttribute{
+				Optional: true,
+			},
+			"delete_extraneous": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"etags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"key_prefix": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrSource: schema.StringAttribute{
+				Required: true,
+			},
+			"source_hashes": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"upload_concurrency": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(10),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+		},
+	}
+}
+
+func (r *directoryObjectsResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var source types.String
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrSource), &source)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if source.IsUnknown() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("etags"), types.MapUnknown(types.StringType))...)
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("source_hashes"), types.MapUnknown(types.StringType))...)
+		return
+	}
+
+	// Any change to the local directory's content is detected by comparing content hashes.
+	files, err := readLocalDirectoryObjects(source.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root(names.AttrSource), "reading S3 Directory Objects source", err.Error())
+		return
+	}
+
+	hashes := files.hashes()
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("source_hashes"), fwflex.FlattenFrameworkStringValueMapLegacy(ctx, hashes))...)
+
+	// Create.
+	if request.State.Raw.IsNull() {
+		return
+	}
+
+	var state directoryObjectsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Objects are uploaded or deleted if the local directory has changed since the last upload,
+	// or if objects have been changed, deleted or (with delete_extraneous) added outside of Terraform.
+	priorHashes, priorETags := fwflex.ExpandFrameworkStringValueMap(ctx, state.SourceHashes), fwflex.ExpandFrameworkStringValueMap(ctx, state.ETags)
+	if !maps.Equal(hashes, priorHashes) || !slices.Equal(slices.Sorted(maps.Keys(hashes)), slices.Sorted(maps.Keys(priorETags))) {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("etags"), types.MapUnknown(types.StringType))...)
+	}
+}
+
+func (r *directoryObjectsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data directoryObjectsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, keyPrefix := data.Bucket.ValueString(), data.KeyPrefix.ValueString()
+	if isDirectoryBucket(bucket) {
+		response.Diagnostics.AddAttributeError(path.Root(names.AttrBucket), "creating S3 Directory Objects", "directory buckets are not supported")
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	if err := syncDirectoryObjects(ctx, conn, &data, nil, nil, false); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Directory Objects (%s/%s)", bucket, keyPrefix), err.Error())
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, bucket+"/"+keyPrefix)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directoryObjectsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data directoryObjectsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	bucket, keyPrefix := data.Bucket.ValueString(), data.KeyPrefix.ValueString()
+	remote, err := findObjectETagsByBucketAndKeyPrefix(ctx, conn, bucket, data.objectKeyPrefix())
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		response.Diagnostics.AddWarning(fmt.Sprintf("S3 Bucket (%s) not found, removing S3 Directory Objects from state", bucket), err.Error())
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Objects (%s/%s)", bucket, keyPrefix), err.Error())
+
+		return
+	}
+
+	// An object that has been deleted or whose ETag differs from that returned when it was uploaded
+	// has changed outside of Terraform. Removing its content hash causes it to be uploaded again.
+	// Only objects in state are reported unless extraneous objects are to be deleted,
+	// in which case any other object under the key prefix is drift.
+	hashes := fwflex.ExpandFrameworkStringValueMap(ctx, data.SourceHashes)
+	etags := make(map[string]string)
+	for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, data.ETags) {
+		remoteETag, ok := remote[k]
+		if !ok {
+			delete(hashes, k)
+			continue
+		}
+
+		if remoteETag != v {
+			delete(hashes, k)
+		}
+		etags[k] = remoteETag
+	}
+	if data.DeleteExtraneous.ValueBool() {
+		for k, v := range remote {
+			if _, ok := etags[k]; !ok {
+				etags[k] = v
+			}
+		}
+	}
+	data.ETags = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, etags)
+	data.SourceHashes = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, hashes)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directoryObjectsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new directoryObjectsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	// Object metadata can only be changed by uploading the object again.
+	uploadAll := !new.CacheControl.Equal(old.CacheControl)
+	priorHashes, priorETags := fwflex.ExpandFrameworkStringValueMap(ctx, old.SourceHashes), fwflex.ExpandFrameworkStringValueMap(ctx, old.ETags)
+
+	if err := syncDirectoryObjects(ctx, conn, &new, priorHashes, priorETags, uploadAll); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating S3 Directory Objects (%s)", new.ID.ValueString()), err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *directoryObjectsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data directoryObjectsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	bucket, keyPrefix := data.Bucket.ValueString(), data.objectKeyPrefix()
+	keys := slices.Collect(maps.Keys(fwflex.ExpandFrameworkStringValueMap(ctx, data.ETags)))
+
+	if err := deleteDirectoryObjects(ctx, conn, bucket, keyPrefix, keys); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Directory Objects (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// syncDirectoryObjects uploads new and changed local files and deletes objects no longer in the local directory.
+// priorHashes and priorETags hold the content hashes and ETags, keyed by key relative to the key prefix, of the objects previously synced.
+// On return the model's content hashes are those of the local directory and its ETags those of the corresponding objects.
+func syncDirectoryObjects(ctx context.Context, conn *s3.Client, data *directoryObjectsResourceModel, priorHashes, priorETags map[string]string, uploadAll bool) error {
+	bucket, keyPrefix := data.Bucket.ValueString(), data.objectKeyPrefix()
+
+	local, err := readLocalDirectoryObjects(data.Source.ValueString())
+	if err != nil {
+		return err
+	}
+
+	toDelete := make(map[string]struct{})
+	for k := range priorETags {
+		if _, ok := local[k]; !ok {
+			toDelete[k] = struct{}{}
+		}
+	}
+	if data.DeleteExtraneous.ValueBool() {
+		remote, err := findObjectETagsByBucketAndKeyPrefix(ctx, conn, bucket, keyPrefix)
+		if err != nil {
+			return err
+		}
+
+		for k := range remote {
+			if _, ok := local[k]; !ok {
+				toDelete[k] = struct{}{}
+			}
+		}
+	}
+
+	etags := make(map[string]string)
+	var toUpload []localDirectoryObject
+	for k, v := range local {
+		if etag, ok := priorETags[k]; ok && !uploadAll && priorHashes[k] == v.hash {
+			etags[k] = etag
+		} else {
+			toUpload = append(toUpload, v)
+		}
+	}
+
+	uploaded, err := uploadDirectoryObjects(ctx, conn, bucket, keyPrefix, data.CacheControl.ValueString(), int(data.UploadConcurrency.ValueInt64()), toUpload)
+	if err != nil {
+		return err
+	}
+	maps.Copy(etags, uploaded)
+
+	if err := deleteDirectoryObjects(ctx, conn, bucket, keyPrefix, slices.Collect(maps.Keys(toDelete))); err != nil {
+		return err
+	}
+
+	data.ETags = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, etags)
+	data.SourceHashes = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, local.hashes())
+
+	return nil
+}
+
+// uploadDirectoryObjects uploads the specified local files in parallel, using multipart uploads for large files.
+// It returns the ETags of the uploaded objects, keyed by key relative to the key prefix.
+func uploadDirectoryObjects(ctx context.Context, conn *s3.Client, bucket, keyPrefix, cacheControl string, concurrency int, files []localDirectoryObject) (map[string]string, error) {
+	uploader := manager.NewUploader(conn, func(u *manager.Uploader) {
+		u.PartSize = directoryObjectsPartSize
+	})
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		errs  []error
+		etags = make(map[string]string)
+	)
+	sem := make(chan struct{}, concurrency)
+
+	for _, file := range files {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			etag, err := uploadDirectoryObject(ctx, uploader, bucket, keyPrefix, cacheControl, file)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			etags[file.key] = etag
+		}()
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return etags, nil
+}
+
+// uploadDirectoryObject uploads the specified local file, returning the object's ETag.
+func uploadDirectoryObject(ctx context.Context, uploader *manager.Uploader, bucket, keyPrefix, cacheControl string, file localDirectoryObject) (string, error) {
+	f, err := os.Open(file.path)
+	if err != nil {
+		return "", fmt.Errorf("opening S3 object source (%s): %w", file.path, err)
+	}
+	defer f.Close()
+
+	input := &s3.PutObjectInput{
+		Body:   f,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(keyPrefix + file.key),
+	}
+
+	if cacheControl != "" {
+		input.CacheControl = aws.String(cacheControl)
+	}
+
+	if v := directoryObjectContentType(file.path); v != "" {
+		input.ContentType = aws.String(v)
+	}
+
+	output, err := uploader.Upload(ctx, input)
+
+	if err != nil {
+		return "", fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", aws.ToString(input.Key), bucket, err)
+	}
+
+	return strings.Trim(aws.ToString(output.ETag), `"`), nil
+}
+
+// directoryObjectContentType returns the Content-Type for the specified file, or "" if its extension is not known.
+func directoryObjectContentType(path string) string {
+	return directoryObjectContentTypes[strings.ToLower(filepath.Ext(path))]
+}
+
+// deleteDirectoryObjects deletes the specified objects, keyed relative to the key prefix.
+func deleteDirectoryObjects(ctx context.Context, conn *s3.Client, bucket, keyPrefix string, keys []string) error {
+	toDelete := tfslices.ApplyToAll(keys, func(v string) awstypes.ObjectIdentifier {
+		return awstypes.ObjectIdentifier{
+			Key: aws.String(keyPrefix + v),
+		}
+	})
+
+	for chunk := range slices.Chunk(toDelete, deleteObjectsMaxKeys) {
+		if _, err := deletePage(ctx, conn, bucket, false, chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findObjectETagsByBucketAndKeyPrefix returns the ETags of all objects under the specified key prefix,
+// keyed by key relative to the key prefix.
+func findObjectETagsByBucketAndKeyPrefix(ctx context.Context, conn *s3.Client, bucket, keyPrefix string) (map[string]string, error) {
+	input := s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+
+	etags := make(map[string]string)
+
+	pages := s3.NewListObjectsV2Paginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			etags[strings.TrimPrefix(aws.ToString(v.Key), keyPrefix)] = strings.Trim(aws.ToString(v.ETag), `"`)
+		}
+	}
+
+	return etags, nil
+}
+
+type localDirectoryObject struct {
+	hash string // Hex-encoded SHA-256 digest of the content.
+	key  string // Relative to the key prefix.
+	path string
+}
+
+type localDirectoryObjects map[string]localDirectoryObject
+
+func (m localDirectoryObjects) hashes() map[string]string {
+	hashes := make(map[string]string, len(m))
+	for k, v := range m {
+		hashes[k] = v.hash
+	}
+
+	return hashes
+}
+
+// readLocalDirectoryObjects returns all files under the specified directory, keyed by slash-separated relative path.
+func readLocalDirectoryObjects(source string) (localDirectoryObjects, error) {
+	dir, err := homedir.Expand(source)
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+	}
+
+	objects := make(localDirectoryObjects)
+
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// Follow symbolic links to files.
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		h := sha256.New()
+		if _, err := io.Copy(h, f); err != nil {
+			return fmt.Errorf("reading %s: %w", path, err)
+		}
+
+		key := filepath.ToSlash(rel)
+		objects[key] = localDirectoryObject{
+			hash: hex.EncodeToString(h.Sum(nil)),
+			key:  key,
+			path: path,
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading S3 Directory Objects source (%s): %w", dir, err)
+	}
+
+	return objects, nil
+}
+
+type directoryObjectsResourceModel struct {
+	framework.WithRegionModel
+	Bucket            types.String `tfsdk:"bucket"`
+	CacheControl      types.String `tfsdk:"cache_control"`
+	DeleteExtraneous  types.Bool   `tfsdk:"delete_extraneous"`
+	ETags             types.Map    `tfsdk:"etags"`
+	ID                types.String `tfsdk:"id"`
+	KeyPrefix         types.String `tfsdk:"key_prefix"`
+	Source            types.String `tfsdk:"source"`
+	SourceHashes      types.Map    `tfsdk:"source_hashes"`
+	UploadConcurrency types.Int64  `tfsdk:"upload_concurrency"`
+}
+
+// objectKeyPrefix returns the key prefix normalized as in object keys, so that the same keys are used to upload, list and delete objects.
+func (m *directoryObjectsResourceModel) objectKeyPrefix() string {
+	return sdkv1CompatibleCleanKey(m.KeyPrefix.ValueString())
+}
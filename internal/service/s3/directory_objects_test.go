@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDirectoryObjectContentType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"index.html":        "text/html; charset=utf-8",
+		"css/site.css":      "text/css; charset=utf-8",
+		"js/app.min.js":     "text/javascript; charset=utf-8",
+		"img/LOGO.PNG":      "image/png",
+		"fonts/font.woff2":  "font/woff2",
+		"data/unknown.abcd": "",
+		"LICENSE":           "",
+	}
+
+	for path, expected := range testCases {
+		t.Run(path, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfs3.DirectoryObjectContentType(path); got != expected {
+				t.Errorf("got %q, expected %q", got, expected)
+			}
+		})
+	}
+}
+
+func TestAccS3DirectoryObjects_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_objects.test"
+	source := t.TempDir()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryObjectsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccDirectoryObjectsWriteFiles(t, source, map[string]string{
+						"index.html":     "<html></html>",
+						"css/site.css":   "body {}",
+						"js/app.min.js":  "void 0;",
+						"img/empty.json": "{}",
+					})
+				},
+				Config: testAccDirectoryObjectsConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryObjectContentType(ctx, resourceName, "site/index.html", "text/html; charset=utf-8"),
+					testAccCheckDirectoryObjectContentType(ctx, resourceName, "site/css/site.css", "text/css; charset=utf-8"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("etags"), knownvalue.MapSizeExact(4)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("etags").AtMapKey("index.html"), knownvalue.StringExact("c83301425b2ad1d496473a5ff3d9ecca")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("source_hashes"), knownvalue.MapSizeExact(4)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("source_hashes").AtMapKey("index.html"), knownvalue.StringExact("b633a587c652d02386c4f16f8c6f6aab7352d97f16367c3c40576214372dd628")),
+				},
+			},
+			{
+				PreConfig: func() {
+					testAccDirectoryObjectsWriteFiles(t, source, map[string]string{
+						"index.html": "<html><body></body></html>",
+					})
+					if err := os.Remove(filepath.Join(source, "js", "app.min.js")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectoryObjectsConfig_basic(rName, source),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("etags"), knownvalue.MapSizeExact(3)),
+				},
+			},
+			{
+				// An object changed outside of Terraform is uploaded again.
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+					input := s3.PutObjectInput{
+						Body:   strings.NewReader("changed"),
+						Bucket: aws.String(rName),
+						Key:    aws.String("site/index.html"),
+					}
+					if _, err := conn.PutObject(ctx, &input); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectoryObjectsConfig_basic(rName, source),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("etags"), knownvalue.MapSizeExact(3)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("source_hashes"), knownvalue.MapSizeExact(3)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3DirectoryObjects_deleteExtraneous(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_objects.test"
+	source := t.TempDir()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryObjectsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccDirectoryObjectsWriteFiles(t, source, map[string]string{
+						"index.html": "<html></html>",
+					})
+				},
+				Config: testAccDirectoryObjectsConfig_deleteExtraneous(rName, source),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("etags"), knownvalue.MapSizeExact(1)),
+				},
+			},
+			{
+				// Objects under the key prefix that are not in the source directory are deleted.
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+					input := s3.PutObjectInput{
+						Body:   strings.NewReader("stale"),
+						Bucket: aws.String(rName),
+						Key:    aws.String("site/stale.html"),
+					}
+					if _, err := conn.PutObject(ctx, &input); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectoryObjectsConfig_deleteExtraneous(rName, source),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("etags"), knownvalue.MapSizeExact(1)),
+				},
+			},
+		},
+	})
+}
+
+func testAccDirectoryObjectsWriteFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for k, v := range files {
+		path := filepath.Join(dir, filepath.FromSlash(k))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(v), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccCheckDirectoryObjectContentType(ctx context.Context, n, key, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], key, "", "")
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Object (%s) content type: got %q, expected %q", key, got, contentType)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectoryObjectsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_directory_objects" {
+				continue
+			}
+
+			input := s3.ListObjectsV2Input{
+				Bucket: aws.String(rs.Primary.Attributes[names.AttrBucket]),
+				Prefix: aws.String(rs.Primary.Attributes["key_prefix"]),
+			}
+
+			output, err := conn.ListObjectsV2(ctx, &input)
+
+			if tfawserr.ErrCodeEquals(err, tfs3.ErrCodeNoSuchBucket) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output.Contents) > 0 {
+				return fmt.Errorf("S3 Directory Objects %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectoryObjectsConfig_basic(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_objects" "test" {
+  bucket        = aws_s3_bucket.test.bucket
+  key_prefix    = "site/"
+  source        = %[2]q
+  cache_control = "max-age=300"
+}
+`, rName, source)
+}
+
+func testAccDirectoryObjectsConfig_deleteExtraneous(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_objects" "test" {
+  bucket            = aws_s3_bucket.test.bucket
+  key_prefix        = "site/"
+  source            = %[2]q
+  delete_extraneous = true
+}
+`, rName, source)
+}
@@ -27,12 +27,14 @@ var (
 	ResourceBucketVersioning                        = resourceBucketVersioning
 	ResourceBucketWebsiteConfiguration              = resourceBucketWebsiteConfiguration
 	ResourceDirectoryBucket                         = newDirectoryBucketResource
+	ResourceDirectoryObjects                        = newDirectoryObjectsResource
 	ResourceObjectCopy                              = resourceObjectCopy
 
 	BucketUpdateTags                            = bucketUpdateTags
 	BucketRegionalDomainName                    = bucketRegionalDomainName
 	BucketWebsiteEndpointAndDomain              = bucketWebsiteEndpointAndDomain
 	DeleteAllObjectVersions                     = deleteAllObjectVersions
+	DirectoryObjectContentType                  = directoryObjectContentType
 	EmptyBucket                                 = emptyBucket
 	FindAnalyticsConfiguration                  = findAnalyticsConfiguration
 	FindBucket                                  = findBucket
@@ -67,6 +69,7 @@ var (
 	BucketVersioningStatusDisabled = bucketVersioningStatusDisabled
 	ErrCodeBucketAlreadyExists     = errCodeBucketAlreadyExists
 	ErrCodeBucketAlreadyOwnedByYou = errCodeBucketAlreadyOwnedByYou
+	ErrCodeNoSuchBucket            = errCodeNoSuchBucket
 	ErrCodeNoSuchCORSConfiguration = errCodeNoSuchCORSConfiguration
 	LifecycleRuleStatusDisabled    = lifecycleRuleStatusDisabled
 	LifecycleRuleStatusEnabled     = lifecycleRuleStatusEnabled
@@ -20,6 +20,7 @@ var (
 
 	FindActivationByID                                 = findActivationByID
 	FindAssociationByID                                = findAssociationByID
+	FindAutomationExecutions                           = findAutomationExecutions
 	FindDefaultPatchBaselineByOperatingSystem          = findDefaultPatchBaselineByOperatingSystem
 	FindDefaultDefaultPatchBaselineIDByOperatingSystem = findDefaultDefaultPatchBaselineIDByOperatingSystem
 	FindDocumentByName                                 = findDocumentByName
@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// sendCommandPollInterval defines polling cadence for send command action.
+const sendCommandPollInterval = 10 * time.Second
+
+// @Action(aws_ssm_send_command, name="Send Command")
+func newSendCommandAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &sendCommandAction{}, nil
+}
+
+var (
+	_ action.Action = (*sendCommandAction)(nil)
+)
+
+type sendCommandAction struct {
+	framework.ActionWithModel[sendCommandModel]
+}
+
+type sendCommandModel struct {
+	framework.WithRegionModel
+	Comment         types.String                                 `tfsdk:"comment"`
+	DocumentName    types.String                                 `tfsdk:"document_name"`
+	DocumentVersion types.String                                 `tfsdk:"document_version"`
+	InstanceIDs     fwtypes.ListValueOf[types.String]            `tfsdk:"instance_ids"`
+	MaxConcurrency  types.String                                 `tfsdk:"max_concurrency"`
+	MaxErrors       types.String                                 `tfsdk:"max_errors"`
+	Parameters      types.Map                                    `tfsdk:"parameters" autoflex:"-"`
+	Targets         fwtypes.ListNestedObjectValueOf[targetModel] `tfsdk:"targets"`
+	Timeout         types.Int64                                  `tfsdk:"timeout"`
+}
+
+type targetModel struct {
+	Key    types.String                      `tfsdk:"key"`
+	Values fwtypes.ListValueOf[types.String] `tfsdk:"values"`
+}
+
+func (a *sendCommandAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a Systems Manager command document on managed nodes and waits for every invocation to finish.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrComment: schema.StringAttribute{
+				Description: "User-specified information about the command",
+				Optional:    true,
+			},
+			"document_name": schema.StringAttribute{
+				Description: "The name or ARN of the Systems Manager document to run",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "The version of the document to run (default: the default version)",
+				Optional:    true,
+			},
+			"instance_ids": schema.ListAttribute{
+				Description: "The IDs of the managed nodes on which the command should run",
+				CustomType:  fwtypes.ListOfStringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ExactlyOneOf(path.MatchRoot("instance_ids"), path.MatchRoot("targets")),
+				},
+			},
+			"max_concurrency": schema.StringAttribute{
+				Description: "The maximum number or percentage of managed nodes on which the command runs at the same time",
+				Optional:    true,
+			},
+			"max_errors": schema.StringAttribute{
+				Description: "The maximum number or percentage of errors allowed before the command stops running on further managed nodes",
+				Optional:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				Description: "The parameters to pass to the document",
+				ElementType: types.ListType{ElemType: types.StringType},
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the command to finish (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(172800),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"targets": targetsBlock(ctx, "The managed nodes on which the command should run, selected by tag or resource group"),
+		},
+	}
+}
+
+func (a *sendCommandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config sendCommandModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := config.DocumentName.ValueString()
+
+	timeout := 1800 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	var input ssm.SendCommandInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Parameters.IsNull() {
+		resp.Diagnostics.Append(config.Parameters.ElementsAs(ctx, &input.Parameters, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Starting SSM send command action", map[string]any{
+		"document_name":   documentName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sending command using document %s...", documentName),
+	})
+
+	output, err := conn.SendCommand(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Send Command",
+			fmt.Sprintf("Could not send command using SSM document %s: %s", documentName, err),
+		)
+		return
+	}
+
+	commandID := aws.ToString(output.Command.CommandId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Command %s sent, waiting for all invocations to finish...", commandID),
+	})
+
+	// The command's status only becomes terminal once every invocation is terminal.
+	// Any terminal status ends the wait; per-invocation results are reported afterwards.
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Command], error) {
+		command, derr := findCommandByID(ctx, conn, commandID)
+		if tfresource.NotFound(derr) {
+			// The new command may not yet be visible to ListCommands.
+			return actionwait.FetchResult[*awstypes.Command]{Status: actionwait.Status(awstypes.CommandStatusPending)}, nil
+		}
+		if derr != nil {
+			return actionwait.FetchResult[*awstypes.Command]{}, fmt.Errorf("describing command: %w", derr)
+		}
+		return actionwait.FetchResult[*awstypes.Command]{Status: actionwait.Status(command.Status), Value: command}, nil
+	}, actionwait.Options[*awstypes.Command]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(sendCommandPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusSuccess),
+			actionwait.Status(awstypes.CommandStatusCancelled),
+			actionwait.Status(awstypes.CommandStatusFailed),
+			actionwait.Status(awstypes.CommandStatusTimedOut),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusPending),
+			actionwait.Status(awstypes.CommandStatusInProgress),
+			actionwait.Status(awstypes.CommandStatusCancelling),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			message := fmt.Sprintf("Command %s is currently in state '%s'", commandID, fr.Status)
+			if command, ok := fr.Value.(*awstypes.Command); ok && command != nil {
+				message = fmt.Sprintf("Command %s is currently in state '%s' (completed: %d, errors: %d, targets: %d)", commandID, fr.Status, command.CompletedCount, command.ErrorCount, command.TargetCount)
+			}
+			resp.SendProgress(action.InvokeProgressEvent{Message: message})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Command",
+				fmt.Sprintf("Command %s did not finish within %s: %s", commandID, timeout, err),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Command State",
+				fmt.Sprintf("Command %s entered unexpected state: %s", commandID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Command",
+				fmt.Sprintf("Error while waiting for command %s to finish: %s", commandID, err),
+			)
+		}
+		return
+	}
+
+	invocations, err := findCommandInvocationsByCommandID(ctx, conn, commandID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to List Command Invocations",
+			fmt.Sprintf("Could not list invocations of command %s: %s", commandID, err),
+		)
+		return
+	}
+
+	var failed int
+	for _, v := range invocations {
+		if v.Status == awstypes.CommandInvocationStatusSuccess {
+			continue
+		}
+
+		failed++
+		resp.Diagnostics.AddError(
+			"Command Invocation Failed",
+			fmt.Sprintf("Command %s on managed node %s finished with status '%s': %s", commandID, aws.ToString(v.InstanceId), v.Status, aws.ToString(v.StatusDetails)),
+		)
+	}
+
+	if failed > 0 {
+		return
+	}
+
+	if fr.Status != actionwait.Status(awstypes.CommandStatusSuccess) {
+		resp.Diagnostics.AddError(
+			"Command Failed",
+			fmt.Sprintf("Command %s finished with status '%s'", commandID, fr.Status),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Command %s completed successfully on %d managed nodes", commandID, len(invocations)),
+	})
+
+	tflog.Info(ctx, "SSM send command action completed successfully", map[string]any{
+		"command_id":       commandID,
+		"invocation_count": len(invocations),
+	})
+}
+
+func targetsBlock(ctx context.Context, description string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType:  fwtypes.NewListNestedObjectTypeOf[targetModel](ctx),
+		Description: description,
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(5),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrKey: schema.StringAttribute{
+					Description: "The target key, for example tag:Environment or InstanceIds",
+					Required:    true,
+				},
+				names.AttrValues: schema.ListAttribute{
+					Description: "The target values",
+					CustomType:  fwtypes.ListOfStringType,
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+func findCommandByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Command, error) {
+	input := &ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	output, err := conn.ListCommands(ctx, input)
+
+	if errs.IsA[*awstypes.InvalidCommandId](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.Commands)
+}
+
+func findCommandInvocationsByCommandID(ctx context.Context, conn *ssm.Client, id string) ([]awstypes.CommandInvocation, error) {
+	input := &ssm.ListCommandInvocationsInput{
+		CommandId: aws.String(id),
+	}
+	var output []awstypes.CommandInvocation
+
+	pages := ssm.NewListCommandInvocationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.InvalidCommandId](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CommandInvocations...)
+	}
+
+	return output, nil
+}
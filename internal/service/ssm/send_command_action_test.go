@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMSendCommandAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+			},
+			{
+				PreConfig: func() {
+					log.Print("[DEBUG] Test: Sleep to allow SSM Agent to register EC2 instance as a managed node.")
+					time.Sleep(1 * time.Minute)
+				},
+				Config: testAccSendCommandActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCommandSucceeded(ctx, resourceName, rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCommandSucceeded(ctx context.Context, n, comment string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		input := ssm.ListCommandsInput{
+			InstanceId: aws.String(rs.Primary.ID),
+		}
+		output, err := conn.ListCommands(ctx, &input)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.Commands {
+			if aws.ToString(v.Comment) != comment {
+				continue
+			}
+
+			if v.Status != awstypes.CommandStatusSuccess {
+				return fmt.Errorf("SSM Command (%s) status = %q, want %q", aws.ToString(v.CommandId), v.Status, awstypes.CommandStatusSuccess)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("SSM Command with comment %q not found for instance %s", comment, rs.Primary.ID)
+	}
+}
+
+func testAccSendCommandActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccInstancesDataSourceConfig_filterInstance(rName), fmt.Sprintf(`
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name = "AWS-RunShellScript"
+    comment       = %[1]q
+    instance_ids  = [aws_instance.test.id]
+
+    parameters = {
+      commands = ["echo hello"]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_instance.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// startAutomationExecutionPollInterval defines polling cadence for start automation execution action.
+const startAutomationExecutionPollInterval = 10 * time.Second
+
+// @Action(aws_ssm_start_automation_execution, name="Start Automation Execution")
+func newStartAutomationExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	return &startAutomationExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startAutomationExecutionAction)(nil)
+)
+
+type startAutomationExecutionAction struct {
+	framework.ActionWithModel[startAutomationExecutionModel]
+}
+
+type startAutomationExecutionModel struct {
+	framework.WithRegionModel
+	DocumentName        types.String                                 `tfsdk:"document_name"`
+	DocumentVersion     types.String                                 `tfsdk:"document_version"`
+	MaxConcurrency      types.String                                 `tfsdk:"max_concurrency"`
+	MaxErrors           types.String                                 `tfsdk:"max_errors"`
+	Parameters          types.Map                                    `tfsdk:"parameters" autoflex:"-"`
+	TargetParameterName types.String                                 `tfsdk:"target_parameter_name"`
+	Targets             fwtypes.ListNestedObjectValueOf[targetModel] `tfsdk:"targets"`
+	Timeout             types.Int64                                  `tfsdk:"timeout"`
+}
+
+func (a *startAutomationExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a Systems Manager Automation runbook and waits for the execution to finish.",
+		Attributes: map[string]schema.Attribute{
+			"document_name": schema.StringAttribute{
+				Description: "The name or ARN of the Automation runbook to run",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "The version of the runbook to run (default: the default version)",
+				Optional:    true,
+			},
+			"max_concurrency": schema.StringAttribute{
+				Description: "The maximum number or percentage of targets on which the runbook runs at the same time",
+				Optional:    true,
+			},
+			"max_errors": schema.StringAttribute{
+				Description: "The maximum number or percentage of errors allowed before the execution stops running on further targets",
+				Optional:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				Description: "The parameters to pass to the runbook",
+				ElementType: types.ListType{ElemType: types.StringType},
+				Optional:    true,
+			},
+			"target_parameter_name": schema.StringAttribute{
+				Description: "The name of the runbook parameter that receives each target when running with targets",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the execution to finish (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(172800),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"targets": targetsBlock(ctx, "The resources on which the runbook should run, selected by tag, resource group or parameter values"),
+		},
+	}
+}
+
+func (a *startAutomationExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startAutomationExecutionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := config.DocumentName.ValueString()
+
+	timeout := 3600 * time.Second
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	var input ssm.StartAutomationExecutionInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Parameters.IsNull() {
+		resp.Diagnostics.Append(config.Parameters.ElementsAs(ctx, &input.Parameters, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Starting SSM start automation execution action", map[string]any{
+		"document_name":   documentName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting automation execution of runbook %s...", documentName),
+	})
+
+	output, err := conn.StartAutomationExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Automation Execution",
+			fmt.Sprintf("Could not start automation execution of SSM runbook %s: %s", documentName, err),
+		)
+		return
+	}
+
+	executionID := aws.ToString(output.AutomationExecutionId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Automation execution %s started, waiting for it to finish...", executionID),
+	})
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.AutomationExecution], error) {
+		execution, derr := findAutomationExecutionByID(ctx, conn, executionID)
+		if derr != nil {
+			return actionwait.FetchResult[*awstypes.AutomationExecution]{}, fmt.Errorf("describing automation execution: %w", derr)
+		}
+		return actionwait.FetchResult[*awstypes.AutomationExecution]{Status: actionwait.Status(execution.AutomationExecutionStatus), Value: execution}, nil
+	}, actionwait.Options[*awstypes.AutomationExecution]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startAutomationExecutionPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusSuccess),
+			actionwait.Status(awstypes.AutomationExecutionStatusCompletedWithSuccess),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusPending),
+			actionwait.Status(awstypes.AutomationExecutionStatusInprogress),
+			actionwait.Status(awstypes.AutomationExecutionStatusWaiting),
+			actionwait.Status(awstypes.AutomationExecutionStatusCancelling),
+			actionwait.Status(awstypes.AutomationExecutionStatusPendingApproval),
+			actionwait.Status(awstypes.AutomationExecutionStatusApproved),
+			actionwait.Status(awstypes.AutomationExecutionStatusScheduled),
+			actionwait.Status(awstypes.AutomationExecutionStatusRunbookInprogress),
+			actionwait.Status(awstypes.AutomationExecutionStatusPendingChangeCalendarOverride),
+			actionwait.Status(awstypes.AutomationExecutionStatusChangeCalendarOverrideApproved),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusFailed),
+			actionwait.Status(awstypes.AutomationExecutionStatusTimedout),
+			actionwait.Status(awstypes.AutomationExecutionStatusCancelled),
+			actionwait.Status(awstypes.AutomationExecutionStatusCompletedWithFailure),
+			actionwait.Status(awstypes.AutomationExecutionStatusExited),
+			actionwait.Status(awstypes.AutomationExecutionStatusRejected),
+			actionwait.Status(awstypes.AutomationExecutionStatusChangeCalendarOverrideRejected),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			message := fmt.Sprintf("Automation execution %s is currently in state '%s'", executionID, fr.Status)
+			if execution, ok := fr.Value.(*awstypes.AutomationExecution); ok && execution != nil {
+				if v := execution.ProgressCounters; v != nil {
+					message = fmt.Sprintf("Automation execution %s is currently in state '%s' (success: %d, failed: %d, total: %d)", executionID, fr.Status, v.SuccessSteps, v.FailedSteps, v.TotalSteps)
+				} else if v := aws.ToString(execution.CurrentStepName); v != "" {
+					message = fmt.Sprintf("Automation execution %s is currently in state '%s' (step: %s)", executionID, fr.Status, v)
+				}
+			}
+			resp.SendProgress(action.InvokeProgressEvent{Message: message})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Automation Execution",
+				fmt.Sprintf("Automation execution %s did not finish within %s: %s", executionID, timeout, err),
+			)
+		} else if errors.As(err, &failureErr) {
+			a.addAutomationExecutionFailureDiagnostics(ctx, conn, fr.Value, resp)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Automation Execution State",
+				fmt.Sprintf("Automation execution %s entered unexpected state: %s", executionID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				"Error Waiting for Automation Execution",
+				fmt.Sprintf("Error while waiting for automation execution %s to finish: %s", executionID, err),
+			)
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Automation execution %s completed successfully", executionID),
+	})
+
+	tflog.Info(ctx, "SSM start automation execution action completed successfully", map[string]any{
+		"automation_execution_id": executionID,
+	})
+}
+
+// addAutomationExecutionFailureDiagnostics reports a failed automation execution.
+// Executions run against targets report one diagnostic per failed child execution, otherwise one per failed step.
+func (a *startAutomationExecutionAction) addAutomationExecutionFailureDiagnostics(ctx context.Context, conn *ssm.Client, execution *awstypes.AutomationExecution, resp *action.InvokeResponse) {
+	executionID := aws.ToString(execution.AutomationExecutionId)
+	summary := "Automation Execution Failed"
+
+	input := ssm.DescribeAutomationExecutionsInput{
+		Filters: []awstypes.AutomationExecutionFilter{
+			{
+				Key:    awstypes.AutomationExecutionFilterKeyParentExecutionId,
+				Values: []string{executionID},
+			},
+		},
+	}
+	children, err := findAutomationExecutions(ctx, conn, &input)
+	if err != nil {
+		tflog.Warn(ctx, "Listing child automation executions", map[string]any{
+			"automation_execution_id": executionID,
+			"error":                   err.Error(),
+		})
+	}
+
+	var n int
+	for _, v := range children {
+		switch v.AutomationExecutionStatus {
+		case awstypes.AutomationExecutionStatusSuccess, awstypes.AutomationExecutionStatusCompletedWithSuccess:
+			continue
+		}
+
+		n++
+		resp.Diagnostics.AddError(
+			summary,
+			fmt.Sprintf("Automation execution %s on target %s finished with status '%s': %s", aws.ToString(v.AutomationExecutionId), aws.ToString(v.Target), v.AutomationExecutionStatus, aws.ToString(v.FailureMessage)),
+		)
+	}
+
+	for _, v := range execution.StepExecutions {
+		switch v.StepStatus {
+		case awstypes.AutomationExecutionStatusFailed, awstypes.AutomationExecutionStatusTimedout, awstypes.AutomationExecutionStatusCancelled:
+		default:
+			continue
+		}
+
+		n++
+		resp.Diagnostics.AddError(
+			summary,
+			fmt.Sprintf("Automation execution %s step %s finished with status '%s': %s", executionID, aws.ToString(v.StepName), v.StepStatus, aws.ToString(v.FailureMessage)),
+		)
+	}
+
+	if n == 0 {
+		resp.Diagnostics.AddError(
+			summary,
+			fmt.Sprintf("Automation execution %s finished with status '%s': %s", executionID, execution.AutomationExecutionStatus, aws.ToString(execution.FailureMessage)),
+		)
+	}
+}
+
+func findAutomationExecutionByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.AutomationExecution, error) {
+	input := &ssm.GetAutomationExecutionInput{
+		AutomationExecutionId: aws.String(id),
+	}
+
+	output, err := conn.GetAutomationExecution(ctx, input)
+
+	if errs.IsA[*awstypes.AutomationExecutionNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AutomationExecution == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AutomationExecution, nil
+}
+
+func findAutomationExecutions(ctx context.Context, conn *ssm.Client, input *ssm.DescribeAutomationExecutionsInput) ([]awstypes.AutomationExecutionMetadata, error) {
+	var output []awstypes.AutomationExecutionMetadata
+
+	pages := ssm.NewDescribeAutomationExecutionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AutomationExecutionMetadataList...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMStartAutomationExecutionAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartAutomationExecutionActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationExecutionSucceeded(ctx, rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAutomationExecutionSucceeded(ctx context.Context, documentName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		input := ssm.DescribeAutomationExecutionsInput{
+			Filters: []awstypes.AutomationExecutionFilter{
+				{
+					Key:    awstypes.AutomationExecutionFilterKeyDocumentNamePrefix,
+					Values: []string{documentName},
+				},
+			},
+		}
+		output, err := tfssm.FindAutomationExecutions(ctx, conn, &input)
+		if err != nil {
+			return err
+		}
+
+		if n := len(output); n != 1 {
+			return fmt.Errorf("SSM Document (%s) has %d automation executions, want 1", documentName, n)
+		}
+
+		if got, want := output[0].AutomationExecutionStatus, awstypes.AutomationExecutionStatusSuccess; got != want {
+			return fmt.Errorf("SSM Automation Execution (%s) status = %q, want %q", aws.ToString(output[0].AutomationExecutionId), got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccStartAutomationExecutionActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Automation"
+
+  content = jsonencode({
+    schemaVersion = "0.3"
+    description   = "Sleeps for the specified duration"
+    parameters = {
+      Duration = {
+        type    = "String"
+        default = "PT5S"
+      }
+    }
+    mainSteps = [
+      {
+        name   = "sleep"
+        action = "aws:sleep"
+        inputs = {
+          Duration = "{{ Duration }}"
+        }
+      }
+    ]
+  })
+}
+
+action "aws_ssm_start_automation_execution" "test" {
+  config {
+    document_name = aws_ssm_document.test.name
+
+    parameters = {
+      Duration = ["PT10S"]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_ssm_document.test.name
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ssm_start_automation_execution.test]
+    }
+  }
+}
+`, rName)
+}
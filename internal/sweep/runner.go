@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+)
+
+const defaultSweeperParallelism = 10
+
+// sweepers is the registry of all sweepers, keyed by name.
+var sweepers = make(map[string]*resource.Sweeper)
+
+// AddTestSweepers registers a sweeper.
+// The sweeper's Dependencies are swept before the sweeper itself.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	resource.AddTestSweepers(name, s)
+
+	sweepers[name] = s
+}
+
+// TestMain runs the registered sweepers if the -sweep flag is set, otherwise tests are executed as normal.
+// Sweepers that don't depend on each other are run concurrently.
+// The -sweep, -sweep-run and -sweep-allow-failures flags are those of resource.TestMain.
+// The additional -sweep-parallelism flag bounds the number of sweepers run concurrently in each Region.
+func TestMain(m interface {
+	Run() int
+}) {
+	// The flag is defined here rather than at package level so that it's only present in the sweeper test binary.
+	parallelism := flag.Int("sweep-parallelism", defaultSweeperParallelism, "Maximum number of Sweepers run concurrently in each Region")
+
+	flag.Parse()
+
+	regions := flag.Lookup("sweep").Value.String()
+	if regions == "" {
+		resource.TestMain(m)
+		return
+	}
+
+	run := flag.Lookup("sweep-run").Value.String()
+	allowFailures := flag.Lookup("sweep-allow-failures").Value.String() == "true"
+
+	if err := runSweepers(strings.Split(regions, ","), filterSweepers(run, sweepers), allowFailures, *parallelism); err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+// sweeperResult is the outcome of running a sweeper in a Region.
+type sweeperResult struct {
+	name     string
+	duration time.Duration
+	err      error
+	ran      bool
+}
+
+// runSweepers runs the specified sweepers in each of the specified Regions.
+// Regions are swept concurrently, with at most parallelism sweepers running at once in each Region.
+func runSweepers(regions []string, sweepers map[string]*resource.Sweeper, allowFailures bool, parallelism int) error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(regions))
+
+	for i, region := range regions {
+		region = strings.TrimSpace(region)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			errs[i] = runRegionSweepers(region, sweepers, allowFailures, parallelism)
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func runRegionSweepers(region string, sweepers map[string]*resource.Sweeper, allowFailures bool, parallelism int) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	g := depgraph.New()
+	for name := range sweepers {
+		g.AddNode(name)
+	}
+
+	missingDependencies := make(map[string]string)
+	for name, s := range sweepers {
+		for _, dependency := range s.Dependencies {
+			if err := g.AddDependency(name, dependency); err != nil {
+				missingDependencies[name] = dependency
+			}
+		}
+	}
+
+	var mu sync.Mutex
+	results := make(map[string]*sweeperResult, len(sweepers))
+
+	log.Printf("[DEBUG] Running Sweepers for region (%s) with parallelism %d", region, parallelism)
+
+	start := time.Now()
+	walkResults, err := g.Walk(ctx, func(ctx context.Context, name string) error {
+		if dependency, ok := missingDependencies[name]; ok {
+			return fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+		}
+
+		log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+
+		start := time.Now()
+		err := sweepers[name].F(region)
+		elapsed := time.Since(start)
+
+		log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", name, region, elapsed)
+
+		mu.Lock()
+		results[name] = &sweeperResult{name: name, duration: elapsed, err: err, ran: true}
+		mu.Unlock()
+
+		if err != nil {
+			log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, err)
+
+			if !allowFailures {
+				cancel()
+			}
+		}
+
+		return err
+	}, depgraph.WithConcurrency(parallelism), depgraph.WithContinueOnError(allowFailures))
+
+	if err != nil {
+		// No sweeper is run if the dependencies can't be ordered, e.g. because of a cycle.
+		err = fmt.Errorf("sweepers for region (%s): %w", region, err)
+		walkResults = make(map[string]error, len(sweepers))
+		for name := range sweepers {
+			walkResults[name] = err
+		}
+	} else {
+		log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+	}
+
+	for name, err := range walkResults {
+		if _, ok := results[name]; !ok {
+			results[name] = &sweeperResult{name: name, err: err}
+		}
+	}
+
+	return errors.Join(err, reportSweeperResults(region, results))
+}
+
+// reportSweeperResults logs the timing and outcome of each sweeper run in a Region, slowest first.
+// Returns an error if any sweeper failed or was skipped.
+func reportSweeperResults(region string, results map[string]*sweeperResult) error {
+	report, err := sweeperResultsReport(region, results)
+
+	log.Print(report)
+
+	return err
+}
+
+// sweeperResultsReport returns the report logged by reportSweeperResults.
+func sweeperResultsReport(region string, results map[string]*sweeperResult) (string, error) {
+	sorted := make([]*sweeperResult, 0, len(results))
+	for _, v := range results {
+		sorted = append(sorted, v)
+	}
+	slices.SortFunc(sorted, func(a, b *sweeperResult) int {
+		return cmp.Or(cmp.Compare(b.duration, a.duration), strings.Compare(a.name, b.name))
+	})
+
+	var failed, skipped int
+	var report strings.Builder
+
+	for _, v := range sorted {
+		switch {
+		case !v.ran:
+			skipped++
+			fmt.Fprintf(&report, "\t- %s: skipped: %s\n", v.name, v.err)
+		case v.err != nil:
+			failed++
+			fmt.Fprintf(&report, "\t- %s: failed after %s: %s\n", v.name, v.duration.Round(time.Millisecond), v.err)
+		default:
+			fmt.Fprintf(&report, "\t- %s: succeeded in %s\n", v.name, v.duration.Round(time.Millisecond))
+		}
+	}
+
+	summary := fmt.Sprintf("Sweeper results for region (%s): %d succeeded, %d failed, %d skipped\n%s", region, len(sorted)-failed-skipped, failed, skipped, report.String())
+
+	if failed > 0 || skipped > 0 {
+		return summary, fmt.Errorf("sweepers for region (%s): %d failed, %d skipped", region, failed, skipped)
+	}
+
+	return summary, nil
+}
+
+// filterSweepers returns the sweepers whose names contain any of the comma-separated values in f, along with all their dependencies.
+// All sweepers are returned if f is empty.
+func filterSweepers(f string, source map[string]*resource.Sweeper) map[string]*resource.Sweeper {
+	if f == "" {
+		return source
+	}
+
+	filters := strings.Split(strings.ToLower(f), ",")
+	result := make(map[string]*resource.Sweeper)
+
+	var addWithDependencies func(name string)
+	addWithDependencies = func(name string) {
+		if _, ok := result[name]; ok {
+			return
+		}
+
+		s, ok := source[name]
+		if !ok {
+			log.Printf("[WARN] Sweeper has dependency (%s), but that sweeper was not found", name)
+			return
+		}
+
+		result[name] = s
+
+		for _, dependency := range s.Dependencies {
+			addWithDependencies(dependency)
+		}
+	}
+
+	for name := range source {
+		for _, filter := range filters {
+			if strings.Contains(strings.ToLower(name), filter) {
+				addWithDependencies(name)
+			}
+		}
+	}
+
+	return result
+}
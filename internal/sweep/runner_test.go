@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// sweeperRecorder records the start and finish of fake sweepers.
+type sweeperRecorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *sweeperRecorder) record(event string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, event)
+}
+
+// sweeper returns a fake sweeper that records when it starts and finishes in each Region and returns err.
+func (r *sweeperRecorder) sweeper(name string, err error, dependencies ...string) *resource.Sweeper {
+	return &resource.Sweeper{
+		Name:         name,
+		Dependencies: dependencies,
+		F: func(region string) error {
+			r.record("start " + name + " " + region)
+			defer r.record("finish " + name + " " + region)
+
+			return err
+		},
+	}
+}
+
+func TestRunRegionSweepers(t *testing.T) {
+	t.Parallel()
+
+	const region = "us-west-2" //lintignore:AWSAT003
+
+	errSweep := errors.New("sweep failed")
+
+	testCases := map[string]struct {
+		sweepers       func(*sweeperRecorder) map[string]*resource.Sweeper
+		allowFailures  bool
+		expectedEvents []string
+		expectedError  string
+	}{
+		"dependency order": {
+			sweepers: func(r *sweeperRecorder) map[string]*resource.Sweeper {
+				return map[string]*resource.Sweeper{
+					"aws_a": r.sweeper("aws_a", nil, "aws_b"),
+					"aws_b": r.sweeper("aws_b", nil, "aws_c"),
+					"aws_c": r.sweeper("aws_c", nil),
+				}
+			},
+			expectedEvents: []string{
+				"start aws_c " + region,
+				"finish aws_c " + region,
+				"start aws_b " + region,
+				"finish aws_b " + region,
+				"start aws_a " + region,
+				"finish aws_a " + region,
+			},
+		},
+		"failure skips dependents": {
+			sweepers: func(r *sweeperRecorder) map[string]*resource.Sweeper {
+				return map[string]*resource.Sweeper{
+					"aws_a": r.sweeper("aws_a", nil, "aws_b"),
+					"aws_b": r.sweeper("aws_b", errSweep),
+				}
+			},
+			expectedEvents: []string{
+				"start aws_b " + region,
+				"finish aws_b " + region,
+			},
+			expectedError: "1 failed, 1 skipped",
+		},
+		"allow failures": {
+			sweepers: func(r *sweeperRecorder) map[string]*resource.Sweeper {
+				return map[string]*resource.Sweeper{
+					"aws_a": r.sweeper("aws_a", nil, "aws_b"),
+					"aws_b": r.sweeper("aws_b", errSweep),
+				}
+			},
+			allowFailures: true,
+			expectedEvents: []string{
+				"start aws_b " + region,
+				"finish aws_b " + region,
+				"start aws_a " + region,
+				"finish aws_a " + region,
+			},
+			expectedError: "1 failed, 0 skipped",
+		},
+		"missing dependency": {
+			sweepers: func(r *sweeperRecorder) map[string]*resource.Sweeper {
+				return map[string]*resource.Sweeper{
+					"aws_a": r.sweeper("aws_a", nil, "aws_missing"),
+				}
+			},
+			expectedError: "0 failed, 1 skipped",
+		},
+		"dependency cycle": {
+			sweepers: func(r *sweeperRecorder) map[string]*resource.Sweeper {
+				return map[string]*resource.Sweeper{
+					"aws_a": r.sweeper("aws_a", nil, "aws_b"),
+					"aws_b": r.sweeper("aws_b", nil, "aws_a"),
+				}
+			},
+			expectedError: "dependency cycle",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var r sweeperRecorder
+			err := runRegionSweepers(region, testCase.sweepers(&r), testCase.allowFailures, defaultSweeperParallelism)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Errorf("expected error containing %q, got: %v", testCase.expectedError, err)
+			}
+
+			if diff := cmp.Diff(r.events, testCase.expectedEvents); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunRegionSweepersParallelism(t *testing.T) {
+	t.Parallel()
+
+	const parallelism = 2
+
+	var current, maxSeen atomic.Int32
+
+	sweepers := make(map[string]*resource.Sweeper)
+	for _, name := range []string{"aws_a", "aws_b", "aws_c", "aws_d", "aws_e", "aws_f"} {
+		sweepers[name] = &resource.Sweeper{
+			Name: name,
+			F: func(string) error {
+				n := current.Add(1)
+				defer current.Add(-1)
+				for {
+					m := maxSeen.Load()
+					if n <= m || maxSeen.CompareAndSwap(m, n) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+
+				return nil
+			},
+		}
+	}
+
+	if err := runRegionSweepers("us-west-2", sweepers, false, parallelism); err != nil { //lintignore:AWSAT003
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := maxSeen.Load(); got > parallelism {
+		t.Errorf("%d sweepers run concurrently, expected at most %d", got, parallelism)
+	}
+}
+
+func TestRunSweepers(t *testing.T) {
+	t.Parallel()
+
+	var r sweeperRecorder
+	sweepers := map[string]*resource.Sweeper{
+		"aws_a": r.sweeper("aws_a", nil),
+	}
+
+	if err := runSweepers([]string{"us-west-2", " us-east-1"}, sweepers, false, defaultSweeperParallelism); err != nil { //lintignore:AWSAT003
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	slices.Sort(r.events)
+	expected := []string{
+		"finish aws_a us-east-1", //lintignore:AWSAT003
+		"finish aws_a us-west-2", //lintignore:AWSAT003
+		"start aws_a us-east-1",  //lintignore:AWSAT003
+		"start aws_a us-west-2",  //lintignore:AWSAT003
+	}
+	if diff := cmp.Diff(r.events, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFilterSweepers(t *testing.T) {
+	t.Parallel()
+
+	source := map[string]*resource.Sweeper{
+		"aws_vpc":            {Name: "aws_vpc", Dependencies: []string{"aws_subnet"}},
+		"aws_subnet":         {Name: "aws_subnet", Dependencies: []string{"aws_instance", "aws_missing"}},
+		"aws_instance":       {Name: "aws_instance"},
+		"aws_security_group": {Name: "aws_security_group"},
+	}
+
+	testCases := map[string]struct {
+		filter   string
+		expected []string
+	}{
+		"no filter": {
+			expected: []string{"aws_instance", "aws_security_group", "aws_subnet", "aws_vpc"},
+		},
+		"with dependencies": {
+			filter:   "aws_vpc",
+			expected: []string{"aws_instance", "aws_subnet", "aws_vpc"},
+		},
+		"case insensitive substrings": {
+			filter:   "SECURITY,instance",
+			expected: []string{"aws_instance", "aws_security_group"},
+		},
+		"no match": {
+			filter: "aws_s3_bucket",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := slices.Sorted(maps.Keys(filterSweepers(testCase.filter, source)))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSweeperResultsReport(t *testing.T) {
+	t.Parallel()
+
+	const region = "us-west-2" //lintignore:AWSAT003
+
+	testCases := map[string]struct {
+		results        map[string]*sweeperResult
+		expectedReport string
+		expectedError  string
+	}{
+		"succeeded": {
+			results: map[string]*sweeperResult{
+				"aws_a": {name: "aws_a", duration: time.Second, ran: true},
+				"aws_b": {name: "aws_b", duration: 2 * time.Second, ran: true},
+				"aws_c": {name: "aws_c", duration: time.Second, ran: true},
+			},
+			expectedReport: "Sweeper results for region (us-west-2): 3 succeeded, 0 failed, 0 skipped\n" +
+				"\t- aws_b: succeeded in 2s\n" +
+				"\t- aws_a: succeeded in 1s\n" +
+				"\t- aws_c: succeeded in 1s\n",
+		},
+		"failed and skipped": {
+			results: map[string]*sweeperResult{
+				"aws_a": {name: "aws_a", err: errors.New("dependency aws_b failed")},
+				"aws_b": {name: "aws_b", duration: 1500 * time.Microsecond, err: errors.New("boom"), ran: true},
+			},
+			expectedReport: "Sweeper results for region (us-west-2): 0 succeeded, 1 failed, 1 skipped\n" +
+				"\t- aws_b: failed after 2ms: boom\n" +
+				"\t- aws_a: skipped: dependency aws_b failed\n",
+			expectedError: "sweepers for region (us-west-2): 1 failed, 1 skipped",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			report, err := sweeperResultsReport(region, testCase.results)
+
+			if diff := cmp.Diff(report, testCase.expectedReport); diff != "" {
+				t.Errorf("unexpected report diff (+wanted, -got): %s", diff)
+			}
+
+			var got string
+			if err != nil {
+				got = err.Error()
+			}
+			if got != testCase.expectedError {
+				t.Errorf("error: got %q, expected %q", got, testCase.expectedError)
+			}
+		})
+	}
+}
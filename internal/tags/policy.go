@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+)
+
+// PolicyKeyCase is a tag key case rule.
+type PolicyKeyCase string
+
+const (
+	PolicyKeyCaseCamel  PolicyKeyCase = "camel"
+	PolicyKeyCaseLower  PolicyKeyCase = "lower"
+	PolicyKeyCasePascal PolicyKeyCase = "pascal"
+	PolicyKeyCaseUpper  PolicyKeyCase = "upper"
+)
+
+func (PolicyKeyCase) Values() []PolicyKeyCase {
+	return []PolicyKeyCase{
+		PolicyKeyCaseCamel,
+		PolicyKeyCaseLower,
+		PolicyKeyCasePascal,
+		PolicyKeyCaseUpper,
+	}
+}
+
+var (
+	camelCaseKeyRegexp  = regexache.MustCompile(`^[a-z][A-Za-z0-9]*$`)
+	pascalCaseKeyRegexp = regexache.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+)
+
+// matches returns whether the specified tag key satisfies the case rule.
+func (c PolicyKeyCase) matches(key string) bool {
+	switch c {
+	case PolicyKeyCaseCamel:
+		return camelCaseKeyRegexp.MatchString(key)
+	case PolicyKeyCaseLower:
+		return key == strings.ToLower(key)
+	case PolicyKeyCasePascal:
+		return pascalCaseKeyRegexp.MatchString(key)
+	case PolicyKeyCaseUpper:
+		return key == strings.ToUpper(key)
+	default:
+		return true
+	}
+}
+
+// PolicyConfig contains rules that resource tags must satisfy.
+type PolicyConfig struct {
+	AllowedValues map[string]PolicyAllowedValues // Tag key -> allowed values.
+	KeyCase       PolicyKeyCase
+	RequiredKeys  []string
+}
+
+// PolicyAllowedValues contains the allowed values for a tag key.
+// A value is allowed if it is one of Values or matches Pattern.
+type PolicyAllowedValues struct {
+	Pattern *regexp.Regexp
+	Values  []string
+}
+
+func (v PolicyAllowedValues) allows(value string) bool {
+	if slices.Contains(v.Values, value) {
+		return true
+	}
+
+	return v.Pattern != nil && v.Pattern.MatchString(value)
+}
+
+func (v PolicyAllowedValues) String() string {
+	var s []string
+
+	if len(v.Values) > 0 {
+		s = append(s, fmt.Sprintf("one of %q", v.Values))
+	}
+	if v.Pattern != nil {
+		s = append(s, fmt.Sprintf("matching %q", v.Pattern.String()))
+	}
+
+	return strings.Join(s, " or ")
+}
+
+// ValidatePolicy returns an error describing all the ways in which the tags violate the specified policy, if any.
+func (tags KeyValueTags) ValidatePolicy(config *PolicyConfig) error {
+	if config == nil {
+		return nil
+	}
+
+	var errs []error
+
+	for _, key := range config.RequiredKeys {
+		if !tags.KeyExists(key) {
+			errs = append(errs, fmt.Errorf("required tag %q is missing", key))
+		}
+	}
+
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		if !config.KeyCase.matches(key) {
+			errs = append(errs, fmt.Errorf("tag key %q is not %s case", key, config.KeyCase))
+		}
+
+		if allowedValues, ok := config.AllowedValues[key]; ok {
+			if value := tags[key].ValueString(); !allowedValues.allows(value) {
+				errs = append(errs, fmt.Errorf("tag %q value %q is not allowed, must be %s", key, value, allowedValues))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
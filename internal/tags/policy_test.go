@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+)
+
+func TestKeyValueTagsValidatePolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name    string
+		tags    KeyValueTags
+		config  *PolicyConfig
+		wantErr string
+	}{
+		{
+			name: "nil config",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			config: nil,
+		},
+		{
+			name: "compliant",
+			tags: New(ctx, map[string]string{
+				"Environment": "prod",
+				"Owner":       "team-a",
+			}),
+			config: &PolicyConfig{
+				AllowedValues: map[string]PolicyAllowedValues{
+					"Environment": {Values: []string{"dev", "prod"}},
+					"Owner":       {Pattern: regexache.MustCompile(`^team-`)},
+				},
+				KeyCase:      PolicyKeyCasePascal,
+				RequiredKeys: []string{"Environment", "Owner"},
+			},
+		},
+		{
+			name: "missing required keys",
+			tags: New(ctx, map[string]string{
+				"Owner": "team-a",
+			}),
+			config: &PolicyConfig{
+				RequiredKeys: []string{"Environment", "Owner", "CostCenter"},
+			},
+			wantErr: "required tag \"Environment\" is missing\nrequired tag \"CostCenter\" is missing",
+		},
+		{
+			name: "value not allowed",
+			tags: New(ctx, map[string]string{
+				"Environment": "staging",
+				"Owner":       "someone",
+			}),
+			config: &PolicyConfig{
+				AllowedValues: map[string]PolicyAllowedValues{
+					"Environment": {Values: []string{"dev", "prod"}, Pattern: regexache.MustCompile(`^test-`)},
+					"Owner":       {Pattern: regexache.MustCompile(`^team-`)},
+				},
+			},
+			wantErr: "tag \"Environment\" value \"staging\" is not allowed, must be one of [\"dev\" \"prod\"] or matching \"^test-\"\n" +
+				"tag \"Owner\" value \"someone\" is not allowed, must be matching \"^team-\"",
+		},
+		{
+			name: "allowed value by pattern",
+			tags: New(ctx, map[string]string{
+				"Environment": "test-1",
+			}),
+			config: &PolicyConfig{
+				AllowedValues: map[string]PolicyAllowedValues{
+					"Environment": {Values: []string{"dev", "prod"}, Pattern: regexache.MustCompile(`^test-`)},
+				},
+			},
+		},
+		{
+			name: "lower case",
+			tags: New(ctx, map[string]string{
+				"cost-center": "1",
+				"Owner":       "team-a",
+			}),
+			config: &PolicyConfig{
+				KeyCase: PolicyKeyCaseLower,
+			},
+			wantErr: "tag key \"Owner\" is not lower case",
+		},
+		{
+			name: "upper case",
+			tags: New(ctx, map[string]string{
+				"COST_CENTER": "1",
+				"Owner":       "team-a",
+			}),
+			config: &PolicyConfig{
+				KeyCase: PolicyKeyCaseUpper,
+			},
+			wantErr: "tag key \"Owner\" is not upper case",
+		},
+		{
+			name: "camel case",
+			tags: New(ctx, map[string]string{
+				"costCenter": "1",
+				"Owner":      "team-a",
+				"owner-team": "team-a",
+			}),
+			config: &PolicyConfig{
+				KeyCase: PolicyKeyCaseCamel,
+			},
+			wantErr: "tag key \"Owner\" is not camel case\ntag key \"owner-team\" is not camel case",
+		},
+		{
+			name: "pascal case",
+			tags: New(ctx, map[string]string{
+				"CostCenter": "1",
+				"owner":      "team-a",
+			}),
+			config: &PolicyConfig{
+				KeyCase: PolicyKeyCasePascal,
+			},
+			wantErr: "tag key \"owner\" is not pascal case",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.tags.ValidatePolicy(testCase.config)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.wantErr)
+			}
+			if got, want := err.Error(), testCase.wantErr; got != want {
+				t.Errorf("got error %q, want %q", got, want)
+			}
+		})
+	}
+}
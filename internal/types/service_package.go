@@ -75,13 +75,14 @@ type ServicePackageFrameworkDataSource struct {
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory  func(context.Context) (resource.ResourceWithConfigure, error)
-	TypeName string
-	Name     string
-	Tags     unique.Handle[ServicePackageResourceTags]
-	Region   unique.Handle[ServicePackageResourceRegion]
-	Identity Identity
-	Import   FrameworkImport
+	Factory                     func(context.Context) (resource.ResourceWithConfigure, error)
+	TypeName                    string
+	Name                        string
+	Tags                        unique.Handle[ServicePackageResourceTags]
+	Region                      unique.Handle[ServicePackageResourceRegion]
+	IsAssumeRoleOverrideEnabled bool // Is per-resource assume role override supported?
+	Identity                    Identity
+	Import                      FrameworkImport
 }
 
 type ServicePackageFrameworkListResource struct {
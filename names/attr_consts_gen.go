@@ -19,6 +19,7 @@ const (
 	AttrApplicationID              = "application_id"
 	AttrApplyImmediately           = "apply_immediately"
 	AttrAssociationID              = "association_id"
+	AttrAssumeRole                 = "assume_role"
 	AttrAttributes                 = "attributes"
 	AttrAutoMinorVersionUpgrade    = "auto_minor_version_upgrade"
 	AttrAvailabilityZone           = "availability_zone"
@@ -181,6 +182,7 @@ const (
 	AttrServiceRole                = "service_role"
 	AttrServiceRoleARN             = "service_role_arn"
 	AttrSession                    = "session"
+	AttrSessionName                = "session_name"
 	AttrSharedConfigFiles          = "shared_config_files"
 	AttrSize                       = "size"
 	AttrSkipCredentialsValidation  = "skip_credentials_validation"
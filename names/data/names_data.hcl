@@ -250,6 +250,11 @@ service "apigatewaymanagementapi" {
   sdk {
     id            = "ApiGatewayManagementApi"
     arn_namespace = "apigateway"
+    iam_actions = {
+      "DeleteConnection" = ["execute-api:ManageConnections"]
+      "GetConnection"    = ["execute-api:ManageConnections"]
+      "PostToConnection" = ["execute-api:ManageConnections"]
+    }
   }
 
   names {
@@ -4987,6 +4992,10 @@ service "lambda" {
   sdk {
     id            = "Lambda"
     arn_namespace = "lambda"
+    iam_actions = {
+      "Invoke"                   = ["lambda:InvokeFunction"]
+      "InvokeWithResponseStream" = ["lambda:InvokeFunction"]
+    }
   }
 
   names {
@@ -7411,6 +7420,62 @@ service "s3" {
   sdk {
     id            = "S3"
     arn_namespace = "s3"
+    iam_actions = {
+      "CompleteMultipartUpload"                     = ["s3:PutObject"]
+      "CopyObject"                                  = ["s3:GetObject", "s3:PutObject"]
+      "CreateMultipartUpload"                       = ["s3:PutObject"]
+      "DeleteBucketAnalyticsConfiguration"          = ["s3:PutAnalyticsConfiguration"]
+      "DeleteBucketCors"                            = ["s3:PutBucketCORS"]
+      "DeleteBucketEncryption"                      = ["s3:PutEncryptionConfiguration"]
+      "DeleteBucketIntelligentTieringConfiguration" = ["s3:PutIntelligentTieringConfiguration"]
+      "DeleteBucketInventoryConfiguration"          = ["s3:PutInventoryConfiguration"]
+      "DeleteBucketLifecycle"                       = ["s3:PutLifecycleConfiguration"]
+      "DeleteBucketMetricsConfiguration"            = ["s3:PutMetricsConfiguration"]
+      "DeleteBucketOwnershipControls"               = ["s3:PutBucketOwnershipControls"]
+      "DeleteBucketReplication"                     = ["s3:PutReplicationConfiguration"]
+      "DeleteBucketTagging"                         = ["s3:PutBucketTagging"]
+      "DeleteObjects"                               = ["s3:DeleteObject"]
+      "DeletePublicAccessBlock"                     = ["s3:PutBucketPublicAccessBlock"]
+      "GetBucketAccelerateConfiguration"            = ["s3:GetAccelerateConfiguration"]
+      "GetBucketAnalyticsConfiguration"             = ["s3:GetAnalyticsConfiguration"]
+      "GetBucketCors"                               = ["s3:GetBucketCORS"]
+      "GetBucketEncryption"                         = ["s3:GetEncryptionConfiguration"]
+      "GetBucketIntelligentTieringConfiguration"    = ["s3:GetIntelligentTieringConfiguration"]
+      "GetBucketInventoryConfiguration"             = ["s3:GetInventoryConfiguration"]
+      "GetBucketLifecycleConfiguration"             = ["s3:GetLifecycleConfiguration"]
+      "GetBucketMetricsConfiguration"               = ["s3:GetMetricsConfiguration"]
+      "GetBucketNotificationConfiguration"          = ["s3:GetBucketNotification"]
+      "GetBucketReplication"                        = ["s3:GetReplicationConfiguration"]
+      "GetObjectLockConfiguration"                  = ["s3:GetBucketObjectLockConfiguration"]
+      "GetPublicAccessBlock"                        = ["s3:GetBucketPublicAccessBlock"]
+      "HeadBucket"                                  = ["s3:ListBucket"]
+      "HeadObject"                                  = ["s3:GetObject"]
+      "ListBucketAnalyticsConfigurations"           = ["s3:GetAnalyticsConfiguration"]
+      "ListBucketIntelligentTieringConfigurations"  = ["s3:GetIntelligentTieringConfiguration"]
+      "ListBucketInventoryConfigurations"           = ["s3:GetInventoryConfiguration"]
+      "ListBucketMetricsConfigurations"             = ["s3:GetMetricsConfiguration"]
+      "ListBuckets"                                 = ["s3:ListAllMyBuckets"]
+      "ListMultipartUploads"                        = ["s3:ListBucketMultipartUploads"]
+      "ListObjectVersions"                          = ["s3:ListBucketVersions"]
+      "ListObjects"                                 = ["s3:ListBucket"]
+      "ListObjectsV2"                               = ["s3:ListBucket"]
+      "ListParts"                                   = ["s3:ListMultipartUploadParts"]
+      "PutBucketAccelerateConfiguration"            = ["s3:PutAccelerateConfiguration"]
+      "PutBucketAnalyticsConfiguration"             = ["s3:PutAnalyticsConfiguration"]
+      "PutBucketCors"                               = ["s3:PutBucketCORS"]
+      "PutBucketEncryption"                         = ["s3:PutEncryptionConfiguration"]
+      "PutBucketIntelligentTieringConfiguration"    = ["s3:PutIntelligentTieringConfiguration"]
+      "PutBucketInventoryConfiguration"             = ["s3:PutInventoryConfiguration"]
+      "PutBucketLifecycleConfiguration"             = ["s3:PutLifecycleConfiguration"]
+      "PutBucketMetricsConfiguration"               = ["s3:PutMetricsConfiguration"]
+      "PutBucketNotificationConfiguration"          = ["s3:PutBucketNotification"]
+      "PutBucketReplication"                        = ["s3:PutReplicationConfiguration"]
+      "PutObjectLockConfiguration"                  = ["s3:PutBucketObjectLockConfiguration"]
+      "PutPublicAccessBlock"                        = ["s3:PutBucketPublicAccessBlock"]
+      "SelectObjectContent"                         = ["s3:GetObject"]
+      "UploadPart"                                  = ["s3:PutObject"]
+      "UploadPartCopy"                              = ["s3:GetObject", "s3:PutObject"]
+    }
   }
 
   names {
@@ -220,6 +220,13 @@ func (sr ServiceRecord) ARNNamespace() string {
 	return ""
 }
 
+func (sr ServiceRecord) IAMActions() map[string][]string {
+	if sr.service.ServiceSDK != nil && len(sr.service.ServiceSDK.IAMActions) > 0 {
+		return maps.Clone(sr.service.ServiceSDK.IAMActions)
+	}
+	return nil
+}
+
 func (sr ServiceRecord) AWSServiceEnvVar() string {
 	return "AWS_ENDPOINT_URL_" + strings.ReplaceAll(strings.ToUpper(sr.SDKID()), " ", "_")
 }
@@ -301,9 +308,10 @@ type ResourcePrefix struct {
 }
 
 type SDK struct {
-	ID           string `hcl:"id,optional"`
-	Version      int    `hcl:"client_version,optional"`
-	ARNNamespace string `hcl:"arn_namespace,optional"`
+	ID           string              `hcl:"id,optional"`
+	Version      int                 `hcl:"client_version,optional"`
+	ARNNamespace string              `hcl:"arn_namespace,optional"`
+	IAMActions   map[string][]string `hcl:"iam_actions,optional"`
 }
 
 type Names struct {
@@ -27,6 +27,7 @@ func ConstOrQuote(constant string) string {
 		"application_id":                "AttrApplicationID",
 		"apply_immediately":             "AttrApplyImmediately",
 		"association_id":                "AttrAssociationID",
+		"assume_role":                   "AttrAssumeRole",
 		"attributes":                    "AttrAttributes",
 		"auto_minor_version_upgrade":    "AttrAutoMinorVersionUpgrade",
 		"availability_zone":             "AttrAvailabilityZone",
@@ -189,6 +190,7 @@ func ConstOrQuote(constant string) string {
 		"service_role":                  "AttrServiceRole",
 		"service_role_arn":              "AttrServiceRoleARN",
 		"session":                       "AttrSession",
+		"session_name":                  "AttrSessionName",
 		"shared_config_files":           "AttrSharedConfigFiles",
 		"size":                          "AttrSize",
 		"skip_credentials_validation":   "AttrSkipCredentialsValidation",
@@ -170,8 +170,10 @@ func PartitionForRegion(region string) endpoints.Partition {
 // described in detail in README.md.
 type serviceDatum struct {
 	aliases           []string
+	arnNamespace      string
 	brand             string
 	humanFriendly     string
+	iamActions        map[string][]string
 	providerNameUpper string
 }
 
@@ -208,8 +210,10 @@ func readHCLIntoServiceData() error {
 		p := l.ProviderPackage()
 
 		sd := serviceDatum{
+			arnNamespace:      l.ARNNamespace(),
 			brand:             l.Brand(),
 			humanFriendly:     l.HumanFriendly(),
+			iamActions:        l.IAMActions(),
 			providerNameUpper: l.ProviderNameUpper(),
 		}
 
@@ -267,6 +271,22 @@ func ProviderNameUpper(service string) (string, error) {
 	return "", fmt.Errorf("no service data found for %s", service)
 }
 
+// ARNNamespace returns the service namespace used in ARNs and IAM policy actions, e.g. "logs".
+func ARNNamespace(service string) (string, error) {
+	if v, ok := serviceData[service]; ok && v.arnNamespace != "" {
+		return v.arnNamespace, nil
+	}
+
+	return "", fmt.Errorf("no ARN namespace found for %s", service)
+}
+
+// IAMActions returns the IAM actions, e.g. ["s3:ListBucket"], authorizing the specified AWS API operation
+// if they differ from the operation name.
+func IAMActions(service, operation string) ([]string, bool) {
+	v, ok := serviceData[service].iamActions[operation]
+	return slices.Clone(v), ok
+}
+
 func FullHumanFriendly(service string) (string, error) {
 	if v, ok := serviceData[service]; ok {
 		if v.brand == "" {
@@ -299,6 +319,7 @@ const (
 	ResourceTopLevelRegionAttributeDescription     = `Region where this resource will be [managed](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints). ` + topLevelRegionDefaultDescription
 	ListResourceTopLevelRegionAttributeDescription = `Region to [query](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints) for resources of this type. ` + topLevelRegionDefaultDescription
 	ActionTopLevelRegionAttributeDescription       = `Region where this action will be [executed](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints). ` + topLevelRegionDefaultDescription
+	ResourceTopLevelAssumeRoleBlockDescription     = `IAM role to assume, using the provider's credentials, when managing this resource. Defaults to the provider's credentials.`
 
 	topLevelRegionDefaultDescription = `Defaults to the Region set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference).`
 )
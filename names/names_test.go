@@ -325,6 +325,128 @@ func TestProviderNameUpper(t *testing.T) {
 	}
 }
 
+func TestARNNamespace(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: "",
+			Error:    true,
+		},
+		{
+			TestName: EC2,
+			Input:    EC2,
+			Expected: "ec2",
+			Error:    false,
+		},
+		{
+			TestName: Logs,
+			Input:    Logs,
+			Expected: "logs",
+			Error:    false,
+		},
+		{
+			TestName: S3,
+			Input:    S3,
+			Expected: "s3",
+			Error:    false,
+		},
+		{
+			TestName: "doesnotexist",
+			Input:    "doesnotexist",
+			Expected: "",
+			Error:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ARNNamespace(testCase.Input)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (%s) and no error, expected error", got)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestIAMActions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName  string
+		Service   string
+		Operation string
+		Expected  []string
+		OK        bool
+	}{
+		{
+			TestName:  "no override",
+			Service:   EC2,
+			Operation: "RunInstances",
+		},
+		{
+			TestName:  "Lambda Invoke",
+			Service:   Lambda,
+			Operation: "Invoke",
+			Expected:  []string{"lambda:InvokeFunction"},
+			OK:        true,
+		},
+		{
+			TestName:  "S3 ListObjectsV2",
+			Service:   S3,
+			Operation: "ListObjectsV2",
+			Expected:  []string{"s3:ListBucket"},
+			OK:        true,
+		},
+		{
+			TestName:  "S3 CopyObject",
+			Service:   S3,
+			Operation: "CopyObject",
+			Expected:  []string{"s3:GetObject", "s3:PutObject"},
+			OK:        true,
+		},
+		{
+			TestName:  "doesnotexist",
+			Service:   "doesnotexist",
+			Operation: "Invoke",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := IAMActions(testCase.Service, testCase.Operation)
+
+			if ok != testCase.OK {
+				t.Errorf("got ok %t, expected %t", ok, testCase.OK)
+			}
+
+			if !slices.Equal(got, testCase.Expected) {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestFullHumanFriendly(t *testing.T) {
 	t.Parallel()
 